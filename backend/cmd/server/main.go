@@ -1,7 +1,231 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	_ "time/tzdata" // alpine has no zoneinfo, IO_TIMEZONE and the current_time tool need it
+
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/curator4/io/backend/internal/mcphost"
+	"github.com/curator4/io/backend/internal/mcpserver"
+	pb "github.com/curator4/io/backend/internal/proto"
+	"github.com/curator4/io/backend/internal/server"
+	"github.com/curator4/io/backend/internal/service"
+	_ "github.com/lib/pq"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"google.golang.org/genai"
+	"google.golang.org/grpc"
+)
+
+const (
+	// listenAddr is the grpc address, the Dockerfile and docker-compose expose 50051
+	listenAddr = ":50051"
+	// defaultAdminAddr keeps the admin service to the local machine
+	defaultAdminAddr = "127.0.0.1:50052"
+)
 
 func main() {
-	fmt.Println("io backend")
+	// with -mcp-stdio io is launched by an mcp client and talks mcp on stdin/stdout instead of serving grpc,
+	// logs go to stderr so they don't get in the way
+	mcpStdio := flag.Bool("mcp-stdio", false, "serve io as an mcp server over stdio instead of grpc")
+	flag.Parse()
+
+	// database
+	db, err := sql.Open("postgres", mustEnv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Ping(); err != nil {
+		log.Fatalf("failed to reach database: %v", err)
+	}
+
+	// llm providers, keyed by their name in the providers table
+	providers := llm.NewRegistry()
+	if key := os.Getenv("OPENAI_API_KEY"); key != "" {
+		providers.Register("openai", llm.NewOpenAIProvider(key))
+	}
+	if key := os.Getenv("ANTHROPIC_API_KEY"); key != "" {
+		providers.Register("anthropic", llm.NewAnthropicProvider(key))
+	}
+	if key := os.Getenv("GEMINI_API_KEY"); key != "" {
+		gemini, err := llm.NewGeminiProvider(context.Background(), key, genai.HTTPOptions{})
+		if err != nil {
+			log.Fatalf("failed to set up gemini: %v", err)
+		}
+		providers.Register("gemini", gemini)
+	}
+	log.Printf("registered providers: %v", providers.Names())
+
+	// mcp servers, their tools are advertised to the model
+	host := mcphost.NewHost()
+	defer host.Close()
+	svc := service.New(db, providers, host)
+	// rolling summaries of long conversations, written by the ai config named in IO_SUMMARY_CONFIG
+	if name := os.Getenv("IO_SUMMARY_CONFIG"); name != "" {
+		threshold, _ := strconv.Atoi(os.Getenv("IO_SUMMARY_THRESHOLD"))
+		svc.EnableSummaries(service.SummaryOptions{AIConfig: name, ThresholdTokens: threshold})
+	}
+	// long-term memories picked out of every exchange by the ai config named in IO_MEMORY_CONFIG
+	if name := os.Getenv("IO_MEMORY_CONFIG"); name != "" {
+		svc.EnableMemories(service.MemoryOptions{AIConfig: name})
+	}
+	// semantic search over messages, memories and documents, with the embedder named in IO_EMBEDDER
+	if spec := os.Getenv("IO_EMBEDDER"); spec != "" {
+		embedder, err := newEmbedder(spec)
+		if err != nil {
+			log.Fatalf("failed to set up embedder: %v", err)
+		}
+		svc.EnableEmbeddings(service.EmbeddingOptions{Embedder: embedder})
+		go func() {
+			if err := svc.BackfillEmbeddings(context.Background()); err != nil {
+				log.Printf("failed to backfill embeddings: %v", err)
+			}
+		}()
+	}
+	// time zone system prompts see the current time in
+	if tz := os.Getenv("IO_TIMEZONE"); tz != "" {
+		if err := svc.SetTimezone(tz); err != nil {
+			log.Fatalf("failed to set time zone: %v", err)
+		}
+	}
+	if err := svc.ExpireToolApprovals(context.Background()); err != nil {
+		log.Fatalf("failed to expire tool approvals: %v", err)
+	}
+	if err := svc.ConnectMCPServers(context.Background()); err != nil {
+		log.Fatalf("failed to connect mcp servers: %v", err)
+	}
+
+	// mcp server, stdio calls act as IO_MCP_USER_ID, http calls as the user of their bearer token in IO_MCP_TOKENS
+	if *mcpStdio {
+		mcpUser := os.Getenv("IO_MCP_USER_ID")
+		if mcpUser == "" {
+			log.Fatal("IO_MCP_USER_ID is not set")
+		}
+		if err := mcpserver.New(svc, mcpUser).Run(context.Background(), &mcp.StdioTransport{}); err != nil {
+			log.Fatalf("mcp server error: %v", err)
+		}
+		return
+	}
+	// the http endpoint is opt-in, without tokens nobody could use it
+	var httpServer *http.Server
+	tokens, err := mcpserver.ParseTokens(os.Getenv("IO_MCP_TOKENS"))
+	if err != nil {
+		log.Fatalf("invalid IO_MCP_TOKENS: %v", err)
+	}
+	if addr := os.Getenv("MCP_HTTP_ADDR"); addr != "" && len(tokens) == 0 {
+		log.Printf("IO_MCP_TOKENS has no tokens, not serving mcp on %s", addr)
+	} else if addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/mcp", mcpserver.NewHTTPHandler(svc, tokens))
+		httpServer = &http.Server{Addr: addr, Handler: mux}
+		go func() {
+			log.Printf("mcp server listening on %s/mcp", addr)
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("mcp http server error: %v", err)
+			}
+		}()
+	}
+
+	// grpc server
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", listenAddr, err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterIOServiceServer(grpcServer, server.New(svc))
+
+	// admin grpc server, callers need the IO_ADMIN_TOKEN bearer token
+	// without a token it has no auth at all, so it only listens on loopback whatever IO_ADMIN_ADDR says
+	adminAddr := os.Getenv("IO_ADMIN_ADDR")
+	adminToken := os.Getenv("IO_ADMIN_TOKEN")
+	var adminOpts []grpc.ServerOption
+	switch {
+	case adminToken != "":
+		adminOpts = server.RequireToken(adminToken)
+	case adminAddr != "" && !isLoopback(adminAddr):
+		log.Printf("IO_ADMIN_TOKEN is not set, serving admin on %s instead of %s", defaultAdminAddr, adminAddr)
+		adminAddr = ""
+	}
+	if adminAddr == "" {
+		adminAddr = defaultAdminAddr
+	}
+	adminLis, err := net.Listen("tcp", adminAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", adminAddr, err)
+	}
+	adminServer := grpc.NewServer(adminOpts...)
+	pb.RegisterAdminServiceServer(adminServer, server.NewAdmin(svc))
+	go func() {
+		log.Printf("io admin listening on %s", adminAddr)
+		if err := adminServer.Serve(adminLis); err != nil {
+			log.Fatalf("admin grpc server error: %v", err)
+		}
+	}()
+
+	// shut down cleanly on ctrl-c / docker stop
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		log.Println("shutting down")
+		if httpServer != nil {
+			httpServer.Shutdown(context.Background())
+		}
+		adminServer.GracefulStop()
+		grpcServer.GracefulStop()
+	}()
+
+	log.Printf("io backend listening on %s", listenAddr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("grpc server error: %v", err)
+	}
+}
+
+// isLoopback reports whether addr only listens on the local machine
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// newEmbedder makes an embedder from its spec, "fake" or "openai:<model>", which uses OPENAI_API_KEY
+func newEmbedder(spec string) (llm.Embedder, error) {
+	kind, model, _ := strings.Cut(spec, ":")
+	switch kind {
+	case "fake":
+		return llm.FakeEmbedder{}, nil
+	case "openai":
+		if model == "" {
+			return nil, fmt.Errorf("%q names no model, like openai:text-embedding-3-small", spec)
+		}
+		return llm.NewOpenAIEmbedder(llm.NewOpenAIClient(mustEnv("OPENAI_API_KEY")), model), nil
+	}
+	return nil, fmt.Errorf("unknown embedder %q", spec)
+}
+
+// mustEnv returns the value of a required environment variable
+func mustEnv(key string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		log.Fatalf("%s is not set", key)
+	}
+	return v
 }
@@ -0,0 +1,169 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: ai_config_tools.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const attachMCPServer = `-- name: AttachMCPServer :exec
+INSERT INTO ai_config_mcp_servers (ai_config_id, mcp_server_id, created_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT DO NOTHING
+`
+
+type AttachMCPServerParams struct {
+	AiConfigID  uuid.UUID
+	McpServerID uuid.UUID
+}
+
+func (q *Queries) AttachMCPServer(ctx context.Context, arg AttachMCPServerParams) error {
+	_, err := q.db.ExecContext(ctx, attachMCPServer, arg.AiConfigID, arg.McpServerID)
+	return err
+}
+
+const deleteToolRule = `-- name: DeleteToolRule :execrows
+DELETE FROM ai_config_tool_rules
+WHERE ai_config_id = $1 AND tool_name = $2
+`
+
+type DeleteToolRuleParams struct {
+	AiConfigID uuid.UUID
+	ToolName   string
+}
+
+func (q *Queries) DeleteToolRule(ctx context.Context, arg DeleteToolRuleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteToolRule, arg.AiConfigID, arg.ToolName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const detachMCPServer = `-- name: DetachMCPServer :execrows
+DELETE FROM ai_config_mcp_servers
+WHERE ai_config_id = $1 AND mcp_server_id = $2
+`
+
+type DetachMCPServerParams struct {
+	AiConfigID  uuid.UUID
+	McpServerID uuid.UUID
+}
+
+func (q *Queries) DetachMCPServer(ctx context.Context, arg DetachMCPServerParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, detachMCPServer, arg.AiConfigID, arg.McpServerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listAIConfigMCPServers = `-- name: ListAIConfigMCPServers :many
+SELECT s.id, s.created_at, s.updated_at, s.name, s.transport, s.command, s.args, s.env, s.enabled, s.url, s.headers FROM mcp_servers s
+JOIN ai_config_mcp_servers a ON a.mcp_server_id = s.id
+WHERE a.ai_config_id = $1
+ORDER BY s.name
+`
+
+func (q *Queries) ListAIConfigMCPServers(ctx context.Context, aiConfigID uuid.UUID) ([]McpServer, error) {
+	rows, err := q.db.QueryContext(ctx, listAIConfigMCPServers, aiConfigID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []McpServer
+	for rows.Next() {
+		var i McpServer
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.Transport,
+			&i.Command,
+			pq.Array(&i.Args),
+			&i.Env,
+			&i.Enabled,
+			&i.Url,
+			&i.Headers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listToolRules = `-- name: ListToolRules :many
+SELECT ai_config_id, tool_name, rule, created_at, updated_at FROM ai_config_tool_rules
+WHERE ai_config_id = $1
+ORDER BY tool_name
+`
+
+func (q *Queries) ListToolRules(ctx context.Context, aiConfigID uuid.UUID) ([]AiConfigToolRule, error) {
+	rows, err := q.db.QueryContext(ctx, listToolRules, aiConfigID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AiConfigToolRule
+	for rows.Next() {
+		var i AiConfigToolRule
+		if err := rows.Scan(
+			&i.AiConfigID,
+			&i.ToolName,
+			&i.Rule,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setToolRule = `-- name: SetToolRule :one
+INSERT INTO ai_config_tool_rules (ai_config_id, tool_name, rule, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
+ON CONFLICT (ai_config_id, tool_name) DO UPDATE
+SET rule = EXCLUDED.rule, updated_at = NOW()
+RETURNING ai_config_id, tool_name, rule, created_at, updated_at
+`
+
+type SetToolRuleParams struct {
+	AiConfigID uuid.UUID
+	ToolName   string
+	Rule       string
+}
+
+func (q *Queries) SetToolRule(ctx context.Context, arg SetToolRuleParams) (AiConfigToolRule, error) {
+	row := q.db.QueryRowContext(ctx, setToolRule, arg.AiConfigID, arg.ToolName, arg.Rule)
+	var i AiConfigToolRule
+	err := row.Scan(
+		&i.AiConfigID,
+		&i.ToolName,
+		&i.Rule,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
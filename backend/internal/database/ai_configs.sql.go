@@ -8,32 +8,56 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const countAIConfigsByModel = `-- name: CountAIConfigsByModel :one
+SELECT COUNT(*) FROM ai_configs
+WHERE model_id = $1
+`
+
+func (q *Queries) CountAIConfigsByModel(ctx context.Context, modelID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAIConfigsByModel, modelID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAIConfig = `-- name: CreateAIConfig :one
-INSERT INTO ai_configs (id, created_at, updated_at, name, model_id, system_prompt)
+INSERT INTO ai_configs (id, created_at, updated_at, name, model_id, system_prompt, context_strategy, personality_version_id)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
   $1,
   $2,
-  $3
+  $3,
+  $4,
+  $5
 )
-RETURNING id, created_at, updated_at, last_used_at, name, model_id, system_prompt
+RETURNING id, created_at, updated_at, last_used_at, name, model_id, system_prompt, temperature, top_p, max_output_tokens, reasoning_effort, stop_sequences, provider_options, context_strategy, personality_version_id
 `
 
 type CreateAIConfigParams struct {
-	Name         string
-	ModelID      uuid.UUID
-	SystemPrompt sql.NullString
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
 }
 
 func (q *Queries) CreateAIConfig(ctx context.Context, arg CreateAIConfigParams) (AiConfig, error) {
-	row := q.db.QueryRowContext(ctx, createAIConfig, arg.Name, arg.ModelID, arg.SystemPrompt)
+	row := q.db.QueryRowContext(ctx, createAIConfig,
+		arg.Name,
+		arg.ModelID,
+		arg.SystemPrompt,
+		arg.ContextStrategy,
+		arg.PersonalityVersionID,
+	)
 	var i AiConfig
 	err := row.Scan(
 		&i.ID,
@@ -43,38 +67,57 @@ func (q *Queries) CreateAIConfig(ctx context.Context, arg CreateAIConfigParams)
 		&i.Name,
 		&i.ModelID,
 		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
 	)
 	return i, err
 }
 
-const deleteAIConfig = `-- name: DeleteAIConfig :exec
+const deleteAIConfig = `-- name: DeleteAIConfig :execrows
 DELETE FROM ai_configs
 WHERE id = $1
 `
 
-func (q *Queries) DeleteAIConfig(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteAIConfig, id)
-	return err
+func (q *Queries) DeleteAIConfig(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAIConfig, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getAIConfigByID = `-- name: GetAIConfigByID :one
 SELECT
-  ac.id, ac.created_at, ac.updated_at, ac.last_used_at, ac.name, ac.model_id, ac.system_prompt,
-  m.id, m.created_at, m.provider_id, m.name, m.description
+  ac.id, ac.created_at, ac.updated_at, ac.last_used_at, ac.name, ac.model_id, ac.system_prompt, ac.temperature, ac.top_p, ac.max_output_tokens, ac.reasoning_effort, ac.stop_sequences, ac.provider_options, ac.context_strategy, ac.personality_version_id,
+  m.id, m.created_at, m.provider_id, m.name, m.description, m.updated_at, m.provider_model_id, m.context_window, m.max_output_tokens, m.supports_vision, m.supports_tools, m.supports_streaming, m.supports_reasoning
 FROM ai_configs ac
 JOIN models m ON ac.model_id = m.id
 WHERE ac.id = $1
 `
 
 type GetAIConfigByIDRow struct {
-	ID           uuid.UUID
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastUsedAt   sql.NullTime
-	Name         string
-	ModelID      uuid.UUID
-	SystemPrompt sql.NullString
-	Model        Model
+	ID                   uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           sql.NullTime
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	Temperature          sql.NullFloat64
+	TopP                 sql.NullFloat64
+	MaxOutputTokens      sql.NullInt32
+	ReasoningEffort      sql.NullString
+	StopSequences        []string
+	ProviderOptions      json.RawMessage
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
+	Model                Model
 }
 
 func (q *Queries) GetAIConfigByID(ctx context.Context, id uuid.UUID) (GetAIConfigByIDRow, error) {
@@ -88,17 +131,33 @@ func (q *Queries) GetAIConfigByID(ctx context.Context, id uuid.UUID) (GetAIConfi
 		&i.Name,
 		&i.ModelID,
 		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
 		&i.Model.ID,
 		&i.Model.CreatedAt,
 		&i.Model.ProviderID,
 		&i.Model.Name,
 		&i.Model.Description,
+		&i.Model.UpdatedAt,
+		&i.Model.ProviderModelID,
+		&i.Model.ContextWindow,
+		&i.Model.MaxOutputTokens,
+		&i.Model.SupportsVision,
+		&i.Model.SupportsTools,
+		&i.Model.SupportsStreaming,
+		&i.Model.SupportsReasoning,
 	)
 	return i, err
 }
 
 const getAIConfigByName = `-- name: GetAIConfigByName :one
-SELECT id, created_at, updated_at, last_used_at, name, model_id, system_prompt FROM ai_configs
+SELECT id, created_at, updated_at, last_used_at, name, model_id, system_prompt, temperature, top_p, max_output_tokens, reasoning_effort, stop_sequences, provider_options, context_strategy, personality_version_id FROM ai_configs
 WHERE name = $1
 `
 
@@ -113,24 +172,121 @@ func (q *Queries) GetAIConfigByName(ctx context.Context, name string) (AiConfig,
 		&i.Name,
 		&i.ModelID,
 		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
+	)
+	return i, err
+}
+
+const getDefaultAIConfig = `-- name: GetDefaultAIConfig :one
+SELECT
+  ac.id, ac.created_at, ac.updated_at, ac.last_used_at, ac.name, ac.model_id, ac.system_prompt, ac.temperature, ac.top_p, ac.max_output_tokens, ac.reasoning_effort, ac.stop_sequences, ac.provider_options, ac.context_strategy, ac.personality_version_id,
+  m.id, m.created_at, m.provider_id, m.name, m.description, m.updated_at, m.provider_model_id, m.context_window, m.max_output_tokens, m.supports_vision, m.supports_tools, m.supports_streaming, m.supports_reasoning
+FROM ai_configs ac
+JOIN models m ON ac.model_id = m.id
+ORDER BY ac.created_at, ac.id
+LIMIT 1
+`
+
+type GetDefaultAIConfigRow struct {
+	ID                   uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           sql.NullTime
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	Temperature          sql.NullFloat64
+	TopP                 sql.NullFloat64
+	MaxOutputTokens      sql.NullInt32
+	ReasoningEffort      sql.NullString
+	StopSequences        []string
+	ProviderOptions      json.RawMessage
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
+	Model                Model
+}
+
+// the config of users who haven't picked one: the oldest, so it doesn't change with what others use
+func (q *Queries) GetDefaultAIConfig(ctx context.Context) (GetDefaultAIConfigRow, error) {
+	row := q.db.QueryRowContext(ctx, getDefaultAIConfig)
+	var i GetDefaultAIConfigRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastUsedAt,
+		&i.Name,
+		&i.ModelID,
+		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
+		&i.Model.ID,
+		&i.Model.CreatedAt,
+		&i.Model.ProviderID,
+		&i.Model.Name,
+		&i.Model.Description,
+		&i.Model.UpdatedAt,
+		&i.Model.ProviderModelID,
+		&i.Model.ContextWindow,
+		&i.Model.MaxOutputTokens,
+		&i.Model.SupportsVision,
+		&i.Model.SupportsTools,
+		&i.Model.SupportsStreaming,
+		&i.Model.SupportsReasoning,
 	)
 	return i, err
 }
 
 const listAIConfigs = `-- name: ListAIConfigs :many
-SELECT id, created_at, updated_at, last_used_at, name, model_id, system_prompt FROM ai_configs
-ORDER BY name
+SELECT
+  ac.id, ac.created_at, ac.updated_at, ac.last_used_at, ac.name, ac.model_id, ac.system_prompt, ac.temperature, ac.top_p, ac.max_output_tokens, ac.reasoning_effort, ac.stop_sequences, ac.provider_options, ac.context_strategy, ac.personality_version_id,
+  m.id, m.created_at, m.provider_id, m.name, m.description, m.updated_at, m.provider_model_id, m.context_window, m.max_output_tokens, m.supports_vision, m.supports_tools, m.supports_streaming, m.supports_reasoning
+FROM ai_configs ac
+JOIN models m ON ac.model_id = m.id
+ORDER BY ac.name
 `
 
-func (q *Queries) ListAIConfigs(ctx context.Context) ([]AiConfig, error) {
+type ListAIConfigsRow struct {
+	ID                   uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           sql.NullTime
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	Temperature          sql.NullFloat64
+	TopP                 sql.NullFloat64
+	MaxOutputTokens      sql.NullInt32
+	ReasoningEffort      sql.NullString
+	StopSequences        []string
+	ProviderOptions      json.RawMessage
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
+	Model                Model
+}
+
+func (q *Queries) ListAIConfigs(ctx context.Context) ([]ListAIConfigsRow, error) {
 	rows, err := q.db.QueryContext(ctx, listAIConfigs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AiConfig
+	var items []ListAIConfigsRow
 	for rows.Next() {
-		var i AiConfig
+		var i ListAIConfigsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.CreatedAt,
@@ -139,6 +295,27 @@ func (q *Queries) ListAIConfigs(ctx context.Context) ([]AiConfig, error) {
 			&i.Name,
 			&i.ModelID,
 			&i.SystemPrompt,
+			&i.Temperature,
+			&i.TopP,
+			&i.MaxOutputTokens,
+			&i.ReasoningEffort,
+			pq.Array(&i.StopSequences),
+			&i.ProviderOptions,
+			&i.ContextStrategy,
+			&i.PersonalityVersionID,
+			&i.Model.ID,
+			&i.Model.CreatedAt,
+			&i.Model.ProviderID,
+			&i.Model.Name,
+			&i.Model.Description,
+			&i.Model.UpdatedAt,
+			&i.Model.ProviderModelID,
+			&i.Model.ContextWindow,
+			&i.Model.MaxOutputTokens,
+			&i.Model.SupportsVision,
+			&i.Model.SupportsTools,
+			&i.Model.SupportsStreaming,
+			&i.Model.SupportsReasoning,
 		); err != nil {
 			return nil, err
 		}
@@ -153,6 +330,39 @@ func (q *Queries) ListAIConfigs(ctx context.Context) ([]AiConfig, error) {
 	return items, nil
 }
 
+const updateAIConfig = `-- name: UpdateAIConfig :exec
+UPDATE ai_configs
+SET
+  name = $2,
+  model_id = $3,
+  system_prompt = $4,
+  context_strategy = $5,
+  personality_version_id = $6,
+  updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateAIConfigParams struct {
+	ID                   uuid.UUID
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
+}
+
+func (q *Queries) UpdateAIConfig(ctx context.Context, arg UpdateAIConfigParams) error {
+	_, err := q.db.ExecContext(ctx, updateAIConfig,
+		arg.ID,
+		arg.Name,
+		arg.ModelID,
+		arg.SystemPrompt,
+		arg.ContextStrategy,
+		arg.PersonalityVersionID,
+	)
+	return err
+}
+
 const updateAIConfigLastUsed = `-- name: UpdateAIConfigLastUsed :exec
 UPDATE ai_configs
 SET last_used_at = NOW()
@@ -170,7 +380,7 @@ SET
   model_id = $2,
   updated_at = NOW()
 WHERE id = $1
-RETURNING id, created_at, updated_at, last_used_at, name, model_id, system_prompt
+RETURNING id, created_at, updated_at, last_used_at, name, model_id, system_prompt, temperature, top_p, max_output_tokens, reasoning_effort, stop_sequences, provider_options, context_strategy, personality_version_id
 `
 
 type UpdateAIConfigModelParams struct {
@@ -189,17 +399,61 @@ func (q *Queries) UpdateAIConfigModel(ctx context.Context, arg UpdateAIConfigMod
 		&i.Name,
 		&i.ModelID,
 		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
 	)
 	return i, err
 }
 
+const updateAIConfigParams = `-- name: UpdateAIConfigParams :exec
+UPDATE ai_configs
+SET
+  temperature = $2,
+  top_p = $3,
+  max_output_tokens = $4,
+  reasoning_effort = $5,
+  stop_sequences = $6,
+  provider_options = $7,
+  updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateAIConfigParamsParams struct {
+	ID              uuid.UUID
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+	StopSequences   []string
+	ProviderOptions json.RawMessage
+}
+
+func (q *Queries) UpdateAIConfigParams(ctx context.Context, arg UpdateAIConfigParamsParams) error {
+	_, err := q.db.ExecContext(ctx, updateAIConfigParams,
+		arg.ID,
+		arg.Temperature,
+		arg.TopP,
+		arg.MaxOutputTokens,
+		arg.ReasoningEffort,
+		pq.Array(arg.StopSequences),
+		arg.ProviderOptions,
+	)
+	return err
+}
+
 const updateAIConfigPrompt = `-- name: UpdateAIConfigPrompt :one
 UPDATE ai_configs
 SET
   system_prompt = $2,
   updated_at = NOW()
 WHERE id = $1
-RETURNING id, created_at, updated_at, last_used_at, name, model_id, system_prompt
+RETURNING id, created_at, updated_at, last_used_at, name, model_id, system_prompt, temperature, top_p, max_output_tokens, reasoning_effort, stop_sequences, provider_options, context_strategy, personality_version_id
 `
 
 type UpdateAIConfigPromptParams struct {
@@ -218,6 +472,14 @@ func (q *Queries) UpdateAIConfigPrompt(ctx context.Context, arg UpdateAIConfigPr
 		&i.Name,
 		&i.ModelID,
 		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
 	)
 	return i, err
 }
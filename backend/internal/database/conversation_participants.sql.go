@@ -14,6 +14,7 @@ import (
 const addParticipant = `-- name: AddParticipant :exec
 INSERT INTO conversation_participants (conversation_id, user_id)
 VALUES ($1, $2)
+ON CONFLICT DO NOTHING
 `
 
 type AddParticipantParams struct {
@@ -61,7 +62,7 @@ func (q *Queries) GetConversationParticipants(ctx context.Context, conversationI
 }
 
 const getUserConversations = `-- name: GetUserConversations :many
-SELECT c.id, c.created_at, c.updated_at, c.last_used_at, c.name FROM conversations c
+SELECT c.id, c.created_at, c.updated_at, c.last_used_at, c.name, c.personality_version_id FROM conversations c
 JOIN conversation_participants cp ON c.id = cp.conversation_id
 WHERE cp.user_id = $1
 ORDER BY c.updated_at DESC
@@ -82,6 +83,7 @@ func (q *Queries) GetUserConversations(ctx context.Context, userID uuid.UUID) ([
 			&i.UpdatedAt,
 			&i.LastUsedAt,
 			&i.Name,
+			&i.PersonalityVersionID,
 		); err != nil {
 			return nil, err
 		}
@@ -96,6 +98,25 @@ func (q *Queries) GetUserConversations(ctx context.Context, userID uuid.UUID) ([
 	return items, nil
 }
 
+const isParticipant = `-- name: IsParticipant :one
+SELECT EXISTS (
+  SELECT 1 FROM conversation_participants
+  WHERE conversation_id = $1 AND user_id = $2
+)
+`
+
+type IsParticipantParams struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) IsParticipant(ctx context.Context, arg IsParticipantParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isParticipant, arg.ConversationID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const removeParticipant = `-- name: RemoveParticipant :exec
 DELETE FROM conversation_participants
 WHERE conversation_id = $1 AND user_id = $2
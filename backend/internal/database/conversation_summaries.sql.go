@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: conversation_summaries.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createConversationSummary = `-- name: CreateConversationSummary :one
+INSERT INTO conversation_summaries (conversation_id, first_message_id, last_message_id, message_count, content)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, conversation_id, first_message_id, last_message_id, message_count, content, created_at
+`
+
+type CreateConversationSummaryParams struct {
+	ConversationID uuid.UUID
+	FirstMessageID uuid.UUID
+	LastMessageID  uuid.UUID
+	MessageCount   int32
+	Content        string
+}
+
+func (q *Queries) CreateConversationSummary(ctx context.Context, arg CreateConversationSummaryParams) (ConversationSummary, error) {
+	row := q.db.QueryRowContext(ctx, createConversationSummary,
+		arg.ConversationID,
+		arg.FirstMessageID,
+		arg.LastMessageID,
+		arg.MessageCount,
+		arg.Content,
+	)
+	var i ConversationSummary
+	err := row.Scan(
+		&i.ID,
+		&i.ConversationID,
+		&i.FirstMessageID,
+		&i.LastMessageID,
+		&i.MessageCount,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestConversationSummary = `-- name: GetLatestConversationSummary :one
+SELECT id, conversation_id, first_message_id, last_message_id, message_count, content, created_at FROM conversation_summaries
+WHERE conversation_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestConversationSummary(ctx context.Context, conversationID uuid.UUID) (ConversationSummary, error) {
+	row := q.db.QueryRowContext(ctx, getLatestConversationSummary, conversationID)
+	var i ConversationSummary
+	err := row.Scan(
+		&i.ID,
+		&i.ConversationID,
+		&i.FirstMessageID,
+		&i.LastMessageID,
+		&i.MessageCount,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -20,7 +20,7 @@ VALUES (
   NOW(),
   $1
 )
-RETURNING id, created_at, updated_at, last_used_at, name
+RETURNING id, created_at, updated_at, last_used_at, name, personality_version_id
 `
 
 func (q *Queries) CreateConversation(ctx context.Context, name sql.NullString) (Conversation, error) {
@@ -32,6 +32,7 @@ func (q *Queries) CreateConversation(ctx context.Context, name sql.NullString) (
 		&i.UpdatedAt,
 		&i.LastUsedAt,
 		&i.Name,
+		&i.PersonalityVersionID,
 	)
 	return i, err
 }
@@ -47,7 +48,7 @@ func (q *Queries) DeleteConversation(ctx context.Context, id uuid.UUID) error {
 }
 
 const getConversation = `-- name: GetConversation :one
-SELECT id, created_at, updated_at, last_used_at, name FROM conversations
+SELECT id, created_at, updated_at, last_used_at, name, personality_version_id FROM conversations
 WHERE id = $1
 `
 
@@ -60,12 +61,13 @@ func (q *Queries) GetConversation(ctx context.Context, id uuid.UUID) (Conversati
 		&i.UpdatedAt,
 		&i.LastUsedAt,
 		&i.Name,
+		&i.PersonalityVersionID,
 	)
 	return i, err
 }
 
 const listRecentConversations = `-- name: ListRecentConversations :many
-SELECT id, created_at, updated_at, last_used_at, name FROM conversations
+SELECT id, created_at, updated_at, last_used_at, name, personality_version_id FROM conversations
 ORDER BY last_used_at DESC NULLS LAST
 LIMIT $1
 `
@@ -85,6 +87,7 @@ func (q *Queries) ListRecentConversations(ctx context.Context, limit int32) ([]C
 			&i.UpdatedAt,
 			&i.LastUsedAt,
 			&i.Name,
+			&i.PersonalityVersionID,
 		); err != nil {
 			return nil, err
 		}
@@ -116,7 +119,7 @@ SET
   name = $2,
   updated_at = NOW()
 WHERE id = $1
-RETURNING id, created_at, updated_at, last_used_at, name
+RETURNING id, created_at, updated_at, last_used_at, name, personality_version_id
 `
 
 type UpdateConversationNameParams struct {
@@ -133,6 +136,35 @@ func (q *Queries) UpdateConversationName(ctx context.Context, arg UpdateConversa
 		&i.UpdatedAt,
 		&i.LastUsedAt,
 		&i.Name,
+		&i.PersonalityVersionID,
+	)
+	return i, err
+}
+
+const updateConversationPersonality = `-- name: UpdateConversationPersonality :one
+UPDATE conversations
+SET
+  personality_version_id = $2,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_used_at, name, personality_version_id
+`
+
+type UpdateConversationPersonalityParams struct {
+	ID                   uuid.UUID
+	PersonalityVersionID uuid.NullUUID
+}
+
+func (q *Queries) UpdateConversationPersonality(ctx context.Context, arg UpdateConversationPersonalityParams) (Conversation, error) {
+	row := q.db.QueryRowContext(ctx, updateConversationPersonality, arg.ID, arg.PersonalityVersionID)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastUsedAt,
+		&i.Name,
+		&i.PersonalityVersionID,
 	)
 	return i, err
 }
@@ -0,0 +1,103 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: documents.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createDocument = `-- name: CreateDocument :one
+INSERT INTO documents (user_id, name, content)
+VALUES ($1, $2, $3)
+RETURNING id, user_id, name, content, created_at
+`
+
+type CreateDocumentParams struct {
+	UserID  uuid.UUID
+	Name    string
+	Content string
+}
+
+func (q *Queries) CreateDocument(ctx context.Context, arg CreateDocumentParams) (Document, error) {
+	row := q.db.QueryRowContext(ctx, createDocument, arg.UserID, arg.Name, arg.Content)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteDocument = `-- name: DeleteDocument :execrows
+DELETE FROM documents
+WHERE id = $1
+`
+
+func (q *Queries) DeleteDocument(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDocument, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDocument = `-- name: GetDocument :one
+SELECT id, user_id, name, content, created_at FROM documents
+WHERE id = $1
+`
+
+func (q *Queries) GetDocument(ctx context.Context, id uuid.UUID) (Document, error) {
+	row := q.db.QueryRowContext(ctx, getDocument, id)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listDocuments = `-- name: ListDocuments :many
+SELECT id, user_id, name, content, created_at FROM documents
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListDocuments(ctx context.Context, userID uuid.UUID) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listDocuments, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Document
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Content,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,265 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: embeddings.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/curator4/io/backend/internal/pgvector"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createEmbedding = `-- name: CreateEmbedding :exec
+INSERT INTO embeddings (message_id, memory_id, document_id, chunk_index, content, embedder, embedding)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateEmbeddingParams struct {
+	MessageID  uuid.NullUUID
+	MemoryID   uuid.NullUUID
+	DocumentID uuid.NullUUID
+	ChunkIndex int32
+	Content    string
+	Embedder   string
+	Embedding  pgvector.Vector
+}
+
+func (q *Queries) CreateEmbedding(ctx context.Context, arg CreateEmbeddingParams) error {
+	_, err := q.db.ExecContext(ctx, createEmbedding,
+		arg.MessageID,
+		arg.MemoryID,
+		arg.DocumentID,
+		arg.ChunkIndex,
+		arg.Content,
+		arg.Embedder,
+		arg.Embedding,
+	)
+	return err
+}
+
+const deleteMemoryEmbeddings = `-- name: DeleteMemoryEmbeddings :exec
+DELETE FROM embeddings
+WHERE memory_id = $1
+`
+
+func (q *Queries) DeleteMemoryEmbeddings(ctx context.Context, memoryID uuid.NullUUID) error {
+	_, err := q.db.ExecContext(ctx, deleteMemoryEmbeddings, memoryID)
+	return err
+}
+
+const listUnembeddedDocuments = `-- name: ListUnembeddedDocuments :many
+SELECT id, user_id, name, content, created_at FROM documents d
+WHERE NOT EXISTS (SELECT 1 FROM embeddings e WHERE e.document_id = d.id AND e.embedder = $1)
+ORDER BY d.created_at
+LIMIT $2
+`
+
+type ListUnembeddedDocumentsParams struct {
+	Embedder string
+	Limit    int32
+}
+
+func (q *Queries) ListUnembeddedDocuments(ctx context.Context, arg ListUnembeddedDocumentsParams) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listUnembeddedDocuments, arg.Embedder, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Document
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Content,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnembeddedMemories = `-- name: ListUnembeddedMemories :many
+SELECT id, user_id, content, normalized, created_at, updated_at FROM memories m
+WHERE NOT EXISTS (SELECT 1 FROM embeddings e WHERE e.memory_id = m.id AND e.embedder = $1)
+ORDER BY m.created_at
+LIMIT $2
+`
+
+type ListUnembeddedMemoriesParams struct {
+	Embedder string
+	Limit    int32
+}
+
+func (q *Queries) ListUnembeddedMemories(ctx context.Context, arg ListUnembeddedMemoriesParams) ([]Memory, error) {
+	rows, err := q.db.QueryContext(ctx, listUnembeddedMemories, arg.Embedder, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Memory
+	for rows.Next() {
+		var i Memory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Content,
+			&i.Normalized,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnembeddedMessages = `-- name: ListUnembeddedMessages :many
+SELECT id, created_at, updated_at, conversation_id, user_id, role, content FROM messages m
+WHERE m.role IN ('user', 'assistant')
+  AND m.content->>'text' ~ '\S'
+  AND NOT EXISTS (SELECT 1 FROM embeddings e WHERE e.message_id = m.id AND e.embedder = $1)
+ORDER BY m.created_at
+LIMIT $2
+`
+
+type ListUnembeddedMessagesParams struct {
+	Embedder string
+	Limit    int32
+}
+
+// user and assistant messages with text that the embedder hasn't seen yet, oldest first
+// text has to have a non-space character, any whitespace counts as space like for chunkText
+func (q *Queries) ListUnembeddedMessages(ctx context.Context, arg ListUnembeddedMessagesParams) ([]Message, error) {
+	rows, err := q.db.QueryContext(ctx, listUnembeddedMessages, arg.Embedder, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ConversationID,
+			&i.UserID,
+			&i.Role,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchEmbeddings = `-- name: SearchEmbeddings :many
+SELECT
+  e.id,
+  e.message_id,
+  e.memory_id,
+  e.document_id,
+  e.content,
+  doc.name AS document_name,
+  (e.embedding <=> $1::vector)::float8 AS distance
+FROM embeddings e
+LEFT JOIN messages msg ON msg.id = e.message_id
+LEFT JOIN memories mem ON mem.id = e.memory_id
+LEFT JOIN documents doc ON doc.id = e.document_id
+WHERE e.embedder = $2
+  AND (
+    msg.conversation_id IN (SELECT cp.conversation_id FROM conversation_participants cp WHERE cp.user_id = $3)
+    OR (e.memory_id IS NOT NULL AND (mem.user_id IS NULL OR mem.user_id = $3))
+    OR doc.user_id = $3
+  )
+  AND COALESCE(e.message_id, e.memory_id, e.document_id) <> ALL($4::uuid[])
+ORDER BY e.embedding <=> $1::vector
+LIMIT $5
+`
+
+type SearchEmbeddingsParams struct {
+	Query      pgvector.Vector
+	Embedder   string
+	UserID     uuid.UUID
+	Exclude    []uuid.UUID
+	MaxResults int32
+}
+
+type SearchEmbeddingsRow struct {
+	ID           uuid.UUID
+	MessageID    uuid.NullUUID
+	MemoryID     uuid.NullUUID
+	DocumentID   uuid.NullUUID
+	Content      string
+	DocumentName sql.NullString
+	Distance     float64
+}
+
+// the chunks nearest to a query vector that a user may see: messages of their conversations,
+// their own and the global memories, and their documents. excluded are the given messages and memories,
+// ones the model sees anyway
+func (q *Queries) SearchEmbeddings(ctx context.Context, arg SearchEmbeddingsParams) ([]SearchEmbeddingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchEmbeddings,
+		arg.Query,
+		arg.Embedder,
+		arg.UserID,
+		pq.Array(arg.Exclude),
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchEmbeddingsRow
+	for rows.Next() {
+		var i SearchEmbeddingsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.MemoryID,
+			&i.DocumentID,
+			&i.Content,
+			&i.DocumentName,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mcp_servers.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/lib/pq"
+)
+
+const createMCPServer = `-- name: CreateMCPServer :one
+INSERT INTO mcp_servers (id, created_at, updated_at, name, transport, command, args, env, url, headers)
+VALUES (
+  gen_random_uuid(),
+  NOW(),
+  NOW(),
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+RETURNING id, created_at, updated_at, name, transport, command, args, env, enabled, url, headers
+`
+
+type CreateMCPServerParams struct {
+	Name      string
+	Transport string
+	Command   sql.NullString
+	Args      []string
+	Env       json.RawMessage
+	Url       sql.NullString
+	Headers   json.RawMessage
+}
+
+func (q *Queries) CreateMCPServer(ctx context.Context, arg CreateMCPServerParams) (McpServer, error) {
+	row := q.db.QueryRowContext(ctx, createMCPServer,
+		arg.Name,
+		arg.Transport,
+		arg.Command,
+		pq.Array(arg.Args),
+		arg.Env,
+		arg.Url,
+		arg.Headers,
+	)
+	var i McpServer
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.Transport,
+		&i.Command,
+		pq.Array(&i.Args),
+		&i.Env,
+		&i.Enabled,
+		&i.Url,
+		&i.Headers,
+	)
+	return i, err
+}
+
+const deleteMCPServer = `-- name: DeleteMCPServer :exec
+DELETE FROM mcp_servers
+WHERE name = $1
+`
+
+func (q *Queries) DeleteMCPServer(ctx context.Context, name string) error {
+	_, err := q.db.ExecContext(ctx, deleteMCPServer, name)
+	return err
+}
+
+const getMCPServer = `-- name: GetMCPServer :one
+SELECT id, created_at, updated_at, name, transport, command, args, env, enabled, url, headers FROM mcp_servers
+WHERE name = $1
+`
+
+func (q *Queries) GetMCPServer(ctx context.Context, name string) (McpServer, error) {
+	row := q.db.QueryRowContext(ctx, getMCPServer, name)
+	var i McpServer
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.Transport,
+		&i.Command,
+		pq.Array(&i.Args),
+		&i.Env,
+		&i.Enabled,
+		&i.Url,
+		&i.Headers,
+	)
+	return i, err
+}
+
+const listEnabledMCPServers = `-- name: ListEnabledMCPServers :many
+SELECT id, created_at, updated_at, name, transport, command, args, env, enabled, url, headers FROM mcp_servers
+WHERE enabled
+ORDER BY name
+`
+
+func (q *Queries) ListEnabledMCPServers(ctx context.Context) ([]McpServer, error) {
+	rows, err := q.db.QueryContext(ctx, listEnabledMCPServers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []McpServer
+	for rows.Next() {
+		var i McpServer
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.Transport,
+			&i.Command,
+			pq.Array(&i.Args),
+			&i.Env,
+			&i.Enabled,
+			&i.Url,
+			&i.Headers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMCPServers = `-- name: ListMCPServers :many
+SELECT id, created_at, updated_at, name, transport, command, args, env, enabled, url, headers FROM mcp_servers
+ORDER BY name
+`
+
+func (q *Queries) ListMCPServers(ctx context.Context) ([]McpServer, error) {
+	rows, err := q.db.QueryContext(ctx, listMCPServers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []McpServer
+	for rows.Next() {
+		var i McpServer
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.Transport,
+			&i.Command,
+			pq.Array(&i.Args),
+			&i.Env,
+			&i.Enabled,
+			&i.Url,
+			&i.Headers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
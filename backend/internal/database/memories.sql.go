@@ -0,0 +1,252 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: memories.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const addMemorySource = `-- name: AddMemorySource :exec
+INSERT INTO memory_sources (memory_id, message_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddMemorySourceParams struct {
+	MemoryID  uuid.UUID
+	MessageID uuid.UUID
+}
+
+func (q *Queries) AddMemorySource(ctx context.Context, arg AddMemorySourceParams) error {
+	_, err := q.db.ExecContext(ctx, addMemorySource, arg.MemoryID, arg.MessageID)
+	return err
+}
+
+const createMemory = `-- name: CreateMemory :one
+INSERT INTO memories (user_id, content, normalized)
+VALUES ($1, $2, $3)
+RETURNING id, user_id, content, normalized, created_at, updated_at
+`
+
+type CreateMemoryParams struct {
+	UserID     uuid.NullUUID
+	Content    string
+	Normalized string
+}
+
+func (q *Queries) CreateMemory(ctx context.Context, arg CreateMemoryParams) (Memory, error) {
+	row := q.db.QueryRowContext(ctx, createMemory, arg.UserID, arg.Content, arg.Normalized)
+	var i Memory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Content,
+		&i.Normalized,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteMemory = `-- name: DeleteMemory :execrows
+DELETE FROM memories
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMemory(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMemory, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findMemory = `-- name: FindMemory :one
+SELECT id, user_id, content, normalized, created_at, updated_at FROM memories
+WHERE user_id IS NOT DISTINCT FROM $1::uuid AND normalized = $2
+`
+
+type FindMemoryParams struct {
+	UserID     uuid.NullUUID
+	Normalized string
+}
+
+// the memory a fact duplicates, if any
+func (q *Queries) FindMemory(ctx context.Context, arg FindMemoryParams) (Memory, error) {
+	row := q.db.QueryRowContext(ctx, findMemory, arg.UserID, arg.Normalized)
+	var i Memory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Content,
+		&i.Normalized,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMemory = `-- name: GetMemory :one
+SELECT id, user_id, content, normalized, created_at, updated_at FROM memories
+WHERE id = $1
+`
+
+func (q *Queries) GetMemory(ctx context.Context, id uuid.UUID) (Memory, error) {
+	row := q.db.QueryRowContext(ctx, getMemory, id)
+	var i Memory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Content,
+		&i.Normalized,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listMemories = `-- name: ListMemories :many
+SELECT id, user_id, content, normalized, created_at, updated_at FROM memories
+WHERE user_id = $1 OR user_id IS NULL
+ORDER BY user_id NULLS LAST, created_at
+`
+
+// a user's memories, followed by the global ones
+func (q *Queries) ListMemories(ctx context.Context, userID uuid.NullUUID) ([]Memory, error) {
+	rows, err := q.db.QueryContext(ctx, listMemories, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Memory
+	for rows.Next() {
+		var i Memory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Content,
+			&i.Normalized,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMemorySources = `-- name: ListMemorySources :many
+SELECT memory_id, message_id FROM memory_sources
+WHERE memory_id = ANY($1::uuid[])
+`
+
+func (q *Queries) ListMemorySources(ctx context.Context, memoryIds []uuid.UUID) ([]MemorySource, error) {
+	rows, err := q.db.QueryContext(ctx, listMemorySources, pq.Array(memoryIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MemorySource
+	for rows.Next() {
+		var i MemorySource
+		if err := rows.Scan(&i.MemoryID, &i.MessageID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recallMemories = `-- name: RecallMemories :many
+SELECT id, user_id, content, normalized, created_at, updated_at FROM memories
+WHERE (user_id = $1 OR user_id IS NULL)
+  AND to_tsvector('english', content) @@ to_tsquery('english', $2)
+ORDER BY ts_rank(to_tsvector('english', content), to_tsquery('english', $2)) DESC, created_at DESC
+LIMIT $3
+`
+
+type RecallMemoriesParams struct {
+	UserID     uuid.NullUUID
+	Query      string
+	MaxResults int32
+}
+
+// a user's and the global memories matching a text search query, best match first
+func (q *Queries) RecallMemories(ctx context.Context, arg RecallMemoriesParams) ([]Memory, error) {
+	rows, err := q.db.QueryContext(ctx, recallMemories, arg.UserID, arg.Query, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Memory
+	for rows.Next() {
+		var i Memory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Content,
+			&i.Normalized,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateMemory = `-- name: UpdateMemory :one
+UPDATE memories
+SET
+  content = $2,
+  normalized = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, user_id, content, normalized, created_at, updated_at
+`
+
+type UpdateMemoryParams struct {
+	ID         uuid.UUID
+	Content    string
+	Normalized string
+}
+
+func (q *Queries) UpdateMemory(ctx context.Context, arg UpdateMemoryParams) (Memory, error) {
+	row := q.db.QueryRowContext(ctx, updateMemory, arg.ID, arg.Content, arg.Normalized)
+	var i Memory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Content,
+		&i.Normalized,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,106 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: message_search.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const searchMessages = `-- name: SearchMessages :many
+SELECT
+  m.id,
+  m.conversation_id,
+  c.name AS conversation_name,
+  m.role,
+  m.created_at,
+  ts_headline(
+    'english',
+    COALESCE(m.content->>'text', ''),
+    websearch_to_tsquery('english', $1),
+    'StartSel=**, StopSel=**, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "'
+  )::text AS snippet
+FROM messages m
+JOIN conversations c ON c.id = m.conversation_id
+WHERE to_tsvector('english', COALESCE(m.content->>'text', '')) @@ websearch_to_tsquery('english', $1)
+  AND m.conversation_id IN (SELECT cp.conversation_id FROM conversation_participants cp WHERE cp.user_id = $2)
+  AND ($3::uuid IS NULL OR m.conversation_id = $3::uuid)
+  AND ($4::text IS NULL OR m.role = $4::text)
+  AND ($5::timestamp IS NULL OR m.created_at >= $5::timestamp)
+  AND ($6::timestamp IS NULL OR m.created_at < $6::timestamp)
+  AND (
+    $7::timestamp IS NULL
+    OR (m.created_at, m.id) < ($7::timestamp, $8::uuid)
+  )
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT $9
+`
+
+type SearchMessagesParams struct {
+	Query           string
+	UserID          uuid.UUID
+	ConversationID  uuid.NullUUID
+	Role            sql.NullString
+	Since           sql.NullTime
+	Until           sql.NullTime
+	BeforeCreatedAt sql.NullTime
+	BeforeID        uuid.NullUUID
+	MaxResults      int32
+}
+
+type SearchMessagesRow struct {
+	ID               uuid.UUID
+	ConversationID   uuid.UUID
+	ConversationName sql.NullString
+	Role             string
+	CreatedAt        time.Time
+	Snippet          string
+}
+
+// messages matching a web search style query in the conversations a user participates in, newest first.
+// the filters are optional, and the cursor is the created_at and id of the last message of the previous page
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]SearchMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchMessages,
+		arg.Query,
+		arg.UserID,
+		arg.ConversationID,
+		arg.Role,
+		arg.Since,
+		arg.Until,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMessagesRow
+	for rows.Next() {
+		var i SearchMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConversationID,
+			&i.ConversationName,
+			&i.Role,
+			&i.CreatedAt,
+			&i.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -7,6 +7,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"time"
 
@@ -14,26 +15,33 @@ import (
 )
 
 const createMessage = `-- name: CreateMessage :one
-INSERT INTO messages (id, created_at, updated_at, conversation_id, role, content)
+INSERT INTO messages (id, created_at, updated_at, conversation_id, user_id, role, content)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
   $1,
   $2,
-  $3
+  $3,
+  $4
 )
 RETURNING id, created_at, updated_at, conversation_id, user_id, role, content
 `
 
 type CreateMessageParams struct {
 	ConversationID uuid.UUID
+	UserID         uuid.NullUUID
 	Role           string
 	Content        json.RawMessage
 }
 
 func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (Message, error) {
-	row := q.db.QueryRowContext(ctx, createMessage, arg.ConversationID, arg.Role, arg.Content)
+	row := q.db.QueryRowContext(ctx, createMessage,
+		arg.ConversationID,
+		arg.UserID,
+		arg.Role,
+		arg.Content,
+	)
 	var i Message
 	err := row.Scan(
 		&i.ID,
@@ -50,7 +58,9 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (M
 const getMessagesByConversation = `-- name: GetMessagesByConversation :many
 SELECT
   m.id, m.created_at, m.updated_at, m.conversation_id, m.user_id, m.role, m.content,
-  u.id, u.created_at, u.updated_at, u.name
+  u.name AS user_name,
+  u.created_at AS user_created_at,
+  u.updated_at AS user_updated_at
 FROM messages m
 LEFT JOIN users u ON m.user_id = u.id
 WHERE m.conversation_id = $1
@@ -65,9 +75,12 @@ type GetMessagesByConversationRow struct {
 	UserID         uuid.NullUUID
 	Role           string
 	Content        json.RawMessage
-	User           User
+	UserName       sql.NullString
+	UserCreatedAt  sql.NullTime
+	UserUpdatedAt  sql.NullTime
 }
 
+// user columns are selected individually since the join is nullable for assistant messages
 func (q *Queries) GetMessagesByConversation(ctx context.Context, conversationID uuid.UUID) ([]GetMessagesByConversationRow, error) {
 	rows, err := q.db.QueryContext(ctx, getMessagesByConversation, conversationID)
 	if err != nil {
@@ -85,10 +98,9 @@ func (q *Queries) GetMessagesByConversation(ctx context.Context, conversationID
 			&i.UserID,
 			&i.Role,
 			&i.Content,
-			&i.User.ID,
-			&i.User.CreatedAt,
-			&i.User.UpdatedAt,
-			&i.User.Name,
+			&i.UserName,
+			&i.UserCreatedAt,
+			&i.UserUpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -9,25 +9,49 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/curator4/io/backend/internal/pgvector"
 	"github.com/google/uuid"
 )
 
 type AiConfig struct {
-	ID           uuid.UUID
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastUsedAt   sql.NullTime
-	Name         string
-	ModelID      uuid.UUID
-	SystemPrompt sql.NullString
+	ID                   uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           sql.NullTime
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	Temperature          sql.NullFloat64
+	TopP                 sql.NullFloat64
+	MaxOutputTokens      sql.NullInt32
+	ReasoningEffort      sql.NullString
+	StopSequences        []string
+	ProviderOptions      json.RawMessage
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
 }
 
-type Conversation struct {
-	ID         uuid.UUID
+type AiConfigMcpServer struct {
+	AiConfigID  uuid.UUID
+	McpServerID uuid.UUID
+	CreatedAt   time.Time
+}
+
+type AiConfigToolRule struct {
+	AiConfigID uuid.UUID
+	ToolName   string
+	Rule       string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
-	LastUsedAt sql.NullTime
-	Name       sql.NullString
+}
+
+type Conversation struct {
+	ID                   uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           sql.NullTime
+	Name                 sql.NullString
+	PersonalityVersionID uuid.NullUUID
 }
 
 type ConversationParticipant struct {
@@ -36,6 +60,64 @@ type ConversationParticipant struct {
 	JoinedAt       time.Time
 }
 
+type ConversationSummary struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	FirstMessageID uuid.UUID
+	LastMessageID  uuid.UUID
+	MessageCount   int32
+	Content        string
+	CreatedAt      time.Time
+}
+
+type Document struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Content   string
+	CreatedAt time.Time
+}
+
+type Embedding struct {
+	ID         uuid.UUID
+	MessageID  uuid.NullUUID
+	MemoryID   uuid.NullUUID
+	DocumentID uuid.NullUUID
+	ChunkIndex int32
+	Content    string
+	Embedder   string
+	Embedding  pgvector.Vector
+	CreatedAt  time.Time
+}
+
+type McpServer struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	Transport string
+	Command   sql.NullString
+	Args      []string
+	Env       json.RawMessage
+	Enabled   bool
+	Url       sql.NullString
+	Headers   json.RawMessage
+}
+
+type Memory struct {
+	ID         uuid.UUID
+	UserID     uuid.NullUUID
+	Content    string
+	Normalized string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type MemorySource struct {
+	MemoryID  uuid.UUID
+	MessageID uuid.UUID
+}
+
 type Message struct {
 	ID             uuid.UUID
 	CreatedAt      time.Time
@@ -47,11 +129,41 @@ type Message struct {
 }
 
 type Model struct {
+	ID                uuid.UUID
+	CreatedAt         time.Time
+	ProviderID        uuid.UUID
+	Name              string
+	Description       sql.NullString
+	UpdatedAt         time.Time
+	ProviderModelID   string
+	ContextWindow     sql.NullInt32
+	MaxOutputTokens   sql.NullInt32
+	SupportsVision    bool
+	SupportsTools     bool
+	SupportsStreaming bool
+	SupportsReasoning bool
+}
+
+type Personality struct {
 	ID          uuid.UUID
-	CreatedAt   time.Time
-	ProviderID  uuid.UUID
 	Name        string
-	Description sql.NullString
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type PersonalityVersion struct {
+	ID              uuid.UUID
+	PersonalityID   uuid.UUID
+	Version         int32
+	PromptTemplate  string
+	StyleRules      []string
+	Examples        json.RawMessage
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+	CreatedAt       time.Time
 }
 
 type Provider struct {
@@ -59,6 +171,24 @@ type Provider struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Name      string
+	BaseUrl   sql.NullString
+	ApiKeyEnv sql.NullString
+	Headers   json.RawMessage
+	ApiMode   string
+}
+
+type ToolCallApproval struct {
+	ID        uuid.UUID
+	MessageID uuid.UUID
+	CallID    string
+	ToolName  string
+	Arguments string
+	Status    string
+	DecidedBy uuid.NullUUID
+	Reason    sql.NullString
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	DecidedAt sql.NullTime
 }
 
 type User struct {
@@ -67,3 +197,11 @@ type User struct {
 	UpdatedAt time.Time
 	Name      string
 }
+
+type UserState struct {
+	UserID         uuid.UUID
+	ChannelID      string
+	AiConfigID     uuid.NullUUID
+	ConversationID uuid.NullUUID
+	UpdatedAt      time.Time
+}
@@ -13,26 +13,54 @@ import (
 )
 
 const createModel = `-- name: CreateModel :one
-INSERT INTO models (id, created_at, updated_at, provider_id, name, description)
+INSERT INTO models (
+  id, created_at, updated_at, provider_id, name, description, provider_model_id, context_window,
+  max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning
+)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
   $1,
   $2,
-  $3
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10
 )
-RETURNING id, created_at, provider_id, name, description
+RETURNING id, created_at, provider_id, name, description, updated_at, provider_model_id, context_window, max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning
 `
 
 type CreateModelParams struct {
-	ProviderID  uuid.UUID
-	Name        string
-	Description sql.NullString
+	ProviderID        uuid.UUID
+	Name              string
+	Description       sql.NullString
+	ProviderModelID   string
+	ContextWindow     sql.NullInt32
+	MaxOutputTokens   sql.NullInt32
+	SupportsVision    bool
+	SupportsTools     bool
+	SupportsStreaming bool
+	SupportsReasoning bool
 }
 
 func (q *Queries) CreateModel(ctx context.Context, arg CreateModelParams) (Model, error) {
-	row := q.db.QueryRowContext(ctx, createModel, arg.ProviderID, arg.Name, arg.Description)
+	row := q.db.QueryRowContext(ctx, createModel,
+		arg.ProviderID,
+		arg.Name,
+		arg.Description,
+		arg.ProviderModelID,
+		arg.ContextWindow,
+		arg.MaxOutputTokens,
+		arg.SupportsVision,
+		arg.SupportsTools,
+		arg.SupportsStreaming,
+		arg.SupportsReasoning,
+	)
 	var i Model
 	err := row.Scan(
 		&i.ID,
@@ -40,22 +68,33 @@ func (q *Queries) CreateModel(ctx context.Context, arg CreateModelParams) (Model
 		&i.ProviderID,
 		&i.Name,
 		&i.Description,
+		&i.UpdatedAt,
+		&i.ProviderModelID,
+		&i.ContextWindow,
+		&i.MaxOutputTokens,
+		&i.SupportsVision,
+		&i.SupportsTools,
+		&i.SupportsStreaming,
+		&i.SupportsReasoning,
 	)
 	return i, err
 }
 
-const deleteModel = `-- name: DeleteModel :exec
+const deleteModel = `-- name: DeleteModel :execrows
 DELETE FROM models
 WHERE id = $1
 `
 
-func (q *Queries) DeleteModel(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteModel, id)
-	return err
+func (q *Queries) DeleteModel(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteModel, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getModelByID = `-- name: GetModelByID :one
-SELECT id, created_at, provider_id, name, description FROM models
+SELECT id, created_at, provider_id, name, description, updated_at, provider_model_id, context_window, max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning FROM models
 WHERE id = $1
 `
 
@@ -68,12 +107,20 @@ func (q *Queries) GetModelByID(ctx context.Context, id uuid.UUID) (Model, error)
 		&i.ProviderID,
 		&i.Name,
 		&i.Description,
+		&i.UpdatedAt,
+		&i.ProviderModelID,
+		&i.ContextWindow,
+		&i.MaxOutputTokens,
+		&i.SupportsVision,
+		&i.SupportsTools,
+		&i.SupportsStreaming,
+		&i.SupportsReasoning,
 	)
 	return i, err
 }
 
 const getModelByName = `-- name: GetModelByName :one
-SELECT id, created_at, provider_id, name, description FROM models
+SELECT id, created_at, provider_id, name, description, updated_at, provider_model_id, context_window, max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning FROM models
 WHERE name = $1
 `
 
@@ -86,12 +133,20 @@ func (q *Queries) GetModelByName(ctx context.Context, name string) (Model, error
 		&i.ProviderID,
 		&i.Name,
 		&i.Description,
+		&i.UpdatedAt,
+		&i.ProviderModelID,
+		&i.ContextWindow,
+		&i.MaxOutputTokens,
+		&i.SupportsVision,
+		&i.SupportsTools,
+		&i.SupportsStreaming,
+		&i.SupportsReasoning,
 	)
 	return i, err
 }
 
 const listModels = `-- name: ListModels :many
-SELECT id, created_at, provider_id, name, description FROM models
+SELECT id, created_at, provider_id, name, description, updated_at, provider_model_id, context_window, max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning FROM models
 ORDER BY name
 `
 
@@ -110,6 +165,14 @@ func (q *Queries) ListModels(ctx context.Context) ([]Model, error) {
 			&i.ProviderID,
 			&i.Name,
 			&i.Description,
+			&i.UpdatedAt,
+			&i.ProviderModelID,
+			&i.ContextWindow,
+			&i.MaxOutputTokens,
+			&i.SupportsVision,
+			&i.SupportsTools,
+			&i.SupportsStreaming,
+			&i.SupportsReasoning,
 		); err != nil {
 			return nil, err
 		}
@@ -125,7 +188,7 @@ func (q *Queries) ListModels(ctx context.Context) ([]Model, error) {
 }
 
 const listModelsByProvider = `-- name: ListModelsByProvider :many
-SELECT id, created_at, provider_id, name, description FROM models
+SELECT id, created_at, provider_id, name, description, updated_at, provider_model_id, context_window, max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning FROM models
 WHERE provider_id = $1
 ORDER BY name
 `
@@ -145,6 +208,14 @@ func (q *Queries) ListModelsByProvider(ctx context.Context, providerID uuid.UUID
 			&i.ProviderID,
 			&i.Name,
 			&i.Description,
+			&i.UpdatedAt,
+			&i.ProviderModelID,
+			&i.ContextWindow,
+			&i.MaxOutputTokens,
+			&i.SupportsVision,
+			&i.SupportsTools,
+			&i.SupportsStreaming,
+			&i.SupportsReasoning,
 		); err != nil {
 			return nil, err
 		}
@@ -158,3 +229,65 @@ func (q *Queries) ListModelsByProvider(ctx context.Context, providerID uuid.UUID
 	}
 	return items, nil
 }
+
+const updateModel = `-- name: UpdateModel :one
+UPDATE models
+SET
+  name = $2,
+  description = $3,
+  provider_model_id = $4,
+  context_window = $5,
+  max_output_tokens = $6,
+  supports_vision = $7,
+  supports_tools = $8,
+  supports_streaming = $9,
+  supports_reasoning = $10,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING id, created_at, provider_id, name, description, updated_at, provider_model_id, context_window, max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning
+`
+
+type UpdateModelParams struct {
+	ID                uuid.UUID
+	Name              string
+	Description       sql.NullString
+	ProviderModelID   string
+	ContextWindow     sql.NullInt32
+	MaxOutputTokens   sql.NullInt32
+	SupportsVision    bool
+	SupportsTools     bool
+	SupportsStreaming bool
+	SupportsReasoning bool
+}
+
+func (q *Queries) UpdateModel(ctx context.Context, arg UpdateModelParams) (Model, error) {
+	row := q.db.QueryRowContext(ctx, updateModel,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.ProviderModelID,
+		arg.ContextWindow,
+		arg.MaxOutputTokens,
+		arg.SupportsVision,
+		arg.SupportsTools,
+		arg.SupportsStreaming,
+		arg.SupportsReasoning,
+	)
+	var i Model
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ProviderID,
+		&i.Name,
+		&i.Description,
+		&i.UpdatedAt,
+		&i.ProviderModelID,
+		&i.ContextWindow,
+		&i.MaxOutputTokens,
+		&i.SupportsVision,
+		&i.SupportsTools,
+		&i.SupportsStreaming,
+		&i.SupportsReasoning,
+	)
+	return i, err
+}
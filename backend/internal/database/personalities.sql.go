@@ -0,0 +1,404 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: personalities.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countAIConfigsByPersonality = `-- name: CountAIConfigsByPersonality :one
+SELECT COUNT(*) FROM ai_configs ac
+JOIN personality_versions pv ON ac.personality_version_id = pv.id
+WHERE pv.personality_id = $1
+`
+
+func (q *Queries) CountAIConfigsByPersonality(ctx context.Context, personalityID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAIConfigsByPersonality, personalityID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPersonality = `-- name: CreatePersonality :one
+INSERT INTO personalities (name, description)
+VALUES ($1, $2)
+RETURNING id, name, description, created_at, updated_at
+`
+
+type CreatePersonalityParams struct {
+	Name        string
+	Description string
+}
+
+func (q *Queries) CreatePersonality(ctx context.Context, arg CreatePersonalityParams) (Personality, error) {
+	row := q.db.QueryRowContext(ctx, createPersonality, arg.Name, arg.Description)
+	var i Personality
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createPersonalityVersion = `-- name: CreatePersonalityVersion :one
+INSERT INTO personality_versions (
+  personality_id, version, prompt_template, style_rules, examples,
+  temperature, top_p, max_output_tokens, reasoning_effort
+)
+VALUES (
+  $1,
+  (SELECT COALESCE(MAX(version), 0) + 1 FROM personality_versions WHERE personality_id = $1),
+  $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id, personality_id, version, prompt_template, style_rules, examples, temperature, top_p, max_output_tokens, reasoning_effort, created_at
+`
+
+type CreatePersonalityVersionParams struct {
+	PersonalityID   uuid.UUID
+	PromptTemplate  string
+	StyleRules      []string
+	Examples        json.RawMessage
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+}
+
+// versions count up per personality, the unique constraint catches concurrent edits
+func (q *Queries) CreatePersonalityVersion(ctx context.Context, arg CreatePersonalityVersionParams) (PersonalityVersion, error) {
+	row := q.db.QueryRowContext(ctx, createPersonalityVersion,
+		arg.PersonalityID,
+		arg.PromptTemplate,
+		pq.Array(arg.StyleRules),
+		arg.Examples,
+		arg.Temperature,
+		arg.TopP,
+		arg.MaxOutputTokens,
+		arg.ReasoningEffort,
+	)
+	var i PersonalityVersion
+	err := row.Scan(
+		&i.ID,
+		&i.PersonalityID,
+		&i.Version,
+		&i.PromptTemplate,
+		pq.Array(&i.StyleRules),
+		&i.Examples,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deletePersonality = `-- name: DeletePersonality :execrows
+DELETE FROM personalities
+WHERE id = $1
+`
+
+func (q *Queries) DeletePersonality(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePersonality, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getLatestPersonalityVersion = `-- name: GetLatestPersonalityVersion :one
+SELECT pv.id, pv.personality_id, pv.version, pv.prompt_template, pv.style_rules, pv.examples, pv.temperature, pv.top_p, pv.max_output_tokens, pv.reasoning_effort, pv.created_at, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+WHERE pv.personality_id = $1
+ORDER BY pv.version DESC
+LIMIT 1
+`
+
+type GetLatestPersonalityVersionRow struct {
+	ID              uuid.UUID
+	PersonalityID   uuid.UUID
+	Version         int32
+	PromptTemplate  string
+	StyleRules      []string
+	Examples        json.RawMessage
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+	CreatedAt       time.Time
+	PersonalityName string
+}
+
+func (q *Queries) GetLatestPersonalityVersion(ctx context.Context, personalityID uuid.UUID) (GetLatestPersonalityVersionRow, error) {
+	row := q.db.QueryRowContext(ctx, getLatestPersonalityVersion, personalityID)
+	var i GetLatestPersonalityVersionRow
+	err := row.Scan(
+		&i.ID,
+		&i.PersonalityID,
+		&i.Version,
+		&i.PromptTemplate,
+		pq.Array(&i.StyleRules),
+		&i.Examples,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		&i.CreatedAt,
+		&i.PersonalityName,
+	)
+	return i, err
+}
+
+const getPersonality = `-- name: GetPersonality :one
+SELECT id, name, description, created_at, updated_at FROM personalities
+WHERE id = $1
+`
+
+func (q *Queries) GetPersonality(ctx context.Context, id uuid.UUID) (Personality, error) {
+	row := q.db.QueryRowContext(ctx, getPersonality, id)
+	var i Personality
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPersonalityVersion = `-- name: GetPersonalityVersion :one
+SELECT pv.id, pv.personality_id, pv.version, pv.prompt_template, pv.style_rules, pv.examples, pv.temperature, pv.top_p, pv.max_output_tokens, pv.reasoning_effort, pv.created_at, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+WHERE pv.id = $1
+`
+
+type GetPersonalityVersionRow struct {
+	ID              uuid.UUID
+	PersonalityID   uuid.UUID
+	Version         int32
+	PromptTemplate  string
+	StyleRules      []string
+	Examples        json.RawMessage
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+	CreatedAt       time.Time
+	PersonalityName string
+}
+
+func (q *Queries) GetPersonalityVersion(ctx context.Context, id uuid.UUID) (GetPersonalityVersionRow, error) {
+	row := q.db.QueryRowContext(ctx, getPersonalityVersion, id)
+	var i GetPersonalityVersionRow
+	err := row.Scan(
+		&i.ID,
+		&i.PersonalityID,
+		&i.Version,
+		&i.PromptTemplate,
+		pq.Array(&i.StyleRules),
+		&i.Examples,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		&i.CreatedAt,
+		&i.PersonalityName,
+	)
+	return i, err
+}
+
+const listLatestPersonalityVersions = `-- name: ListLatestPersonalityVersions :many
+SELECT DISTINCT ON (pv.personality_id) pv.id, pv.personality_id, pv.version, pv.prompt_template, pv.style_rules, pv.examples, pv.temperature, pv.top_p, pv.max_output_tokens, pv.reasoning_effort, pv.created_at, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+ORDER BY pv.personality_id, pv.version DESC
+`
+
+type ListLatestPersonalityVersionsRow struct {
+	ID              uuid.UUID
+	PersonalityID   uuid.UUID
+	Version         int32
+	PromptTemplate  string
+	StyleRules      []string
+	Examples        json.RawMessage
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+	CreatedAt       time.Time
+	PersonalityName string
+}
+
+// the newest version of every personality
+func (q *Queries) ListLatestPersonalityVersions(ctx context.Context) ([]ListLatestPersonalityVersionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestPersonalityVersions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLatestPersonalityVersionsRow
+	for rows.Next() {
+		var i ListLatestPersonalityVersionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PersonalityID,
+			&i.Version,
+			&i.PromptTemplate,
+			pq.Array(&i.StyleRules),
+			&i.Examples,
+			&i.Temperature,
+			&i.TopP,
+			&i.MaxOutputTokens,
+			&i.ReasoningEffort,
+			&i.CreatedAt,
+			&i.PersonalityName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPersonalities = `-- name: ListPersonalities :many
+SELECT id, name, description, created_at, updated_at FROM personalities
+ORDER BY name
+`
+
+func (q *Queries) ListPersonalities(ctx context.Context) ([]Personality, error) {
+	rows, err := q.db.QueryContext(ctx, listPersonalities)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Personality
+	for rows.Next() {
+		var i Personality
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPersonalityVersions = `-- name: ListPersonalityVersions :many
+SELECT pv.id, pv.personality_id, pv.version, pv.prompt_template, pv.style_rules, pv.examples, pv.temperature, pv.top_p, pv.max_output_tokens, pv.reasoning_effort, pv.created_at, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+WHERE pv.personality_id = $1
+ORDER BY pv.version DESC
+`
+
+type ListPersonalityVersionsRow struct {
+	ID              uuid.UUID
+	PersonalityID   uuid.UUID
+	Version         int32
+	PromptTemplate  string
+	StyleRules      []string
+	Examples        json.RawMessage
+	Temperature     sql.NullFloat64
+	TopP            sql.NullFloat64
+	MaxOutputTokens sql.NullInt32
+	ReasoningEffort sql.NullString
+	CreatedAt       time.Time
+	PersonalityName string
+}
+
+func (q *Queries) ListPersonalityVersions(ctx context.Context, personalityID uuid.UUID) ([]ListPersonalityVersionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPersonalityVersions, personalityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPersonalityVersionsRow
+	for rows.Next() {
+		var i ListPersonalityVersionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PersonalityID,
+			&i.Version,
+			&i.PromptTemplate,
+			pq.Array(&i.StyleRules),
+			&i.Examples,
+			&i.Temperature,
+			&i.TopP,
+			&i.MaxOutputTokens,
+			&i.ReasoningEffort,
+			&i.CreatedAt,
+			&i.PersonalityName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePersonality = `-- name: UpdatePersonality :one
+UPDATE personalities
+SET
+  name = $2,
+  description = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, name, description, created_at, updated_at
+`
+
+type UpdatePersonalityParams struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+}
+
+func (q *Queries) UpdatePersonality(ctx context.Context, arg UpdatePersonalityParams) (Personality, error) {
+	row := q.db.QueryRowContext(ctx, updatePersonality, arg.ID, arg.Name, arg.Description)
+	var i Personality
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
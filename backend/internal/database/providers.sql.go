@@ -7,43 +7,72 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
 )
 
 const createProvider = `-- name: CreateProvider :one
-INSERT INTO providers (id, created_at, updated_at, name)
+INSERT INTO providers (id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
-  $1
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
 )
-RETURNING id, created_at, updated_at, name
+RETURNING id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode
 `
 
-func (q *Queries) CreateProvider(ctx context.Context, name string) (Provider, error) {
-	row := q.db.QueryRowContext(ctx, createProvider, name)
+type CreateProviderParams struct {
+	Name      string
+	BaseUrl   sql.NullString
+	ApiKeyEnv sql.NullString
+	Headers   json.RawMessage
+	ApiMode   string
+}
+
+func (q *Queries) CreateProvider(ctx context.Context, arg CreateProviderParams) (Provider, error) {
+	row := q.db.QueryRowContext(ctx, createProvider,
+		arg.Name,
+		arg.BaseUrl,
+		arg.ApiKeyEnv,
+		arg.Headers,
+		arg.ApiMode,
+	)
 	var i Provider
 	err := row.Scan(
 		&i.ID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Name,
+		&i.BaseUrl,
+		&i.ApiKeyEnv,
+		&i.Headers,
+		&i.ApiMode,
 	)
 	return i, err
 }
 
-const deleteProvider = `-- name: DeleteProvider :exec
+const deleteProvider = `-- name: DeleteProvider :execrows
 DELETE FROM providers
-WHERE name = $1
+WHERE id = $1
 `
 
-func (q *Queries) DeleteProvider(ctx context.Context, name string) error {
-	_, err := q.db.ExecContext(ctx, deleteProvider, name)
-	return err
+func (q *Queries) DeleteProvider(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProvider, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getProvider = `-- name: GetProvider :one
-SELECT id, created_at, updated_at, name FROM providers
+SELECT id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode FROM providers
 WHERE name = $1
 `
 
@@ -55,12 +84,37 @@ func (q *Queries) GetProvider(ctx context.Context, name string) (Provider, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Name,
+		&i.BaseUrl,
+		&i.ApiKeyEnv,
+		&i.Headers,
+		&i.ApiMode,
+	)
+	return i, err
+}
+
+const getProviderByID = `-- name: GetProviderByID :one
+SELECT id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode FROM providers
+WHERE id = $1
+`
+
+func (q *Queries) GetProviderByID(ctx context.Context, id uuid.UUID) (Provider, error) {
+	row := q.db.QueryRowContext(ctx, getProviderByID, id)
+	var i Provider
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.BaseUrl,
+		&i.ApiKeyEnv,
+		&i.Headers,
+		&i.ApiMode,
 	)
 	return i, err
 }
 
 const listProviders = `-- name: ListProviders :many
-SELECT id, created_at, updated_at, name FROM providers
+SELECT id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode FROM providers
 ORDER BY name
 `
 
@@ -78,6 +132,10 @@ func (q *Queries) ListProviders(ctx context.Context) ([]Provider, error) {
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Name,
+			&i.BaseUrl,
+			&i.ApiKeyEnv,
+			&i.Headers,
+			&i.ApiMode,
 		); err != nil {
 			return nil, err
 		}
@@ -91,3 +149,48 @@ func (q *Queries) ListProviders(ctx context.Context) ([]Provider, error) {
 	}
 	return items, nil
 }
+
+const updateProvider = `-- name: UpdateProvider :one
+UPDATE providers
+SET
+  name = $2,
+  base_url = $3,
+  api_key_env = $4,
+  headers = $5,
+  api_mode = $6,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode
+`
+
+type UpdateProviderParams struct {
+	ID        uuid.UUID
+	Name      string
+	BaseUrl   sql.NullString
+	ApiKeyEnv sql.NullString
+	Headers   json.RawMessage
+	ApiMode   string
+}
+
+func (q *Queries) UpdateProvider(ctx context.Context, arg UpdateProviderParams) (Provider, error) {
+	row := q.db.QueryRowContext(ctx, updateProvider,
+		arg.ID,
+		arg.Name,
+		arg.BaseUrl,
+		arg.ApiKeyEnv,
+		arg.Headers,
+		arg.ApiMode,
+	)
+	var i Provider
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.BaseUrl,
+		&i.ApiKeyEnv,
+		&i.Headers,
+		&i.ApiMode,
+	)
+	return i, err
+}
@@ -0,0 +1,151 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: tool_call_approvals.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createToolApproval = `-- name: CreateToolApproval :one
+INSERT INTO tool_call_approvals (message_id, call_id, tool_name, arguments, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, message_id, call_id, tool_name, arguments, status, decided_by, reason, created_at, expires_at, decided_at
+`
+
+type CreateToolApprovalParams struct {
+	MessageID uuid.UUID
+	CallID    string
+	ToolName  string
+	Arguments string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateToolApproval(ctx context.Context, arg CreateToolApprovalParams) (ToolCallApproval, error) {
+	row := q.db.QueryRowContext(ctx, createToolApproval,
+		arg.MessageID,
+		arg.CallID,
+		arg.ToolName,
+		arg.Arguments,
+		arg.ExpiresAt,
+	)
+	var i ToolCallApproval
+	err := row.Scan(
+		&i.ID,
+		&i.MessageID,
+		&i.CallID,
+		&i.ToolName,
+		&i.Arguments,
+		&i.Status,
+		&i.DecidedBy,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const decideToolApproval = `-- name: DecideToolApproval :one
+UPDATE tool_call_approvals
+SET status = $2, decided_by = $3, reason = $4, decided_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, message_id, call_id, tool_name, arguments, status, decided_by, reason, created_at, expires_at, decided_at
+`
+
+type DecideToolApprovalParams struct {
+	ID        uuid.UUID
+	Status    string
+	DecidedBy uuid.NullUUID
+	Reason    sql.NullString
+}
+
+// only pending approvals can be decided, the first decision wins
+func (q *Queries) DecideToolApproval(ctx context.Context, arg DecideToolApprovalParams) (ToolCallApproval, error) {
+	row := q.db.QueryRowContext(ctx, decideToolApproval,
+		arg.ID,
+		arg.Status,
+		arg.DecidedBy,
+		arg.Reason,
+	)
+	var i ToolCallApproval
+	err := row.Scan(
+		&i.ID,
+		&i.MessageID,
+		&i.CallID,
+		&i.ToolName,
+		&i.Arguments,
+		&i.Status,
+		&i.DecidedBy,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const expireOverdueToolApprovals = `-- name: ExpireOverdueToolApprovals :execrows
+UPDATE tool_call_approvals
+SET status = 'expired', decided_at = now()
+WHERE status = 'pending' AND expires_at < now()
+`
+
+// approvals still pending past their deadline belong to generations that died with their process, a live tool loop
+// expires its own approval on time. ones that aren't due yet may be waited on by another running backend
+func (q *Queries) ExpireOverdueToolApprovals(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expireOverdueToolApprovals)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getToolApproval = `-- name: GetToolApproval :one
+SELECT a.id, a.message_id, a.call_id, a.tool_name, a.arguments, a.status, a.decided_by, a.reason, a.created_at, a.expires_at, a.decided_at, m.conversation_id
+FROM tool_call_approvals a
+JOIN messages m ON m.id = a.message_id
+WHERE a.id = $1
+`
+
+type GetToolApprovalRow struct {
+	ID             uuid.UUID
+	MessageID      uuid.UUID
+	CallID         string
+	ToolName       string
+	Arguments      string
+	Status         string
+	DecidedBy      uuid.NullUUID
+	Reason         sql.NullString
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	DecidedAt      sql.NullTime
+	ConversationID uuid.UUID
+}
+
+// the conversation is joined in so callers can check the deciding user participates in it
+func (q *Queries) GetToolApproval(ctx context.Context, id uuid.UUID) (GetToolApprovalRow, error) {
+	row := q.db.QueryRowContext(ctx, getToolApproval, id)
+	var i GetToolApprovalRow
+	err := row.Scan(
+		&i.ID,
+		&i.MessageID,
+		&i.CallID,
+		&i.ToolName,
+		&i.Arguments,
+		&i.Status,
+		&i.DecidedBy,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DecidedAt,
+		&i.ConversationID,
+	)
+	return i, err
+}
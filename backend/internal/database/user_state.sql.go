@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: user_state.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const getUserAIConfig = `-- name: GetUserAIConfig :one
+SELECT
+  ac.id, ac.created_at, ac.updated_at, ac.last_used_at, ac.name, ac.model_id, ac.system_prompt, ac.temperature, ac.top_p, ac.max_output_tokens, ac.reasoning_effort, ac.stop_sequences, ac.provider_options, ac.context_strategy, ac.personality_version_id,
+  m.id, m.created_at, m.provider_id, m.name, m.description, m.updated_at, m.provider_model_id, m.context_window, m.max_output_tokens, m.supports_vision, m.supports_tools, m.supports_streaming, m.supports_reasoning
+FROM user_state us
+JOIN ai_configs ac ON us.ai_config_id = ac.id
+JOIN models m ON ac.model_id = m.id
+WHERE us.user_id = $1 AND (us.channel_id = $2 OR us.channel_id = '')
+ORDER BY us.channel_id DESC
+LIMIT 1
+`
+
+type GetUserAIConfigParams struct {
+	UserID    uuid.UUID
+	ChannelID string
+}
+
+type GetUserAIConfigRow struct {
+	ID                   uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           sql.NullTime
+	Name                 string
+	ModelID              uuid.UUID
+	SystemPrompt         sql.NullString
+	Temperature          sql.NullFloat64
+	TopP                 sql.NullFloat64
+	MaxOutputTokens      sql.NullInt32
+	ReasoningEffort      sql.NullString
+	StopSequences        []string
+	ProviderOptions      json.RawMessage
+	ContextStrategy      string
+	PersonalityVersionID uuid.NullUUID
+	Model                Model
+}
+
+// the channel's own selection wins over the user's overall one
+func (q *Queries) GetUserAIConfig(ctx context.Context, arg GetUserAIConfigParams) (GetUserAIConfigRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserAIConfig, arg.UserID, arg.ChannelID)
+	var i GetUserAIConfigRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastUsedAt,
+		&i.Name,
+		&i.ModelID,
+		&i.SystemPrompt,
+		&i.Temperature,
+		&i.TopP,
+		&i.MaxOutputTokens,
+		&i.ReasoningEffort,
+		pq.Array(&i.StopSequences),
+		&i.ProviderOptions,
+		&i.ContextStrategy,
+		&i.PersonalityVersionID,
+		&i.Model.ID,
+		&i.Model.CreatedAt,
+		&i.Model.ProviderID,
+		&i.Model.Name,
+		&i.Model.Description,
+		&i.Model.UpdatedAt,
+		&i.Model.ProviderModelID,
+		&i.Model.ContextWindow,
+		&i.Model.MaxOutputTokens,
+		&i.Model.SupportsVision,
+		&i.Model.SupportsTools,
+		&i.Model.SupportsStreaming,
+		&i.Model.SupportsReasoning,
+	)
+	return i, err
+}
+
+const getUserState = `-- name: GetUserState :one
+SELECT user_id, channel_id, ai_config_id, conversation_id, updated_at FROM user_state
+WHERE user_id = $1 AND channel_id = $2
+`
+
+type GetUserStateParams struct {
+	UserID    uuid.UUID
+	ChannelID string
+}
+
+func (q *Queries) GetUserState(ctx context.Context, arg GetUserStateParams) (UserState, error) {
+	row := q.db.QueryRowContext(ctx, getUserState, arg.UserID, arg.ChannelID)
+	var i UserState
+	err := row.Scan(
+		&i.UserID,
+		&i.ChannelID,
+		&i.AiConfigID,
+		&i.ConversationID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setUserAIConfig = `-- name: SetUserAIConfig :exec
+INSERT INTO user_state (user_id, channel_id, ai_config_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel_id) DO UPDATE
+SET
+  ai_config_id = EXCLUDED.ai_config_id,
+  updated_at = NOW()
+`
+
+type SetUserAIConfigParams struct {
+	UserID     uuid.UUID
+	ChannelID  string
+	AiConfigID uuid.NullUUID
+}
+
+func (q *Queries) SetUserAIConfig(ctx context.Context, arg SetUserAIConfigParams) error {
+	_, err := q.db.ExecContext(ctx, setUserAIConfig, arg.UserID, arg.ChannelID, arg.AiConfigID)
+	return err
+}
+
+const setUserConversation = `-- name: SetUserConversation :exec
+INSERT INTO user_state (user_id, channel_id, conversation_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel_id) DO UPDATE
+SET
+  conversation_id = EXCLUDED.conversation_id,
+  updated_at = NOW()
+`
+
+type SetUserConversationParams struct {
+	UserID         uuid.UUID
+	ChannelID      string
+	ConversationID uuid.NullUUID
+}
+
+func (q *Queries) SetUserConversation(ctx context.Context, arg SetUserConversationParams) error {
+	_, err := q.db.ExecContext(ctx, setUserConversation, arg.UserID, arg.ChannelID, arg.ConversationID)
+	return err
+}
@@ -22,11 +22,12 @@ func UserFromDB(u database.User) User {
 // ConversationFromDB converts a database Conversation to domain Conversation
 func ConversationFromDB(c database.Conversation) Conversation {
 	return Conversation{
-		ID:         c.ID,
-		Name:       sqlNullStringToString(c.Name),
-		CreatedAt:  c.CreatedAt,
-		UpdatedAt:  c.UpdatedAt,
-		LastUsedAt: sqlNullTimeToPtr(c.LastUsedAt),
+		ID:                   c.ID,
+		Name:                 sqlNullStringToString(c.Name),
+		PersonalityVersionID: nullUUIDToPtr(c.PersonalityVersionID),
+		CreatedAt:            c.CreatedAt,
+		UpdatedAt:            c.UpdatedAt,
+		LastUsedAt:           sqlNullTimeToPtr(c.LastUsedAt),
 	}
 }
 
@@ -38,9 +39,13 @@ func MessageFromDB(row database.GetMessagesByConversationRow) Message {
 	_ = json.Unmarshal(row.Content, &content)
 
 	var user *User
-	if row.User.ID != uuid.Nil {
-		u := UserFromDB(row.User)
-		user = &u
+	if row.UserID.Valid {
+		user = &User{
+			ID:        row.UserID.UUID,
+			Name:      sqlNullStringToString(row.UserName),
+			CreatedAt: row.UserCreatedAt.Time,
+			UpdatedAt: row.UserUpdatedAt.Time,
+		}
 	}
 
 	return Message{
@@ -55,9 +60,17 @@ func MessageFromDB(row database.GetMessagesByConversationRow) Message {
 
 // ProviderFromDB converts a database Provider to domain Provider
 func ProviderFromDB(p database.Provider) Provider {
+	// Headers are stored as JSONB, invalid JSON is treated as no headers
+	var headers map[string]string
+	_ = json.Unmarshal(p.Headers, &headers)
+
 	return Provider{
 		ID:        p.ID,
 		Name:      p.Name,
+		BaseURL:   sqlNullStringToString(p.BaseUrl),
+		APIKeyEnv: sqlNullStringToString(p.ApiKeyEnv),
+		Headers:   headers,
+		APIMode:   p.ApiMode,
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
 	}
@@ -66,27 +79,102 @@ func ProviderFromDB(p database.Provider) Provider {
 // ModelFromDB converts a database Model to domain Model
 func ModelFromDB(m database.Model) Model {
 	return Model{
-		ID:          m.ID,
-		ProviderID:  m.ProviderID,
-		Name:        m.Name,
-		Description: sqlNullStringToString(m.Description),
-		CreatedAt:   m.CreatedAt,
+		ID:              m.ID,
+		ProviderID:      m.ProviderID,
+		Name:            m.Name,
+		ProviderModelID: m.ProviderModelID,
+		Description:     sqlNullStringToString(m.Description),
+		ContextWindow:   m.ContextWindow.Int32,
+		MaxOutputTokens: m.MaxOutputTokens.Int32,
+		Capabilities: ModelCapabilities{
+			Vision:    m.SupportsVision,
+			Tools:     m.SupportsTools,
+			Streaming: m.SupportsStreaming,
+			Reasoning: m.SupportsReasoning,
+		},
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
 	}
 }
 
 // AIConfigFromDB converts a database query result to domain AIConfig
 func AIConfigFromDB(row database.GetAIConfigByIDRow) AIConfig {
 	return AIConfig{
-		ID:           row.ID,
-		Name:         row.Name,
-		Model:        ModelFromDB(row.Model),
-		SystemPrompt: sqlNullStringToString(row.SystemPrompt),
-		CreatedAt:    row.CreatedAt,
-		UpdatedAt:    row.UpdatedAt,
-		LastUsedAt:   sqlNullTimeToPtr(row.LastUsedAt),
+		ID:                   row.ID,
+		Name:                 row.Name,
+		Model:                ModelFromDB(row.Model),
+		SystemPrompt:         sqlNullStringToString(row.SystemPrompt),
+		Params:               generationParamsFromDB(row),
+		ContextStrategy:      ContextStrategy(row.ContextStrategy),
+		PersonalityVersionID: nullUUIDToPtr(row.PersonalityVersionID),
+		CreatedAt:            row.CreatedAt,
+		UpdatedAt:            row.UpdatedAt,
+		LastUsedAt:           sqlNullTimeToPtr(row.LastUsedAt),
+	}
+}
+
+// generationParamsFromDB collects an ai config's generation params, invalid provider options are treated as empty
+func generationParamsFromDB(row database.GetAIConfigByIDRow) GenerationParams {
+	var options map[string]any
+	_ = json.Unmarshal(row.ProviderOptions, &options)
+
+	params := GenerationParams{
+		ReasoningEffort: sqlNullStringToString(row.ReasoningEffort),
+		StopSequences:   row.StopSequences,
+		ProviderOptions: options,
+	}
+	if row.Temperature.Valid {
+		params.Temperature = &row.Temperature.Float64
+	}
+	if row.TopP.Valid {
+		params.TopP = &row.TopP.Float64
+	}
+	if row.MaxOutputTokens.Valid {
+		params.MaxOutputTokens = &row.MaxOutputTokens.Int32
+	}
+	return params
+}
+
+// PersonalityFromDB converts a database Personality and its latest version to domain Personality
+func PersonalityFromDB(p database.Personality, latest database.GetPersonalityVersionRow) Personality {
+	return Personality{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Latest:      PersonalityVersionFromDB(latest),
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
 	}
 }
 
+// PersonalityVersionFromDB converts a database query result to domain PersonalityVersion, invalid examples are treated as none
+func PersonalityVersionFromDB(row database.GetPersonalityVersionRow) PersonalityVersion {
+	var examples []ExampleDialogue
+	_ = json.Unmarshal(row.Examples, &examples)
+
+	version := PersonalityVersion{
+		ID:             row.ID,
+		PersonalityID:  row.PersonalityID,
+		Name:           row.PersonalityName,
+		Version:        row.Version,
+		PromptTemplate: row.PromptTemplate,
+		StyleRules:     row.StyleRules,
+		Examples:       examples,
+		Params:         GenerationParams{ReasoningEffort: sqlNullStringToString(row.ReasoningEffort)},
+		CreatedAt:      row.CreatedAt,
+	}
+	if row.Temperature.Valid {
+		version.Params.Temperature = &row.Temperature.Float64
+	}
+	if row.TopP.Valid {
+		version.Params.TopP = &row.TopP.Float64
+	}
+	if row.MaxOutputTokens.Valid {
+		version.Params.MaxOutputTokens = &row.MaxOutputTokens.Int32
+	}
+	return version
+}
+
 // ConversationParticipantFromDB converts a database ConversationParticipant to domain
 func ConversationParticipantFromDB(cp database.ConversationParticipant) ConversationParticipant {
 	return ConversationParticipant{
@@ -104,9 +192,101 @@ func sqlNullTimeToPtr(t sql.NullTime) *time.Time {
 	return &t.Time
 }
 
+func nullUUIDToPtr(id uuid.NullUUID) *uuid.UUID {
+	if id.Valid {
+		return &id.UUID
+	}
+	return nil
+}
+
 func sqlNullStringToString(s sql.NullString) string {
 	if !s.Valid {
 		return ""
 	}
 	return s.String
 }
+
+// MCPServerFromDB converts a database McpServer to domain MCPServer
+func MCPServerFromDB(s database.McpServer) MCPServer {
+	// Env and headers are stored as JSONB, invalid JSON is treated as empty
+	var env, headers map[string]string
+	_ = json.Unmarshal(s.Env, &env)
+	_ = json.Unmarshal(s.Headers, &headers)
+
+	return MCPServer{
+		ID:        s.ID,
+		Name:      s.Name,
+		Transport: s.Transport,
+		Command:   sqlNullStringToString(s.Command),
+		Args:      s.Args,
+		Env:       env,
+		URL:       sqlNullStringToString(s.Url),
+		Headers:   headers,
+		Enabled:   s.Enabled,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+// ToolRuleFromDB converts a database AiConfigToolRule to domain ToolRule
+func ToolRuleFromDB(r database.AiConfigToolRule) ToolRule {
+	return ToolRule{
+		AIConfigID: r.AiConfigID,
+		ToolName:   r.ToolName,
+		Rule:       r.Rule,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+// ToolApprovalFromDB converts a database ToolCallApproval to domain ToolApproval
+// the conversation isn't stored on the approval itself, it comes from the message that made the call
+func ToolApprovalFromDB(a database.ToolCallApproval, conversationID uuid.UUID) ToolApproval {
+	return ToolApproval{
+		ID:             a.ID,
+		MessageID:      a.MessageID,
+		ConversationID: conversationID,
+		Call: ToolCall{
+			ID:        a.CallID,
+			Name:      a.ToolName,
+			Arguments: a.Arguments,
+		},
+		Status:    a.Status,
+		CreatedAt: a.CreatedAt,
+		ExpiresAt: a.ExpiresAt,
+	}
+}
+
+// MemoryFromDB converts a database Memory to domain Memory, with the messages it was learned from
+func MemoryFromDB(m database.Memory, sources []uuid.UUID) Memory {
+	return Memory{
+		ID:               m.ID,
+		UserID:           nullUUIDToPtr(m.UserID),
+		Content:          m.Content,
+		SourceMessageIDs: sources,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+// DocumentFromDB converts a database Document to domain Document, its content stays behind
+func DocumentFromDB(d database.Document) Document {
+	return Document{
+		ID:        d.ID,
+		Name:      d.Name,
+		Size:      len([]rune(d.Content)),
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+// MessageSearchResultFromDB converts a database SearchMessagesRow to domain MessageSearchResult
+func MessageSearchResultFromDB(row database.SearchMessagesRow) MessageSearchResult {
+	return MessageSearchResult{
+		MessageID:        row.ID,
+		ConversationID:   row.ConversationID,
+		ConversationName: sqlNullStringToString(row.ConversationName),
+		Role:             Role(row.Role),
+		Snippet:          row.Snippet,
+		CreatedAt:        row.CreatedAt,
+	}
+}
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"encoding/json"
+	"fmt"
+
 	pb "github.com/curator4/io/backend/internal/proto"
 	"github.com/google/uuid"
 )
@@ -18,38 +21,22 @@ func UserFromPb(u *pb.User) User {
 // ConversationFromPb converts a protobuf Conversation to domain Conversation
 func ConversationFromPb(c *pb.Conversation) Conversation {
 	conv := Conversation{
-		ID:        uuid.MustParse(c.Id),
-		Name:      c.Name,
-		CreatedAt: c.CreatedAt.AsTime(),
-		UpdatedAt: c.UpdatedAt.AsTime(),
+		ID:                   uuid.MustParse(c.Id),
+		Name:                 c.Name,
+		PersonalityVersionID: stringToUUIDPtr(c.PersonalityVersionId),
+		CreatedAt:            c.CreatedAt.AsTime(),
+		UpdatedAt:            c.UpdatedAt.AsTime(),
 	}
 	return conv
 }
 
 // MessageFromPb converts a protobuf Message to domain Message
 func MessageFromPb(m *pb.Message) Message {
-	var content MessageContent
-	if m.Content != nil {
-		content.Text = m.Content.Text
-
-		// Convert media items
-		if len(m.Content.Media) > 0 {
-			content.Media = make([]MediaItem, len(m.Content.Media))
-			for i, item := range m.Content.Media {
-				content.Media[i] = MediaItem{
-					Type:     item.Type,
-					URL:      item.Url,
-					FileName: item.FileName,
-				}
-			}
-		}
-	}
-
 	msg := Message{
 		ID:             uuid.MustParse(m.Id),
 		ConversationID: uuid.MustParse(m.ConversationId),
 		Role:           Role(m.Role),
-		Content:        content,
+		Content:        MessageContentFromPb(m.Content),
 		CreatedAt:      m.CreatedAt.AsTime(),
 	}
 
@@ -61,11 +48,58 @@ func MessageFromPb(m *pb.Message) Message {
 	return msg
 }
 
+// MessageContentFromPb converts a protobuf MessageContent to domain MessageContent
+func MessageContentFromPb(c *pb.MessageContent) MessageContent {
+	var content MessageContent
+	if c == nil {
+		return content
+	}
+	content.Text = c.Text
+
+	// Convert media items
+	if len(c.Media) > 0 {
+		content.Media = make([]MediaItem, len(c.Media))
+		for i, item := range c.Media {
+			content.Media[i] = MediaItem{
+				Type:     item.Type,
+				URL:      item.Url,
+				FileName: item.FileName,
+			}
+		}
+	}
+
+	// Convert tool calls and results
+	if len(c.ToolCalls) > 0 {
+		content.ToolCalls = make([]ToolCall, len(c.ToolCalls))
+		for i, call := range c.ToolCalls {
+			content.ToolCalls[i] = ToolCall{
+				ID:        call.Id,
+				Name:      call.Name,
+				Arguments: call.Arguments,
+			}
+		}
+	}
+	if r := c.ToolResult; r != nil {
+		content.ToolResult = &ToolResult{
+			CallID:  r.CallId,
+			Name:    r.Name,
+			Content: r.Content,
+			IsError: r.IsError,
+		}
+	}
+
+	return content
+}
+
 // ProviderFromPb converts a protobuf Provider to domain Provider
 func ProviderFromPb(p *pb.Provider) Provider {
 	return Provider{
 		ID:        uuid.MustParse(p.Id),
 		Name:      p.Name,
+		BaseURL:   p.BaseUrl,
+		APIKeyEnv: p.ApiKeyEnv,
+		Headers:   p.Headers,
+		APIMode:   p.ApiMode,
 		CreatedAt: p.CreatedAt.AsTime(),
 		UpdatedAt: p.UpdatedAt.AsTime(),
 	}
@@ -74,29 +108,134 @@ func ProviderFromPb(p *pb.Provider) Provider {
 // ModelFromPb converts a protobuf Model to domain Model
 func ModelFromPb(m *pb.Model) Model {
 	return Model{
-		ID:          uuid.MustParse(m.Id),
-		ProviderID:  uuid.MustParse(m.ProviderId),
-		Name:        m.Name,
-		Description: m.Description,
-		CreatedAt:   m.CreatedAt.AsTime(),
+		ID:              uuid.MustParse(m.Id),
+		ProviderID:      uuid.MustParse(m.ProviderId),
+		Name:            m.Name,
+		ProviderModelID: m.ProviderModelId,
+		Description:     m.Description,
+		ContextWindow:   m.ContextWindow,
+		MaxOutputTokens: m.MaxOutputTokens,
+		Capabilities: ModelCapabilities{
+			Vision:    m.SupportsVision,
+			Tools:     m.SupportsTools,
+			Streaming: m.SupportsStreaming,
+			Reasoning: m.SupportsReasoning,
+		},
+		CreatedAt: m.CreatedAt.AsTime(),
+		UpdatedAt: m.UpdatedAt.AsTime(),
 	}
 }
 
 // AIConfigFromPb converts a protobuf AIConfig to domain AIConfig
 func AIConfigFromPb(a *pb.AIConfig) AIConfig {
 	config := AIConfig{
-		ID:           uuid.MustParse(a.Id),
-		Name:         a.Name,
-		Model:        ModelFromPb(a.Model),
-		SystemPrompt: a.SystemPrompt,
-		CreatedAt:    a.CreatedAt.AsTime(),
-		UpdatedAt:    a.UpdatedAt.AsTime(),
+		ID:                   uuid.MustParse(a.Id),
+		Name:                 a.Name,
+		Model:                ModelFromPb(a.Model),
+		SystemPrompt:         a.SystemPrompt,
+		ContextStrategy:      ContextStrategy(a.ContextStrategy),
+		PersonalityVersionID: stringToUUIDPtr(a.PersonalityVersionId),
+		CreatedAt:            a.CreatedAt.AsTime(),
+		UpdatedAt:            a.UpdatedAt.AsTime(),
 	}
 
 	if a.LastUsedAt != nil {
 		t := a.LastUsedAt.AsTime()
 		config.LastUsedAt = &t
 	}
+	// params come from our own AIConfigToPb here, so their provider options are known to be valid
+	config.Params, _ = GenerationParamsFromPb(a.Params)
 
 	return config
 }
+
+// GenerationParamsFromPb converts protobuf GenerationParams to domain GenerationParams
+// provider options arrive as a JSON string, which fails the conversion if it isn't an object
+func GenerationParamsFromPb(p *pb.GenerationParams) (GenerationParams, error) {
+	if p == nil {
+		return GenerationParams{}, nil
+	}
+	params := GenerationParams{
+		Temperature:     p.Temperature,
+		TopP:            p.TopP,
+		MaxOutputTokens: p.MaxOutputTokens,
+		ReasoningEffort: p.GetReasoningEffort(),
+		StopSequences:   p.GetStopSequences(),
+	}
+	if options := p.GetProviderOptions(); options != "" {
+		if err := json.Unmarshal([]byte(options), &params.ProviderOptions); err != nil {
+			return GenerationParams{}, fmt.Errorf("provider_options must be a JSON object: %w", err)
+		}
+	}
+	return params, nil
+}
+
+// ProviderSpecFromPb converts a protobuf ProviderSpec to a domain Provider without id or timestamps
+func ProviderSpecFromPb(p *pb.ProviderSpec) Provider {
+	if p == nil {
+		return Provider{}
+	}
+	return Provider{
+		Name:      p.Name,
+		BaseURL:   p.BaseUrl,
+		APIKeyEnv: p.ApiKeyEnv,
+		Headers:   p.Headers,
+		APIMode:   p.ApiMode,
+	}
+}
+
+// ModelSpecFromPb converts a protobuf ModelSpec to a domain Model without ids or timestamps
+func ModelSpecFromPb(m *pb.ModelSpec) Model {
+	if m == nil {
+		return Model{}
+	}
+	return Model{
+		Name:            m.Name,
+		ProviderModelID: m.ProviderModelId,
+		Description:     m.Description,
+		ContextWindow:   m.ContextWindow,
+		MaxOutputTokens: m.MaxOutputTokens,
+		Capabilities: ModelCapabilities{
+			Vision:    m.SupportsVision,
+			Tools:     m.SupportsTools,
+			Streaming: m.SupportsStreaming,
+			Reasoning: m.SupportsReasoning,
+		},
+	}
+}
+
+// PersonalitySpecFromPb converts a protobuf PersonalitySpec to a domain Personality without ids or timestamps,
+// the definition goes into Latest
+func PersonalitySpecFromPb(p *pb.PersonalitySpec) (Personality, error) {
+	if p == nil {
+		return Personality{}, nil
+	}
+	params, err := GenerationParamsFromPb(p.DefaultParams)
+	if err != nil {
+		return Personality{}, err
+	}
+	examples := make([]ExampleDialogue, len(p.Examples))
+	for i, e := range p.Examples {
+		examples[i] = ExampleDialogue{User: e.GetUser(), Assistant: e.GetAssistant()}
+	}
+
+	return Personality{
+		Name:        p.Name,
+		Description: p.Description,
+		Latest: PersonalityVersion{
+			PromptTemplate: p.PromptTemplate,
+			StyleRules:     p.StyleRules,
+			Examples:       examples,
+			Params:         params,
+		},
+	}, nil
+}
+
+// stringToUUIDPtr converts an optional id, "" and malformed ids become nil
+func stringToUUIDPtr(s string) *uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
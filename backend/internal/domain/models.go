@@ -13,6 +13,7 @@ const (
 	RoleAssistant Role = "assistant"
 	RoleSystem    Role = "system"
 	RoleDeveloper Role = "developer"
+	RoleTool      Role = "tool" // Result of a tool call, see ToolResult
 )
 
 // MediaItem represents a single media attachment (image, video, file, etc.)
@@ -22,10 +23,28 @@ type MediaItem struct {
 	FileName string `json:"file_name,omitempty"` // Original filename if applicable
 }
 
+// ToolCall is a tool invocation requested by the model
+type ToolCall struct {
+	ID        string `json:"id"`        // Provider-assigned call id, results refer back to it
+	Name      string `json:"name"`      // Tool name as advertised to the model
+	Arguments string `json:"arguments"` // JSON arguments object, as generated by the model
+}
+
+// ToolResult is the output of a tool call, fed back to the model
+type ToolResult struct {
+	CallID  string `json:"call_id"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	IsError bool   `json:"is_error,omitempty"`
+}
+
 // MessageContent represents the content of a message, supporting text and multiple media attachments
+// assistant messages may also request tool calls, tool messages carry the result of one
 type MessageContent struct {
-	Text  string      `json:"text,omitempty"`
-	Media []MediaItem `json:"media,omitempty"`
+	Text       string      `json:"text,omitempty"`
+	Media      []MediaItem `json:"media,omitempty"`
+	ToolCalls  []ToolCall  `json:"tool_calls,omitempty"`
+	ToolResult *ToolResult `json:"tool_result,omitempty"`
 }
 
 // User represents a user in the system
@@ -38,11 +57,12 @@ type User struct {
 
 // Conversation represents a chat conversation
 type Conversation struct {
-	ID         uuid.UUID
-	Name       string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	LastUsedAt *time.Time
+	ID                   uuid.UUID
+	Name                 string
+	PersonalityVersionID *uuid.UUID // Overrides the ai config's personality
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           *time.Time
 }
 
 // Message represents a chat message
@@ -50,37 +70,134 @@ type Message struct {
 	ID             uuid.UUID
 	ConversationID uuid.UUID
 	User           *User // Full user object (nil for assistant messages)
-	Role           Role  // "user", "assistant", "system"
+	Role           Role  // "user", "assistant", "system", "developer", "tool"
 	Content        MessageContent
 	CreatedAt      time.Time
 }
 
 // Provider represents an AI provider (OpenAI, Anthropic, etc.)
+// providers with a BaseURL are served by the generic OpenAI-compatible client
 type Provider struct {
 	ID        uuid.UUID
 	Name      string
+	BaseURL   string
+	APIKeyEnv string            // Name of the environment variable holding the api key
+	Headers   map[string]string // Extra headers sent with every request
+	APIMode   string            // "chat_completions" or "responses"
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
 // Model represents an AI model
 type Model struct {
+	ID              uuid.UUID
+	ProviderID      uuid.UUID
+	Name            string
+	ProviderModelID string // What the provider's api calls the model
+	Description     string
+	ContextWindow   int32 // In tokens, 0 if unknown
+	MaxOutputTokens int32 // 0 if unknown
+	Capabilities    ModelCapabilities
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ModelCapabilities are the features a model supports
+type ModelCapabilities struct {
+	Vision    bool // Reads images and other media
+	Tools     bool
+	Streaming bool
+	Reasoning bool // Takes a reasoning effort
+}
+
+// AIConfig represents an AI configuration
+type AIConfig struct {
+	ID              uuid.UUID
+	Name            string
+	Model           Model // Full model object instead of just ID
+	SystemPrompt    string
+	Params          GenerationParams
+	ContextStrategy ContextStrategy
+	// PersonalityVersionID is the personality the config talks as, a conversation's own personality wins
+	PersonalityVersionID *uuid.UUID
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	LastUsedAt           *time.Time
+}
+
+// Personality is a persona ai configs and conversations can take on, its definition lives in versions
+type Personality struct {
 	ID          uuid.UUID
-	ProviderID  uuid.UUID
 	Name        string
 	Description string
+	Latest      PersonalityVersion
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
-// AIConfig represents an AI configuration
-type AIConfig struct {
-	ID           uuid.UUID
-	Name         string
-	Model        Model // Full model object instead of just ID
-	SystemPrompt string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastUsedAt   *time.Time
+// PersonalityVersion is one revision of a personality's definition, versions are never edited
+type PersonalityVersion struct {
+	ID             uuid.UUID
+	PersonalityID  uuid.UUID
+	Name           string // The personality's name
+	Version        int32
+	PromptTemplate string
+	StyleRules     []string
+	Examples       []ExampleDialogue
+	Params         GenerationParams // Defaults for what an ai config leaves unset, stop sequences and provider options aren't used
+	CreatedAt      time.Time
+}
+
+// ExampleDialogue is an exchange that shows the model how a personality talks
+type ExampleDialogue struct {
+	User      string `json:"user"`
+	Assistant string `json:"assistant"`
+}
+
+// Memory is a fact kept across conversations, about a user or, without one, global
+type Memory struct {
+	ID               uuid.UUID
+	UserID           *uuid.UUID // nil for global memories, which every user's conversations recall
+	Content          string
+	SourceMessageIDs []uuid.UUID // Messages the memory was learned from
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Document is text a user handed io, it is only seen through semantic search
+type Document struct {
+	ID        uuid.UUID
+	Name      string
+	Size      int // In characters
+	CreatedAt time.Time
+}
+
+// MessageSearchResult is a message that matched a search, with the matching parts of its text
+type MessageSearchResult struct {
+	MessageID        uuid.UUID
+	ConversationID   uuid.UUID
+	ConversationName string
+	Role             Role
+	Snippet          string // Fragments of the text with the matches wrapped in **
+	CreatedAt        time.Time
+}
+
+// ContextStrategy is how a history that outgrew the model's context window is cut down
+type ContextStrategy string
+
+const (
+	ContextDropOldest     ContextStrategy = "drop_oldest"     // the oldest messages are left out
+	ContextTruncateOldest ContextStrategy = "truncate_oldest" // the oldest messages are shortened first, then left out
+)
+
+// GenerationParams tune how a model generates, unset fields leave the provider's default
+type GenerationParams struct {
+	Temperature     *float64
+	TopP            *float64
+	MaxOutputTokens *int32
+	ReasoningEffort string // "minimal", "low", "medium" or "high", empty for the model's default
+	StopSequences   []string
+	ProviderOptions map[string]any // Merged into the provider's request body as is, for anything without a param
 }
 
 // ConversationParticipant represents a user's participation in a conversation
@@ -89,3 +206,38 @@ type ConversationParticipant struct {
 	UserID         uuid.UUID
 	JoinedAt       time.Time
 }
+
+// MCPServer represents an MCP server the backend connects to as a host
+type MCPServer struct {
+	ID        uuid.UUID
+	Name      string
+	Transport string            // "stdio", "streamable_http" or "sse"
+	Command   string            // Executable to launch, for stdio servers
+	Args      []string          // Arguments passed to Command
+	Env       map[string]string // Extra environment variables for the server process
+	URL       string            // Endpoint of http servers
+	Headers   map[string]string // Extra headers for http servers, values may reference ${IO_MCP_SECRET_*} variables
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ToolRule allows or denies tools matching ToolName (a tool name or glob) for an AI config
+type ToolRule struct {
+	AIConfigID uuid.UUID
+	ToolName   string
+	Rule       string // "allow", "deny" or "approve" (allowed, but every call needs a user's consent)
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ToolApproval is a tool call that waits for a user's consent before it runs
+type ToolApproval struct {
+	ID             uuid.UUID
+	MessageID      uuid.UUID // The assistant message that made the call
+	ConversationID uuid.UUID
+	Call           ToolCall
+	Status         string // "pending", "approved", "rejected" or "expired"
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/google/uuid"
+)
+
+var toolContents = map[string]MessageContent{
+	"text":   {Text: "hello", Media: []MediaItem{{Type: "image", URL: "https://example.com/cat.png"}}},
+	"calls":  {Text: "let me check", ToolCalls: []ToolCall{{ID: "call-1", Name: "current_time", Arguments: `{"timezone":"UTC"}`}, {ID: "call-2", Name: "docs__search", Arguments: "{}"}}},
+	"result": {ToolResult: &ToolResult{CallID: "call-1", Name: "current_time", Content: "12:00"}},
+	"error":  {ToolResult: &ToolResult{CallID: "call-2", Name: "docs__search", Content: "docs is down", IsError: true}},
+}
+
+func TestMessageContentDBRoundTrip(t *testing.T) {
+	for name, content := range toolContents {
+		t.Run(name, func(t *testing.T) {
+			stored := MessageToDB(Message{ID: uuid.New(), Role: RoleTool, Content: content, CreatedAt: time.Now()})
+			got := MessageFromDB(database.GetMessagesByConversationRow{ID: stored.ID, Role: stored.Role, Content: stored.Content})
+			if !reflect.DeepEqual(got.Content, content) || got.Role != RoleTool {
+				t.Errorf("got %+v from %s, want %+v", got.Content, stored.Content, content)
+			}
+		})
+	}
+}
+
+func TestMessageContentPbRoundTrip(t *testing.T) {
+	for name, content := range toolContents {
+		t.Run(name, func(t *testing.T) {
+			if got := MessageContentFromPb(MessageContentToPb(content)); !reflect.DeepEqual(got, content) {
+				t.Errorf("got %+v, want %+v", got, content)
+			}
+		})
+	}
+}
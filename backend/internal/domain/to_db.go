@@ -22,17 +22,18 @@ func UserToDB(u User) database.User {
 // ConversationToDB converts a domain Conversation to database Conversation
 func ConversationToDB(c Conversation) database.Conversation {
 	return database.Conversation{
-		ID:         c.ID,
-		Name:       stringToSqlNullString(c.Name),
-		CreatedAt:  c.CreatedAt,
-		UpdatedAt:  c.UpdatedAt,
-		LastUsedAt: ptrToSqlNullTime(c.LastUsedAt),
+		ID:                   c.ID,
+		Name:                 stringToSqlNullString(c.Name),
+		PersonalityVersionID: PtrToNullUUID(c.PersonalityVersionID),
+		CreatedAt:            c.CreatedAt,
+		UpdatedAt:            c.UpdatedAt,
+		LastUsedAt:           ptrToSqlNullTime(c.LastUsedAt),
 	}
 }
 
 // MessageToDB converts a domain Message to database Message
 func MessageToDB(m Message) database.Message {
-	// Content needs to be marshaled to JSONB (MessageContent already has json tags)
+	// Content needs to be marshaled to JSONB (MessageContent already has json tags, tool parts included)
 	contentJSON, _ := json.Marshal(m.Content)
 
 	var userID uuid.NullUUID
@@ -53,9 +54,18 @@ func MessageToDB(m Message) database.Message {
 
 // ProviderToDB converts a domain Provider to database Provider
 func ProviderToDB(p Provider) database.Provider {
+	headersJSON, _ := json.Marshal(p.Headers)
+	if p.Headers == nil {
+		headersJSON = []byte("{}")
+	}
+
 	return database.Provider{
 		ID:        p.ID,
 		Name:      p.Name,
+		BaseUrl:   stringToSqlNullString(p.BaseURL),
+		ApiKeyEnv: stringToSqlNullString(p.APIKeyEnv),
+		Headers:   headersJSON,
+		ApiMode:   p.APIMode,
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
 	}
@@ -64,27 +74,107 @@ func ProviderToDB(p Provider) database.Provider {
 // ModelToDB converts a domain Model to database Model
 func ModelToDB(m Model) database.Model {
 	return database.Model{
-		ID:          m.ID,
-		ProviderID:  m.ProviderID,
-		Name:        m.Name,
-		Description: stringToSqlNullString(m.Description),
-		CreatedAt:   m.CreatedAt,
+		ID:                m.ID,
+		ProviderID:        m.ProviderID,
+		Name:              m.Name,
+		ProviderModelID:   m.ProviderModelID,
+		Description:       stringToSqlNullString(m.Description),
+		ContextWindow:     int32ToSqlNullInt32(m.ContextWindow),
+		MaxOutputTokens:   int32ToSqlNullInt32(m.MaxOutputTokens),
+		SupportsVision:    m.Capabilities.Vision,
+		SupportsTools:     m.Capabilities.Tools,
+		SupportsStreaming: m.Capabilities.Streaming,
+		SupportsReasoning: m.Capabilities.Reasoning,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
 	}
 }
 
 // AIConfigToDB converts a domain AIConfig to database AiConfig
 func AIConfigToDB(a AIConfig) database.AiConfig {
+	params := GenerationParamsToDB(a.Params)
 	return database.AiConfig{
-		ID:           a.ID,
-		Name:         a.Name,
-		ModelID:      a.Model.ID, // Extract model ID from Model object
-		SystemPrompt: stringToSqlNullString(a.SystemPrompt),
-		CreatedAt:    a.CreatedAt,
-		UpdatedAt:    a.UpdatedAt,
-		LastUsedAt:   ptrToSqlNullTime(a.LastUsedAt),
+		ID:                   a.ID,
+		Name:                 a.Name,
+		ModelID:              a.Model.ID, // Extract model ID from Model object
+		SystemPrompt:         stringToSqlNullString(a.SystemPrompt),
+		CreatedAt:            a.CreatedAt,
+		UpdatedAt:            a.UpdatedAt,
+		LastUsedAt:           ptrToSqlNullTime(a.LastUsedAt),
+		Temperature:          params.Temperature,
+		TopP:                 params.TopP,
+		MaxOutputTokens:      params.MaxOutputTokens,
+		ReasoningEffort:      params.ReasoningEffort,
+		StopSequences:        params.StopSequences,
+		ProviderOptions:      params.ProviderOptions,
+		ContextStrategy:      string(a.ContextStrategy),
+		PersonalityVersionID: PtrToNullUUID(a.PersonalityVersionID),
 	}
 }
 
+// GenerationParamsToDB converts domain GenerationParams to the columns UpdateAIConfigParams sets, the id is left to the caller
+func GenerationParamsToDB(p GenerationParams) database.UpdateAIConfigParamsParams {
+	optionsJSON, _ := json.Marshal(p.ProviderOptions)
+	if p.ProviderOptions == nil {
+		optionsJSON = []byte("{}")
+	}
+	// stop_sequences is NOT NULL, a nil slice would be stored as NULL
+	stop := p.StopSequences
+	if stop == nil {
+		stop = []string{}
+	}
+
+	params := database.UpdateAIConfigParamsParams{
+		ReasoningEffort: stringToSqlNullString(p.ReasoningEffort),
+		StopSequences:   stop,
+		ProviderOptions: optionsJSON,
+	}
+	if p.Temperature != nil {
+		params.Temperature = sql.NullFloat64{Float64: *p.Temperature, Valid: true}
+	}
+	if p.TopP != nil {
+		params.TopP = sql.NullFloat64{Float64: *p.TopP, Valid: true}
+	}
+	if p.MaxOutputTokens != nil {
+		params.MaxOutputTokens = sql.NullInt32{Int32: *p.MaxOutputTokens, Valid: true}
+	}
+	return params
+}
+
+// PersonalityVersionToDB converts a domain PersonalityVersion to the params that store it as a new version
+func PersonalityVersionToDB(v PersonalityVersion) database.CreatePersonalityVersionParams {
+	examples := v.Examples
+	if examples == nil {
+		examples = []ExampleDialogue{}
+	}
+	examplesJSON, _ := json.Marshal(examples)
+	// style_rules is NOT NULL, a nil slice would be stored as NULL
+	rules := v.StyleRules
+	if rules == nil {
+		rules = []string{}
+	}
+
+	params := GenerationParamsToDB(v.Params)
+	return database.CreatePersonalityVersionParams{
+		PersonalityID:   v.PersonalityID,
+		PromptTemplate:  v.PromptTemplate,
+		StyleRules:      rules,
+		Examples:        examplesJSON,
+		Temperature:     params.Temperature,
+		TopP:            params.TopP,
+		MaxOutputTokens: params.MaxOutputTokens,
+		ReasoningEffort: params.ReasoningEffort,
+	}
+}
+
+// PtrToNullUUID converts an optional id to uuid.NullUUID
+func PtrToNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
 // ConversationParticipantToDB converts a domain ConversationParticipant to database
 func ConversationParticipantToDB(cp ConversationParticipant) database.ConversationParticipant {
 	return database.ConversationParticipant{
@@ -102,9 +192,45 @@ func ptrToSqlNullTime(t *time.Time) sql.NullTime {
 	return sql.NullTime{Time: *t, Valid: true}
 }
 
+// int32ToSqlNullInt32 stores 0 (unknown) as NULL
+func int32ToSqlNullInt32(n int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: n, Valid: n != 0}
+}
+
 func stringToSqlNullString(s string) sql.NullString {
 	if s == "" {
 		return sql.NullString{Valid: false}
 	}
 	return sql.NullString{String: s, Valid: true}
 }
+
+// MCPServerToDB converts a domain MCPServer to database McpServer
+func MCPServerToDB(s MCPServer) database.McpServer {
+	envJSON, _ := json.Marshal(s.Env)
+	if s.Env == nil {
+		envJSON = []byte("{}")
+	}
+	headersJSON, _ := json.Marshal(s.Headers)
+	if s.Headers == nil {
+		headersJSON = []byte("{}")
+	}
+	// args is NOT NULL, a nil slice would be stored as NULL
+	args := s.Args
+	if args == nil {
+		args = []string{}
+	}
+
+	return database.McpServer{
+		ID:        s.ID,
+		Name:      s.Name,
+		Transport: s.Transport,
+		Command:   stringToSqlNullString(s.Command),
+		Args:      args,
+		Env:       envJSON,
+		Url:       stringToSqlNullString(s.URL),
+		Headers:   headersJSON,
+		Enabled:   s.Enabled,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
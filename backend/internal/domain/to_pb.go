@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"encoding/json"
+
 	pb "github.com/curator4/io/backend/internal/proto"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -18,23 +21,41 @@ func UserToPb(u User) *pb.User {
 // ConversationToPb converts a domain Conversation to protobuf Conversation
 func ConversationToPb(c Conversation) *pb.Conversation {
 	return &pb.Conversation{
-		Id:        c.ID.String(),
-		Name:      c.Name,
-		CreatedAt: timestamppb.New(c.CreatedAt),
-		UpdatedAt: timestamppb.New(c.UpdatedAt),
+		Id:                   c.ID.String(),
+		Name:                 c.Name,
+		PersonalityVersionId: uuidPtrToString(c.PersonalityVersionID),
+		CreatedAt:            timestamppb.New(c.CreatedAt),
+		UpdatedAt:            timestamppb.New(c.UpdatedAt),
 	}
 }
 
 // MessageToPb converts a domain Message to protobuf Message
 func MessageToPb(m Message) *pb.Message {
+	msg := &pb.Message{
+		Id:             m.ID.String(),
+		ConversationId: m.ConversationID.String(),
+		Role:           string(m.Role),
+		Content:        MessageContentToPb(m.Content),
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+	}
+
+	if m.User != nil {
+		msg.UserId = m.User.ID.String()
+	}
+
+	return msg
+}
+
+// MessageContentToPb converts a domain MessageContent to protobuf MessageContent
+func MessageContentToPb(c MessageContent) *pb.MessageContent {
 	content := &pb.MessageContent{
-		Text: m.Content.Text,
+		Text: c.Text,
 	}
 
 	// Convert media items
-	if len(m.Content.Media) > 0 {
-		content.Media = make([]*pb.MediaItem, len(m.Content.Media))
-		for i, item := range m.Content.Media {
+	if len(c.Media) > 0 {
+		content.Media = make([]*pb.MediaItem, len(c.Media))
+		for i, item := range c.Media {
 			content.Media[i] = &pb.MediaItem{
 				Type:     item.Type,
 				Url:      item.URL,
@@ -43,19 +64,27 @@ func MessageToPb(m Message) *pb.Message {
 		}
 	}
 
-	msg := &pb.Message{
-		Id:             m.ID.String(),
-		ConversationId: m.ConversationID.String(),
-		Role:           string(m.Role),
-		Content:        content,
-		CreatedAt:      timestamppb.New(m.CreatedAt),
+	// Convert tool calls and results
+	if len(c.ToolCalls) > 0 {
+		content.ToolCalls = make([]*pb.ToolCall, len(c.ToolCalls))
+		for i, call := range c.ToolCalls {
+			content.ToolCalls[i] = &pb.ToolCall{
+				Id:        call.ID,
+				Name:      call.Name,
+				Arguments: call.Arguments,
+			}
+		}
 	}
-
-	if m.User != nil {
-		msg.UserId = m.User.ID.String()
+	if r := c.ToolResult; r != nil {
+		content.ToolResult = &pb.ToolResult{
+			CallId:  r.CallID,
+			Name:    r.Name,
+			Content: r.Content,
+			IsError: r.IsError,
+		}
 	}
 
-	return msg
+	return content
 }
 
 // ProviderToPb converts a domain Provider to protobuf Provider
@@ -65,29 +94,44 @@ func ProviderToPb(p Provider) *pb.Provider {
 		Name:      p.Name,
 		CreatedAt: timestamppb.New(p.CreatedAt),
 		UpdatedAt: timestamppb.New(p.UpdatedAt),
+		BaseUrl:   p.BaseURL,
+		ApiMode:   p.APIMode,
+		ApiKeyEnv: p.APIKeyEnv,
+		Headers:   p.Headers,
 	}
 }
 
 // ModelToPb converts a domain Model to protobuf Model
 func ModelToPb(m Model) *pb.Model {
 	return &pb.Model{
-		Id:          m.ID.String(),
-		ProviderId:  m.ProviderID.String(),
-		Name:        m.Name,
-		Description: m.Description,
-		CreatedAt:   timestamppb.New(m.CreatedAt),
+		Id:                m.ID.String(),
+		ProviderId:        m.ProviderID.String(),
+		Name:              m.Name,
+		Description:       m.Description,
+		CreatedAt:         timestamppb.New(m.CreatedAt),
+		ProviderModelId:   m.ProviderModelID,
+		ContextWindow:     m.ContextWindow,
+		MaxOutputTokens:   m.MaxOutputTokens,
+		SupportsVision:    m.Capabilities.Vision,
+		SupportsTools:     m.Capabilities.Tools,
+		SupportsStreaming: m.Capabilities.Streaming,
+		SupportsReasoning: m.Capabilities.Reasoning,
+		UpdatedAt:         timestamppb.New(m.UpdatedAt),
 	}
 }
 
 // AIConfigToPb converts a domain AIConfig to protobuf AIConfig
 func AIConfigToPb(a AIConfig) *pb.AIConfig {
 	config := &pb.AIConfig{
-		Id:           a.ID.String(),
-		Name:         a.Name,
-		Model:        ModelToPb(a.Model),
-		SystemPrompt: a.SystemPrompt,
-		CreatedAt:    timestamppb.New(a.CreatedAt),
-		UpdatedAt:    timestamppb.New(a.UpdatedAt),
+		Id:                   a.ID.String(),
+		Name:                 a.Name,
+		Model:                ModelToPb(a.Model),
+		SystemPrompt:         a.SystemPrompt,
+		Params:               GenerationParamsToPb(a.Params),
+		ContextStrategy:      string(a.ContextStrategy),
+		PersonalityVersionId: uuidPtrToString(a.PersonalityVersionID),
+		CreatedAt:            timestamppb.New(a.CreatedAt),
+		UpdatedAt:            timestamppb.New(a.UpdatedAt),
 	}
 
 	if a.LastUsedAt != nil {
@@ -96,3 +140,118 @@ func AIConfigToPb(a AIConfig) *pb.AIConfig {
 
 	return config
 }
+
+// ToolRuleToPb converts a domain ToolRule to protobuf ToolRule
+func ToolRuleToPb(r ToolRule) *pb.ToolRule {
+	return &pb.ToolRule{
+		ToolName: r.ToolName,
+		Rule:     r.Rule,
+	}
+}
+
+// ToolApprovalToPb converts a domain ToolApproval to protobuf ToolApprovalRequest
+func ToolApprovalToPb(a ToolApproval) *pb.ToolApprovalRequest {
+	return &pb.ToolApprovalRequest{
+		ApprovalId:     a.ID.String(),
+		ConversationId: a.ConversationID.String(),
+		ToolCall: &pb.ToolCall{
+			Id:        a.Call.ID,
+			Name:      a.Call.Name,
+			Arguments: a.Call.Arguments,
+		},
+		ExpiresAt: timestamppb.New(a.ExpiresAt),
+	}
+}
+
+// GenerationParamsToPb converts domain GenerationParams to protobuf GenerationParams
+func GenerationParamsToPb(p GenerationParams) *pb.GenerationParams {
+	params := &pb.GenerationParams{
+		Temperature:     p.Temperature,
+		TopP:            p.TopP,
+		MaxOutputTokens: p.MaxOutputTokens,
+		ReasoningEffort: p.ReasoningEffort,
+		StopSequences:   p.StopSequences,
+	}
+	if len(p.ProviderOptions) > 0 {
+		options, _ := json.Marshal(p.ProviderOptions)
+		params.ProviderOptions = string(options)
+	}
+	return params
+}
+
+// PersonalityToPb converts a domain Personality to protobuf Personality
+func PersonalityToPb(p Personality) *pb.Personality {
+	return &pb.Personality{
+		Id:          p.ID.String(),
+		Name:        p.Name,
+		Description: p.Description,
+		Latest:      PersonalityVersionToPb(p.Latest),
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// PersonalityVersionToPb converts a domain PersonalityVersion to protobuf PersonalityVersion
+func PersonalityVersionToPb(v PersonalityVersion) *pb.PersonalityVersion {
+	examples := make([]*pb.ExampleDialogue, len(v.Examples))
+	for i, e := range v.Examples {
+		examples[i] = &pb.ExampleDialogue{User: e.User, Assistant: e.Assistant}
+	}
+	return &pb.PersonalityVersion{
+		Id:             v.ID.String(),
+		PersonalityId:  v.PersonalityID.String(),
+		Name:           v.Name,
+		Version:        v.Version,
+		PromptTemplate: v.PromptTemplate,
+		StyleRules:     v.StyleRules,
+		Examples:       examples,
+		DefaultParams:  GenerationParamsToPb(v.Params),
+		CreatedAt:      timestamppb.New(v.CreatedAt),
+	}
+}
+
+// MemoryToPb converts a domain Memory to protobuf Memory
+func MemoryToPb(m Memory) *pb.Memory {
+	sources := make([]string, len(m.SourceMessageIDs))
+	for i, id := range m.SourceMessageIDs {
+		sources[i] = id.String()
+	}
+	return &pb.Memory{
+		Id:               m.ID.String(),
+		Global:           m.UserID == nil,
+		Content:          m.Content,
+		SourceMessageIds: sources,
+		CreatedAt:        timestamppb.New(m.CreatedAt),
+		UpdatedAt:        timestamppb.New(m.UpdatedAt),
+	}
+}
+
+// DocumentToPb converts a domain Document to protobuf Document
+func DocumentToPb(d Document) *pb.Document {
+	return &pb.Document{
+		Id:        d.ID.String(),
+		Name:      d.Name,
+		Size:      int64(d.Size),
+		CreatedAt: timestamppb.New(d.CreatedAt),
+	}
+}
+
+// MessageSearchResultToPb converts a domain MessageSearchResult to protobuf MessageSearchResult
+func MessageSearchResultToPb(r MessageSearchResult) *pb.MessageSearchResult {
+	return &pb.MessageSearchResult{
+		MessageId:        r.MessageID.String(),
+		ConversationId:   r.ConversationID.String(),
+		ConversationName: r.ConversationName,
+		Role:             string(r.Role),
+		Snippet:          r.Snippet,
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+	}
+}
+
+// uuidPtrToString converts an optional id, nil becomes ""
+func uuidPtrToString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
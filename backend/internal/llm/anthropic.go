@@ -0,0 +1,348 @@
+package llm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+)
+
+type AnthropicProvider struct {
+	client *anthropic.Client
+}
+
+const (
+	// claudeMaxTokens is the output limit when a config sets none, anthropic requires one. thinking comes on top of it
+	claudeMaxTokens = 8192
+	// claudeMinThinking is the smallest thinking budget anthropic accepts
+	claudeMinThinking = 1024
+)
+
+// NewAnthropicProvider creates an AnthropicProvider authenticated with the given api key
+// extra request options (e.g. option.WithBaseURL) are passed through to the client, which is handy for tests
+func NewAnthropicProvider(apikey string, opts ...option.RequestOption) AnthropicProvider {
+	client := anthropic.NewClient(append([]option.RequestOption{option.WithAPIKey(apikey)}, opts...)...)
+	return AnthropicProvider{client: &client}
+}
+
+func (p AnthropicProvider) SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool) (*Response, error) {
+	resp, err := p.client.Messages.New(ctx, buildClaudeParams(ctx, messages, config, tools))
+	if err != nil {
+		return nil, fmt.Errorf("anthropic api error: %w", err)
+	}
+
+	return claudeResponse(resp), nil
+}
+
+// StreamMessage uses the streaming messages api, forwarding text deltas and tool use progress to handler
+func (p AnthropicProvider) StreamMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool, handler StreamHandler) (*Response, error) {
+	stream := p.client.Messages.NewStreaming(ctx, buildClaudeParams(ctx, messages, config, tools))
+	defer stream.Close()
+
+	// the sdk accumulates the final message for us, tool blocks are tracked by index to report their progress
+	var final anthropic.Message
+	toolBlocks := make(map[int64]anthropic.ContentBlockStartEventContentBlockUnion)
+
+	for stream.Next() {
+		event := stream.Current()
+		if err := final.Accumulate(event); err != nil {
+			return nil, fmt.Errorf("anthropic stream error: %w", err)
+		}
+
+		var out *StreamEvent
+		switch event.Type {
+		case "content_block_start":
+			block := event.ContentBlock
+			if block.Type == "tool_use" || block.Type == "server_tool_use" {
+				toolBlocks[event.Index] = block
+				out = &StreamEvent{
+					Type:     StreamEventToolCall,
+					ToolCall: ToolCallProgress{ID: block.ID, Name: block.Name, Status: ToolCallStarted},
+				}
+			}
+		case "content_block_delta":
+			switch event.Delta.Type {
+			case "text_delta":
+				out = &StreamEvent{Type: StreamEventTextDelta, Text: event.Delta.Text}
+			case "input_json_delta":
+				block := toolBlocks[event.Index]
+				out = &StreamEvent{
+					Type: StreamEventToolCall,
+					ToolCall: ToolCallProgress{
+						ID:             block.ID,
+						Status:         ToolCallArguments,
+						ArgumentsDelta: event.Delta.PartialJSON,
+					},
+				}
+			}
+		case "content_block_stop":
+			if block, ok := toolBlocks[event.Index]; ok {
+				out = &StreamEvent{
+					Type:     StreamEventToolCall,
+					ToolCall: ToolCallProgress{ID: block.ID, Name: block.Name, Status: ToolCallCompleted},
+				}
+			}
+		}
+
+		if out != nil {
+			if err := handler(*out); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, fmt.Errorf("anthropic api error: %w", err)
+	}
+
+	return claudeResponse(&final), nil
+}
+
+// ValidateParams also checks extended thinking's own rules: no temperature, top_p of at least 0.95,
+// and room for output beyond the thinking budget
+func (p AnthropicProvider) ValidateParams(model domain.Model, params domain.GenerationParams) error {
+	capabilities := Capabilities{
+		MaxTemperature:  1,
+		TopP:            true,
+		MaxOutputTokens: model.MaxOutputTokens,
+		Reasoning:       model.Capabilities.Reasoning, // turns on extended thinking
+		StopSequences:   -1,
+	}
+	if err := capabilities.Validate(params); err != nil {
+		return err
+	}
+	if params.ReasoningEffort == "" {
+		return nil
+	}
+
+	if params.Temperature != nil {
+		return fmt.Errorf("temperature can't be set along with a reasoning effort")
+	}
+	if params.TopP != nil && *params.TopP < 0.95 {
+		return fmt.Errorf("top_p must be at least 0.95 along with a reasoning effort")
+	}
+	if budget := thinkingBudget(params.ReasoningEffort, claudeMinThinking); params.MaxOutputTokens != nil && *params.MaxOutputTokens <= budget {
+		return fmt.Errorf("max_output_tokens must be above the %s reasoning budget of %d tokens", params.ReasoningEffort, budget)
+	}
+	return nil
+}
+
+// TokenEstimator estimates claude's tokenizer, which splits english finer than openai's
+func (p AnthropicProvider) TokenEstimator(model domain.Model) TokenEstimator {
+	return modelEstimator(model, 3.5)
+}
+
+// buildClaudeParams builds the messages api request shared by SendMessage and StreamMessage
+func buildClaudeParams(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool) anthropic.MessageNewParams {
+	system, input := messagesToClaudeInput(ctx, messages)
+	if config.SystemPrompt != "" {
+		system = append([]anthropic.TextBlockParam{{Text: config.SystemPrompt}}, system...)
+	}
+
+	params := anthropic.MessageNewParams{
+		Model:         anthropic.Model(modelID(config.Model)),
+		MaxTokens:     claudeMaxTokens,
+		System:        system,
+		Messages:      input,
+		StopSequences: config.Params.StopSequences,
+	}
+
+	gen := config.Params
+	if gen.Temperature != nil {
+		params.Temperature = anthropic.Float(*gen.Temperature)
+	}
+	if gen.TopP != nil {
+		params.TopP = anthropic.Float(*gen.TopP)
+	}
+	if gen.MaxOutputTokens != nil {
+		params.MaxTokens = int64(*gen.MaxOutputTokens)
+	}
+	// thinking blocks aren't stored, and with thinking on anthropic wants them back on the turn that called tools,
+	// so the steps after a tool call run without thinking
+	if gen.ReasoningEffort != "" && !endsInToolResult(messages) {
+		budget := int64(thinkingBudget(gen.ReasoningEffort, claudeMinThinking))
+		if gen.MaxOutputTokens == nil {
+			params.MaxTokens = budget + claudeMaxTokens
+		}
+		params.Thinking = anthropic.ThinkingConfigParamOfEnabled(budget)
+	}
+	if len(gen.ProviderOptions) > 0 {
+		params.SetExtraFields(gen.ProviderOptions)
+	}
+
+	if len(tools) > 0 {
+		params.Tools = claudeTools(tools)
+	}
+	return params
+}
+
+// endsInToolResult reports whether the conversation is in the middle of a tool loop
+func endsInToolResult(messages []domain.Message) bool {
+	return len(messages) > 0 && messages[len(messages)-1].Role == domain.RoleTool
+}
+
+// claudeResponse converts a messages api result to a provider Response
+func claudeResponse(msg *anthropic.Message) *Response {
+	var text strings.Builder
+	var calls []domain.ToolCall
+	for _, block := range msg.Content {
+		switch block.Type {
+		case "text":
+			text.WriteString(block.Text)
+		case "tool_use":
+			calls = append(calls, domain.ToolCall{ID: block.ID, Name: block.Name, Arguments: string(block.Input)})
+		}
+	}
+
+	return &Response{
+		Message: assistantMessage(text.String(), calls),
+		Usage: Usage{
+			InputTokens:  msg.Usage.InputTokens,
+			OutputTokens: msg.Usage.OutputTokens,
+			TotalTokens:  msg.Usage.InputTokens + msg.Usage.OutputTokens,
+		},
+		FinishReason: claudeFinishReason(msg.StopReason),
+	}
+}
+
+// claudeFinishReason maps an anthropic stop reason to a FinishReason
+func claudeFinishReason(reason anthropic.StopReason) FinishReason {
+	switch reason {
+	case anthropic.StopReasonMaxTokens:
+		return FinishLength
+	case anthropic.StopReasonToolUse:
+		return FinishToolCalls
+	case anthropic.StopReasonRefusal:
+		return FinishContentFilter
+	default:
+		return FinishStop
+	}
+}
+
+// buildClaudeUserBlocks creates the content blocks of a user message for Anthropic
+func buildClaudeUserBlocks(ctx context.Context, msg domain.Message) []anthropic.ContentBlockParamUnion {
+	blocks := make([]anthropic.ContentBlockParamUnion, 0, len(msg.Content.Media)+1)
+
+	// Add text content (with user prefix if multi-user)
+	if text := messageText(msg); text != "" {
+		blocks = append(blocks, anthropic.NewTextBlock(text))
+	}
+
+	// Add media, anthropic only reads images, pdfs and plain text
+	for _, media := range msg.Content.Media {
+		switch media.Type {
+		case "image":
+			blocks = append(blocks, anthropic.NewImageBlock(anthropic.URLImageSourceParam{URL: media.URL}))
+		case "file":
+			block, err := buildClaudeDocumentBlock(ctx, media)
+			if err != nil {
+				// the rest of the message still goes through, the model is told what it can't see
+				blocks = append(blocks, anthropic.NewTextBlock(fmt.Sprintf("[attachment %s left out: %v]", mediaName(media), err)))
+				continue
+			}
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+// buildClaudeDocumentBlock creates a document block for a file by its mime type
+// pdfs can be passed by url, text has to be inlined, anthropic reads no other documents
+func buildClaudeDocumentBlock(ctx context.Context, media domain.MediaItem) (anthropic.ContentBlockParamUnion, error) {
+	var block anthropic.ContentBlockParamUnion
+	switch mimeType := mediaMIMEType(media); {
+	case mimeType == "application/pdf":
+		_, data, ok, err := parseDataURL(media.URL)
+		if err != nil {
+			return block, err
+		}
+		if ok {
+			block = anthropic.NewDocumentBlock(anthropic.Base64PDFSourceParam{Data: base64.StdEncoding.EncodeToString(data)})
+		} else {
+			block = anthropic.NewDocumentBlock(anthropic.URLPDFSourceParam{URL: media.URL})
+		}
+	case strings.HasPrefix(mimeType, "text/"):
+		text, err := mediaText(ctx, media)
+		if err != nil {
+			return block, err
+		}
+		block = anthropic.NewDocumentBlock(anthropic.PlainTextSourceParam{Data: text})
+	default:
+		return block, fmt.Errorf("%s files aren't supported", mimeType)
+	}
+
+	if media.FileName != "" {
+		block.OfDocument.Title = anthropic.String(media.FileName)
+	}
+	return block, nil
+}
+
+// buildClaudeAssistantBlocks creates the content blocks of an assistant message, including the tools it used
+func buildClaudeAssistantBlocks(msg domain.Message) []anthropic.ContentBlockParamUnion {
+	blocks := make([]anthropic.ContentBlockParamUnion, 0, len(msg.Content.ToolCalls)+1)
+	if msg.Content.Text != "" {
+		blocks = append(blocks, anthropic.NewTextBlock(msg.Content.Text))
+	}
+	for _, call := range msg.Content.ToolCalls {
+		// tool_use input must be an object, arguments that don't parse as one are sent as empty
+		input := json.RawMessage(call.Arguments)
+		if !json.Valid(input) || len(input) == 0 {
+			input = json.RawMessage("{}")
+		}
+		blocks = append(blocks, anthropic.NewToolUseBlock(call.ID, input, call.Name))
+	}
+	return blocks
+}
+
+// messagesToClaudeInput converts messages to anthropic's shape
+// system and developer messages have no place in the messages list, they are lifted into the top-level system field
+// tool results are sent as user turns, as anthropic expects
+// consecutive messages with the same role are merged, since anthropic expects user and assistant turns to alternate
+func messagesToClaudeInput(ctx context.Context, messages []domain.Message) ([]anthropic.TextBlockParam, []anthropic.MessageParam) {
+	messages = pairToolCalls(messages)
+	var system []anthropic.TextBlockParam
+	input := make([]anthropic.MessageParam, 0, len(messages))
+
+	for _, msg := range messages {
+		var param anthropic.MessageParam
+		switch msg.Role {
+		case domain.RoleSystem, domain.RoleDeveloper:
+			if msg.Content.Text != "" {
+				system = append(system, anthropic.TextBlockParam{Text: msg.Content.Text})
+			}
+			continue
+		case domain.RoleAssistant:
+			blocks := buildClaudeAssistantBlocks(msg)
+			if len(blocks) == 0 {
+				continue
+			}
+			param = anthropic.NewAssistantMessage(blocks...)
+		case domain.RoleTool:
+			result := msg.Content.ToolResult
+			if result == nil {
+				continue
+			}
+			param = anthropic.NewUserMessage(anthropic.NewToolResultBlock(result.CallID, result.Content, result.IsError))
+		default:
+			blocks := buildClaudeUserBlocks(ctx, msg)
+			if len(blocks) == 0 {
+				continue
+			}
+			param = anthropic.NewUserMessage(blocks...)
+		}
+
+		if n := len(input); n > 0 && input[n-1].Role == param.Role {
+			input[n-1].Content = append(input[n-1].Content, param.Content...)
+			continue
+		}
+		input = append(input, param)
+	}
+
+	return system, input
+}
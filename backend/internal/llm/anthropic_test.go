@@ -0,0 +1,314 @@
+package llm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"github.com/anthropics/anthropic-sdk-go/option"
+)
+
+// newClaudeFake serves the recorded message for the messages api, or the recorded stream when the request streams
+func newClaudeFake(t *testing.T) (*recordedAPI, AnthropicProvider) {
+	t.Helper()
+	api, url := newRecordedAPI(t, func(r *http.Request, body map[string]any) string {
+		if r.URL.Path != "/v1/messages" {
+			return ""
+		}
+		if body["stream"] == true {
+			return "anthropic_stream.sse"
+		}
+		return "anthropic_tool_use.json"
+	})
+	return api, NewAnthropicProvider("test-key", option.WithBaseURL(url), option.WithMaxRetries(0))
+}
+
+var claudeTestConfig = domain.AIConfig{Model: domain.Model{Name: "claude-sonnet-4-5"}}
+
+// claudeRequest is the part of a messages api request the tests look at
+type claudeRequest struct {
+	System []struct {
+		Text string `json:"text"`
+	} `json:"system"`
+	Messages []struct {
+		Role    string        `json:"role"`
+		Content []claudeBlock `json:"content"`
+	} `json:"messages"`
+	Tools []struct {
+		Name string `json:"name"`
+	} `json:"tools"`
+}
+
+type claudeBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Title  string `json:"title"`
+	Source *struct {
+		Type      string `json:"type"`
+		URL       string `json:"url"`
+		Data      string `json:"data"`
+		MediaType string `json:"media_type"`
+	} `json:"source"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Input     json.RawMessage `json:"input"`
+	ToolUseID string          `json:"tool_use_id"`
+	Content   json.RawMessage `json:"content"`
+	IsError   bool            `json:"is_error"`
+}
+
+func TestClaudeRequest(t *testing.T) {
+	api, provider := newClaudeFake(t)
+
+	ada := &domain.User{Name: "ada"}
+	bob := &domain.User{Name: "bob"}
+	messages := []domain.Message{
+		{Role: domain.RoleSystem, Content: domain.MessageContent{Text: "the conversation so far was about boats"}},
+		{Role: domain.RoleUser, User: ada, Content: domain.MessageContent{
+			Text: "what's on this picture?",
+			Media: []domain.MediaItem{
+				{Type: "image", URL: "https://example.com/boat.png"},
+				{Type: "file", URL: "https://example.com/manual.pdf"},
+				{Type: "audio", URL: "https://example.com/horn.mp3"},
+			},
+		}},
+		{Role: domain.RoleUser, User: bob, Content: domain.MessageContent{Text: "and is it windy in oslo?"}},
+		{Role: domain.RoleDeveloper, Content: domain.MessageContent{Text: "answer briefly"}},
+		{Role: domain.RoleAssistant, Content: domain.MessageContent{
+			Text:      "A sailboat. Checking the wind.",
+			ToolCalls: []domain.ToolCall{{ID: "toolu_1", Name: "get_weather", Arguments: `{"city":"Oslo"}`}},
+		}},
+		{Role: domain.RoleTool, Content: domain.MessageContent{
+			ToolResult: &domain.ToolResult{CallID: "toolu_1", Name: "get_weather", Content: "weather service is down", IsError: true},
+		}},
+	}
+	config := claudeTestConfig
+	config.SystemPrompt = "you are io"
+
+	if _, err := provider.SendMessage(context.Background(), messages, config, []Tool{weatherTool}); err != nil {
+		t.Fatal(err)
+	}
+	var req claudeRequest
+	api.decodeRequest(t, &req)
+
+	// the config's prompt comes first, system and developer messages are lifted after it
+	var system []string
+	for _, s := range req.System {
+		system = append(system, s.Text)
+	}
+	if want := []string{"you are io", "the conversation so far was about boats", "answer briefly"}; strings.Join(system, "|") != strings.Join(want, "|") {
+		t.Errorf("got system %q, want %q", system, want)
+	}
+
+	if len(req.Messages) != 3 {
+		t.Fatalf("got %d messages, want user, assistant and the tool result", len(req.Messages))
+	}
+	user, assistant, result := req.Messages[0], req.Messages[1], req.Messages[2]
+
+	// both users' messages merge into one turn, each prefixed with its author, audio is left out
+	if user.Role != "user" || len(user.Content) != 4 {
+		t.Fatalf("got user turn %+v", user)
+	}
+	if b := user.Content[0]; b.Type != "text" || b.Text != "ada: what's on this picture?" {
+		t.Errorf("got first block %+v", b)
+	}
+	if b := user.Content[1]; b.Type != "image" || b.Source == nil || b.Source.Type != "url" || b.Source.URL != "https://example.com/boat.png" {
+		t.Errorf("got image block %+v", b)
+	}
+	if b := user.Content[2]; b.Type != "document" || b.Source == nil || b.Source.URL != "https://example.com/manual.pdf" {
+		t.Errorf("got document block %+v", b)
+	}
+	if b := user.Content[3]; b.Type != "text" || b.Text != "bob: and is it windy in oslo?" {
+		t.Errorf("got last block %+v", b)
+	}
+
+	if assistant.Role != "assistant" || len(assistant.Content) != 2 {
+		t.Fatalf("got assistant turn %+v", assistant)
+	}
+	if b := assistant.Content[1]; b.Type != "tool_use" || b.ID != "toolu_1" || b.Name != "get_weather" || string(b.Input) != `{"city":"Oslo"}` {
+		t.Errorf("got tool use %+v", b)
+	}
+
+	if result.Role != "user" || len(result.Content) != 1 {
+		t.Fatalf("got tool result turn %+v", result)
+	}
+	if b := result.Content[0]; b.Type != "tool_result" || b.ToolUseID != "toolu_1" || !b.IsError || !strings.Contains(string(b.Content), "weather service is down") {
+		t.Errorf("got tool result %+v", b)
+	}
+
+	if len(req.Tools) != 1 || req.Tools[0].Name != "get_weather" {
+		t.Errorf("got tools %+v", req.Tools)
+	}
+}
+
+func TestClaudeDocuments(t *testing.T) {
+	api, provider := newClaudeFake(t)
+	files := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/notes.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("buy rope"))
+	}))
+	t.Cleanup(files.Close)
+	attachmentClient = files.Client()
+	t.Cleanup(func() { attachmentClient = http.DefaultClient })
+
+	media := []domain.MediaItem{
+		{Type: "file", URL: "https://example.com/manual.pdf"},
+		{Type: "file", URL: "data:application/pdf;base64,JVBERi0=", FileName: "scan.pdf"},
+		{Type: "file", URL: files.URL + "/notes.txt"},
+		{Type: "file", URL: "data:text/csv;base64," + base64.StdEncoding.EncodeToString([]byte("a,b\n1,2")), FileName: "table.csv"},
+		{Type: "file", URL: "https://example.com/archive.bin"},
+		{Type: "file", URL: files.URL + "/missing.txt"},
+		{Type: "file", URL: "http://backend:8080/secrets.txt"},
+	}
+	messages := []domain.Message{{Role: domain.RoleUser, Content: domain.MessageContent{Text: "read these", Media: media}}}
+	if _, err := provider.SendMessage(context.Background(), messages, claudeTestConfig, nil); err != nil {
+		t.Fatal(err)
+	}
+	var req claudeRequest
+	api.decodeRequest(t, &req)
+
+	blocks := req.Messages[0].Content[1:]
+	if len(blocks) != len(media) {
+		t.Fatalf("got %d blocks for %d files", len(blocks), len(media))
+	}
+	document := func(i int, source, want string) {
+		t.Helper()
+		b := blocks[i]
+		if b.Type != "document" || b.Source == nil || b.Source.Type != source {
+			t.Fatalf("file %d: got %+v, want a %s document", i, b, source)
+		}
+		if got := b.Source.URL + b.Source.Data; got != want {
+			t.Errorf("file %d: got %q, want %q", i, got, want)
+		}
+	}
+	document(0, "url", "https://example.com/manual.pdf")
+	document(1, "base64", "JVBERi0=")
+	document(2, "text", "buy rope")
+	document(3, "text", "a,b\n1,2")
+	if blocks[1].Title != "scan.pdf" || blocks[3].Title != "table.csv" {
+		t.Errorf("got titles %q and %q", blocks[1].Title, blocks[3].Title)
+	}
+
+	// what claude can't read is left out with a note, rather than failing the request
+	for i, want := range map[int]string{
+		4: "[attachment archive.bin left out: application/octet-stream files aren't supported]",
+		5: "[attachment missing.txt left out: failed to fetch: 404 Not Found]",
+		6: "[attachment secrets.txt left out: only https attachments are fetched]",
+	} {
+		if b := blocks[i]; b.Type != "text" || b.Text != want {
+			t.Errorf("file %d: got %+v, want the note %q", i, b, want)
+		}
+	}
+}
+
+func TestClaudeToolUseRoundTrip(t *testing.T) {
+	api, provider := newClaudeFake(t)
+	ctx := context.Background()
+
+	history := []domain.Message{userMessage("weather in oslo?")}
+	resp, err := provider.SendMessage(ctx, history, claudeTestConfig, []Tool{weatherTool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FinishReason != FinishToolCalls {
+		t.Errorf("got finish reason %q, want %q", resp.FinishReason, FinishToolCalls)
+	}
+	if resp.Usage.InputTokens != 412 || resp.Usage.OutputTokens != 57 || resp.Usage.TotalTokens != 469 {
+		t.Errorf("got usage %+v", resp.Usage)
+	}
+	if resp.Message.Content.Text != "Let me look that up." {
+		t.Errorf("got text %q", resp.Message.Content.Text)
+	}
+	calls := resp.Message.Content.ToolCalls
+	if len(calls) != 1 || calls[0].ID != "toolu_01A09q90qw90lq917835lq9" || calls[0].Name != "get_weather" || calls[0].Arguments != `{"city":"Oslo"}` {
+		t.Fatalf("got calls %+v", calls)
+	}
+
+	history = append(history, resp.Message, domain.Message{Role: domain.RoleTool, Content: domain.MessageContent{
+		ToolResult: &domain.ToolResult{CallID: calls[0].ID, Name: calls[0].Name, Content: "4°C and sunny"},
+	}})
+	if _, err := provider.SendMessage(ctx, history, claudeTestConfig, []Tool{weatherTool}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the tool_use goes back as it came, and the result refers to it by id
+	var req claudeRequest
+	api.decodeRequest(t, &req)
+	if len(req.Messages) != 3 {
+		t.Fatalf("got %d messages, want 3", len(req.Messages))
+	}
+	use := req.Messages[1].Content[len(req.Messages[1].Content)-1]
+	if use.Type != "tool_use" || use.ID != calls[0].ID || string(use.Input) != calls[0].Arguments {
+		t.Errorf("got tool use %+v", use)
+	}
+	result := req.Messages[2].Content[0]
+	if result.Type != "tool_result" || result.ToolUseID != calls[0].ID || result.IsError || !strings.Contains(string(result.Content), "4°C and sunny") {
+		t.Errorf("got tool result %+v", result)
+	}
+}
+
+func TestClaudeStream(t *testing.T) {
+	_, provider := newClaudeFake(t)
+
+	var deltas []string
+	var progress []ToolCallProgress
+	handler := func(e StreamEvent) error {
+		switch e.Type {
+		case StreamEventTextDelta:
+			deltas = append(deltas, e.Text)
+		case StreamEventToolCall:
+			progress = append(progress, e.ToolCall)
+		}
+		return nil
+	}
+	resp, err := provider.StreamMessage(context.Background(), []domain.Message{userMessage("weather in oslo?")}, claudeTestConfig, []Tool{weatherTool}, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(deltas, "|") != "Let me |look that up." {
+		t.Errorf("got text deltas %q", deltas)
+	}
+
+	const id = "toolu_01T1x1fJ34qAmk2tNTrN7Up6"
+	var arguments strings.Builder
+	for _, p := range progress {
+		if p.ID != id {
+			t.Errorf("got progress for %q, want %q", p.ID, id)
+		}
+		arguments.WriteString(p.ArgumentsDelta)
+	}
+	if len(progress) < 2 || progress[0].Status != ToolCallStarted || progress[0].Name != "get_weather" || progress[len(progress)-1].Status != ToolCallCompleted {
+		t.Errorf("got tool progress %+v", progress)
+	}
+	if arguments.String() != `{"city": "Oslo"}` {
+		t.Errorf("got argument deltas %q", arguments.String())
+	}
+
+	if resp.Message.Content.Text != "Let me look that up." {
+		t.Errorf("got text %q", resp.Message.Content.Text)
+	}
+	calls := resp.Message.Content.ToolCalls
+	if len(calls) != 1 || calls[0].ID != id || calls[0].Name != "get_weather" {
+		t.Fatalf("got calls %+v", calls)
+	}
+	var args map[string]string
+	if err := json.Unmarshal([]byte(calls[0].Arguments), &args); err != nil || args["city"] != "Oslo" {
+		t.Errorf("got arguments %s", calls[0].Arguments)
+	}
+	if resp.FinishReason != FinishToolCalls {
+		t.Errorf("got finish reason %q, want %q", resp.FinishReason, FinishToolCalls)
+	}
+	if resp.Usage.OutputTokens != 57 {
+		t.Errorf("got %d output tokens, want 57", resp.Usage.OutputTokens)
+	}
+}
@@ -0,0 +1,312 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+	"github.com/openai/openai-go/v3/shared"
+)
+
+// APIMode is the OpenAI api dialect a compatible endpoint speaks
+type APIMode string
+
+const (
+	APIModeChatCompletions APIMode = "chat_completions" // /chat/completions, understood by nearly every compatible server
+	APIModeResponses       APIMode = "responses"        // /responses, e.g. grok
+)
+
+// CompatibleConfig describes an OpenAI-compatible endpoint
+type CompatibleConfig struct {
+	BaseURL string
+	APIKey  string            // optional, local servers usually don't need one
+	Headers map[string]string // extra headers sent with every request
+	Mode    APIMode
+}
+
+// OpenAICompatibleProvider talks to any endpoint that speaks the OpenAI api (grok, ollama, llama.cpp, vllm, ...)
+// these servers host arbitrary models, which are all resolved from the model registry like everywhere else
+type OpenAICompatibleProvider struct {
+	client *openai.Client
+	mode   APIMode
+}
+
+// NewOpenAICompatibleProvider creates a provider for the endpoint described by cfg
+func NewOpenAICompatibleProvider(cfg CompatibleConfig, opts ...option.RequestOption) (OpenAICompatibleProvider, error) {
+	if cfg.BaseURL == "" {
+		return OpenAICompatibleProvider{}, fmt.Errorf("base url is required")
+	}
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = APIModeChatCompletions
+	case APIModeChatCompletions, APIModeResponses:
+	default:
+		return OpenAICompatibleProvider{}, fmt.Errorf("unknown api mode: %s", cfg.Mode)
+	}
+
+	// the key is always set explicitly, the sdk would otherwise fall back on OPENAI_API_KEY,
+	// which must never leak to third party endpoints
+	clientOpts := []option.RequestOption{
+		option.WithBaseURL(cfg.BaseURL),
+		option.WithAPIKey(cfg.APIKey),
+	}
+	if cfg.APIKey == "" {
+		clientOpts = append(clientOpts, option.WithHeaderDel("authorization"))
+	}
+	for key, value := range cfg.Headers {
+		clientOpts = append(clientOpts, option.WithHeader(key, value))
+	}
+	client := openai.NewClient(append(clientOpts, opts...)...)
+
+	return OpenAICompatibleProvider{client: &client, mode: cfg.Mode}, nil
+}
+
+func (p OpenAICompatibleProvider) SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool) (*Response, error) {
+	if p.mode == APIModeResponses {
+		return sendResponses(ctx, p.client, buildParams(messages, config, tools))
+	}
+
+	resp, err := p.client.Chat.Completions.New(ctx, buildChatParams(messages, config, tools))
+	if err != nil {
+		return nil, fmt.Errorf("chat completions api error: %w", err)
+	}
+
+	return chatResponse(resp), nil
+}
+
+// ValidateParams goes by the registry for the model and by the endpoint's api for the rest,
+// compatible servers are lenient about sampling params
+func (p OpenAICompatibleProvider) ValidateParams(model domain.Model, params domain.GenerationParams) error {
+	capabilities := openCapabilities
+	capabilities.MaxOutputTokens = model.MaxOutputTokens
+	capabilities.Reasoning = model.Capabilities.Reasoning
+	if p.mode == APIModeResponses {
+		capabilities.StopSequences = 0
+	}
+	return capabilities.Validate(params)
+}
+
+// TokenEstimator estimates the vocabulary of the model's family, compatible servers run all kinds of them
+// models of no known family get a conservative estimate
+func (p OpenAICompatibleProvider) TokenEstimator(model domain.Model) TokenEstimator {
+	return modelEstimator(model, 3.5)
+}
+
+// StreamMessage streams from whichever api the endpoint speaks, forwarding text deltas and tool call progress to handler
+func (p OpenAICompatibleProvider) StreamMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool, handler StreamHandler) (*Response, error) {
+	if p.mode == APIModeResponses {
+		return streamResponses(ctx, p.client, buildParams(messages, config, tools), handler)
+	}
+
+	params := buildChatParams(messages, config, tools)
+	params.StreamOptions = openai.ChatCompletionStreamOptionsParam{IncludeUsage: openai.Bool(true)}
+
+	stream := p.client.Chat.Completions.NewStreaming(ctx, params)
+	defer stream.Close()
+
+	// chat completions identify tool calls by index, only the first chunk of a call carries its id and name
+	var acc openai.ChatCompletionAccumulator
+	calls := make(map[int64]ToolCallProgress)
+
+	for stream.Next() {
+		chunk := stream.Current()
+		if !acc.AddChunk(chunk) {
+			return nil, fmt.Errorf("chat completions stream error: could not accumulate chunk")
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		delta := chunk.Choices[0].Delta
+
+		if delta.Content != "" {
+			if err := handler(StreamEvent{Type: StreamEventTextDelta, Text: delta.Content}); err != nil {
+				return nil, err
+			}
+		}
+		for _, call := range delta.ToolCalls {
+			progress, seen := calls[call.Index]
+			if !seen {
+				progress = ToolCallProgress{ID: call.ID, Name: call.Function.Name}
+				calls[call.Index] = progress
+				if err := handler(StreamEvent{Type: StreamEventToolCall, ToolCall: withStatus(progress, ToolCallStarted, "")}); err != nil {
+					return nil, err
+				}
+			}
+			if call.Function.Arguments != "" {
+				if err := handler(StreamEvent{Type: StreamEventToolCall, ToolCall: withStatus(progress, ToolCallArguments, call.Function.Arguments)}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if tool, ok := acc.JustFinishedToolCall(); ok {
+			progress := calls[int64(tool.Index)]
+			if err := handler(StreamEvent{Type: StreamEventToolCall, ToolCall: withStatus(progress, ToolCallCompleted, "")}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, fmt.Errorf("chat completions api error: %w", err)
+	}
+
+	return chatResponse(&acc.ChatCompletion), nil
+}
+
+// withStatus returns a copy of progress in the given stage
+func withStatus(progress ToolCallProgress, status ToolCallStatus, argumentsDelta string) ToolCallProgress {
+	progress.Status = status
+	progress.ArgumentsDelta = argumentsDelta
+	return progress
+}
+
+// buildChatParams builds the chat completions request shared by SendMessage and StreamMessage
+// max_tokens is used over max_completion_tokens since that is what most compatible servers understand
+func buildChatParams(messages []domain.Message, config domain.AIConfig, tools []Tool) openai.ChatCompletionNewParams {
+	input := messagesToChatInput(messages)
+	if config.SystemPrompt != "" {
+		input = append([]openai.ChatCompletionMessageParamUnion{openai.SystemMessage(config.SystemPrompt)}, input...)
+	}
+
+	params := openai.ChatCompletionNewParams{
+		Model:    modelID(config.Model),
+		Messages: input,
+	}
+
+	gen := config.Params
+	if gen.Temperature != nil {
+		params.Temperature = openai.Float(*gen.Temperature)
+	}
+	if gen.TopP != nil {
+		params.TopP = openai.Float(*gen.TopP)
+	}
+	if gen.MaxOutputTokens != nil {
+		params.MaxTokens = openai.Int(int64(*gen.MaxOutputTokens))
+	}
+	if gen.ReasoningEffort != "" {
+		params.ReasoningEffort = shared.ReasoningEffort(gen.ReasoningEffort)
+	}
+	if len(gen.StopSequences) > 0 {
+		params.Stop = openai.ChatCompletionNewParamsStopUnion{OfStringArray: gen.StopSequences}
+	}
+	if len(gen.ProviderOptions) > 0 {
+		params.SetExtraFields(gen.ProviderOptions)
+	}
+
+	if len(tools) > 0 {
+		params.Tools = chatTools(tools)
+	}
+	return params
+}
+
+// chatResponse converts a chat completion to a provider Response
+func chatResponse(completion *openai.ChatCompletion) *Response {
+	var text string
+	var calls []domain.ToolCall
+	finish := FinishStop
+	if len(completion.Choices) > 0 {
+		choice := completion.Choices[0]
+		text = choice.Message.Content
+		for _, call := range choice.Message.ToolCalls {
+			if call.Type == "function" {
+				calls = append(calls, domain.ToolCall{ID: call.ID, Name: call.Function.Name, Arguments: call.Function.Arguments})
+			}
+		}
+		switch choice.FinishReason {
+		case "length":
+			finish = FinishLength
+		case "tool_calls", "function_call":
+			finish = FinishToolCalls
+		case "content_filter":
+			finish = FinishContentFilter
+		}
+	}
+
+	return &Response{
+		Message: assistantMessage(text, calls),
+		Usage: Usage{
+			InputTokens:  completion.Usage.PromptTokens,
+			OutputTokens: completion.Usage.CompletionTokens,
+			TotalTokens:  completion.Usage.TotalTokens,
+		},
+		FinishReason: finish,
+	}
+}
+
+// buildChatUserMessage creates a user message for chat completions
+// only images are sent along, files and other media have no portable representation in this dialect
+func buildChatUserMessage(msg domain.Message) (openai.ChatCompletionMessageParamUnion, bool) {
+	parts := make([]openai.ChatCompletionContentPartUnionParam, 0, len(msg.Content.Media)+1)
+
+	// Add text content (with user prefix if multi-user)
+	if text := messageText(msg); text != "" {
+		parts = append(parts, openai.TextContentPart(text))
+	}
+
+	for _, media := range msg.Content.Media {
+		if media.Type == "image" {
+			parts = append(parts, openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{URL: media.URL}))
+		}
+	}
+
+	if len(parts) == 0 {
+		return openai.ChatCompletionMessageParamUnion{}, false
+	}
+	return openai.UserMessage(parts), true
+}
+
+// buildChatAssistantMessage creates an assistant message for chat completions, including the tool calls it made
+func buildChatAssistantMessage(msg domain.Message) (openai.ChatCompletionMessageParamUnion, bool) {
+	if msg.Content.Text == "" && len(msg.Content.ToolCalls) == 0 {
+		return openai.ChatCompletionMessageParamUnion{}, false
+	}
+
+	param := openai.ChatCompletionAssistantMessageParam{}
+	if msg.Content.Text != "" {
+		param.Content.OfString = openai.String(msg.Content.Text)
+	}
+	for _, call := range msg.Content.ToolCalls {
+		param.ToolCalls = append(param.ToolCalls, openai.ChatCompletionMessageToolCallUnionParam{
+			OfFunction: &openai.ChatCompletionMessageFunctionToolCallParam{
+				ID: call.ID,
+				Function: openai.ChatCompletionMessageFunctionToolCallFunctionParam{
+					Name:      call.Name,
+					Arguments: call.Arguments,
+				},
+			},
+		})
+	}
+	return openai.ChatCompletionMessageParamUnion{OfAssistant: &param}, true
+}
+
+// messagesToChatInput converts messages to chat completions messages
+// developer messages are sent as system messages, since most compatible servers don't know the developer role
+func messagesToChatInput(messages []domain.Message) []openai.ChatCompletionMessageParamUnion {
+	messages = pairToolCalls(messages)
+	items := make([]openai.ChatCompletionMessageParamUnion, 0, len(messages))
+
+	for _, msg := range messages {
+		switch msg.Role {
+		case domain.RoleSystem, domain.RoleDeveloper:
+			if msg.Content.Text != "" {
+				items = append(items, openai.SystemMessage(msg.Content.Text))
+			}
+		case domain.RoleAssistant:
+			if item, ok := buildChatAssistantMessage(msg); ok {
+				items = append(items, item)
+			}
+		case domain.RoleTool:
+			if result := msg.Content.ToolResult; result != nil {
+				items = append(items, openai.ToolMessage(result.Content, result.CallID))
+			}
+		default:
+			if item, ok := buildChatUserMessage(msg); ok {
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,116 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/openai/openai-go/v3"
+)
+
+// EmbeddingDimensions is the size of every embedding, the embeddings table is fixed to it
+const EmbeddingDimensions = 1536
+
+// Embedder turns text into vectors for semantic search, it sits alongside Provider
+type Embedder interface {
+	// Name identifies the embedder's vector space, vectors of different embedders aren't compared
+	Name() string
+	// Embed returns one vector of EmbeddingDimensions per text, in order
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// OpenAIEmbedder uses the embeddings api, of openai or a compatible endpoint
+type OpenAIEmbedder struct {
+	client *openai.Client
+	model  string
+}
+
+// NewOpenAIEmbedder creates an OpenAIEmbedder for a model that can shorten its vectors to EmbeddingDimensions,
+// like text-embedding-3-small or -large
+func NewOpenAIEmbedder(client *openai.Client, model string) OpenAIEmbedder {
+	return OpenAIEmbedder{client: client, model: model}
+}
+
+func (e OpenAIEmbedder) Name() string {
+	return "openai:" + e.model
+}
+
+func (e OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	resp, err := e.client.Embeddings.New(ctx, openai.EmbeddingNewParams{
+		Input:      openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: texts},
+		Model:      e.model,
+		Dimensions: openai.Int(EmbeddingDimensions),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || int(d.Index) >= len(texts) {
+			return nil, fmt.Errorf("embedding index %d out of range", d.Index)
+		}
+		v := make([]float32, len(d.Embedding))
+		for i, f := range d.Embedding {
+			v[i] = float32(f)
+		}
+		vectors[d.Index] = v
+	}
+	for i, v := range vectors {
+		if len(v) != EmbeddingDimensions {
+			return nil, fmt.Errorf("embedding %d has %d dimensions, want %d", i, len(v), EmbeddingDimensions)
+		}
+	}
+	return vectors, nil
+}
+
+// FakeEmbedder hashes words into a vector, so texts sharing words come out close
+// it needs no network or api key and always gives the same vector for the same text, for tests and offline use
+type FakeEmbedder struct{}
+
+func (FakeEmbedder) Name() string {
+	return "fake"
+}
+
+func (FakeEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = fakeEmbedding(text)
+	}
+	return vectors, nil
+}
+
+// fakeEmbedding is a normalized bag of hashed words, text without words still gets a valid, non-zero vector
+func fakeEmbedding(text string) []float32 {
+	v := make([]float32, EmbeddingDimensions)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		sum := h.Sum64()
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		v[sum%EmbeddingDimensions] += sign
+	}
+
+	var norm float64
+	for _, f := range v {
+		norm += float64(f) * float64(f)
+	}
+	if norm == 0 {
+		v[0] = 1
+		return v
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range v {
+		v[i] *= scale
+	}
+	return v
+}
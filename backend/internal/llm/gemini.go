@@ -0,0 +1,372 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"google.golang.org/genai"
+)
+
+type GeminiProvider struct {
+	client *genai.Client
+}
+
+// geminiMinThinking is the thinking budget of the minimal reasoning effort, the smallest one every thinking model takes
+const geminiMinThinking = 512
+
+// NewGeminiProvider creates a GeminiProvider for the Gemini developer api
+// httpOptions can point the client elsewhere (e.g. an httptest server), the zero value uses the real api
+func NewGeminiProvider(ctx context.Context, apikey string, httpOptions genai.HTTPOptions) (GeminiProvider, error) {
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:      apikey,
+		Backend:     genai.BackendGeminiAPI,
+		HTTPOptions: httpOptions,
+	})
+	if err != nil {
+		return GeminiProvider{}, fmt.Errorf("failed to create gemini client: %w", err)
+	}
+	return GeminiProvider{client: client}, nil
+}
+
+func (p GeminiProvider) SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool) (*Response, error) {
+	model, contents, params := buildGeminiParams(messages, config, tools)
+
+	resp, err := p.client.Models.GenerateContent(ctx, model, contents, params)
+	if err != nil {
+		return nil, fmt.Errorf("gemini api error: %w", err)
+	}
+
+	calls, err := geminiToolCalls(resp.FunctionCalls(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	text, finish := geminiCandidate(resp)
+	return &Response{
+		Message:      assistantMessage(text, calls),
+		Usage:        geminiUsage(resp),
+		FinishReason: finish,
+	}, nil
+}
+
+// StreamMessage uses streamGenerateContent, forwarding text deltas and function calls to handler
+// gemini sends function calls whole rather than as argument deltas, so each one is reported started and completed at once
+func (p GeminiProvider) StreamMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool, handler StreamHandler) (*Response, error) {
+	model, contents, params := buildGeminiParams(messages, config, tools)
+
+	var text strings.Builder
+	var usage Usage
+	var calls []domain.ToolCall
+	finish := FinishStop
+
+	for chunk, err := range p.client.Models.GenerateContentStream(ctx, model, contents, params) {
+		if err != nil {
+			return nil, fmt.Errorf("gemini api error: %w", err)
+		}
+
+		delta, chunkFinish := geminiCandidate(chunk)
+		if delta != "" {
+			text.WriteString(delta)
+			if err := handler(StreamEvent{Type: StreamEventTextDelta, Text: delta}); err != nil {
+				return nil, err
+			}
+		}
+		chunkCalls, err := geminiToolCalls(chunk.FunctionCalls(), len(calls))
+		if err != nil {
+			return nil, err
+		}
+		for _, call := range chunkCalls {
+			calls = append(calls, call)
+			if err := reportGeminiToolCall(call, handler); err != nil {
+				return nil, err
+			}
+		}
+
+		// usage is cumulative, the last chunk carries the totals
+		if chunk.UsageMetadata != nil {
+			usage = geminiUsage(chunk)
+		}
+		if len(chunk.Candidates) > 0 && chunk.Candidates[0].FinishReason != "" {
+			finish = chunkFinish
+		}
+	}
+
+	// calls may arrive in an earlier chunk than the finish reason
+	if finish == FinishStop && len(calls) > 0 {
+		finish = FinishToolCalls
+	}
+
+	return &Response{
+		Message:      assistantMessage(text.String(), calls),
+		Usage:        usage,
+		FinishReason: finish,
+	}, nil
+}
+
+// ValidateParams goes by the registry for the model, reasoning effort sets its thinking budget
+func (p GeminiProvider) ValidateParams(model domain.Model, params domain.GenerationParams) error {
+	capabilities := Capabilities{
+		MaxTemperature:  2,
+		TopP:            true,
+		MaxOutputTokens: model.MaxOutputTokens,
+		Reasoning:       model.Capabilities.Reasoning,
+		StopSequences:   5,
+	}
+	return capabilities.Validate(params)
+}
+
+// TokenEstimator estimates gemini's sentencepiece vocabulary, close to four characters per token
+func (p GeminiProvider) TokenEstimator(model domain.Model) TokenEstimator {
+	return modelEstimator(model, 4)
+}
+
+// buildGeminiParams builds the generateContent request shared by SendMessage and StreamMessage
+func buildGeminiParams(messages []domain.Message, config domain.AIConfig, tools []Tool) (string, []*genai.Content, *genai.GenerateContentConfig) {
+	system, contents := messagesToGeminiInput(messages)
+	if config.SystemPrompt != "" {
+		system = append([]*genai.Part{genai.NewPartFromText(config.SystemPrompt)}, system...)
+	}
+
+	params := &genai.GenerateContentConfig{
+		StopSequences: config.Params.StopSequences,
+	}
+
+	gen := config.Params
+	if gen.Temperature != nil {
+		params.Temperature = genai.Ptr(float32(*gen.Temperature))
+	}
+	if gen.TopP != nil {
+		params.TopP = genai.Ptr(float32(*gen.TopP))
+	}
+	if gen.MaxOutputTokens != nil {
+		params.MaxOutputTokens = *gen.MaxOutputTokens
+	}
+	if gen.ReasoningEffort != "" {
+		params.ThinkingConfig = &genai.ThinkingConfig{ThinkingBudget: genai.Ptr(thinkingBudget(gen.ReasoningEffort, geminiMinThinking))}
+	}
+	if len(gen.ProviderOptions) > 0 {
+		params.HTTPOptions = &genai.HTTPOptions{ExtraBody: gen.ProviderOptions}
+	}
+
+	if len(system) > 0 {
+		params.SystemInstruction = &genai.Content{Parts: system}
+	}
+	if len(tools) > 0 {
+		params.Tools = geminiTools(tools)
+	}
+
+	return modelID(config.Model), contents, params
+}
+
+// geminiCandidate returns the text and finish reason of a response's first candidate, skipping thought parts
+func geminiCandidate(resp *genai.GenerateContentResponse) (string, FinishReason) {
+	if len(resp.Candidates) == 0 {
+		// a missing candidate means the prompt itself was blocked
+		if resp.PromptFeedback != nil && resp.PromptFeedback.BlockReason != "" {
+			return "", FinishContentFilter
+		}
+		return "", FinishStop
+	}
+
+	candidate := resp.Candidates[0]
+	var text strings.Builder
+	if candidate.Content != nil {
+		for _, part := range candidate.Content.Parts {
+			if !part.Thought {
+				text.WriteString(part.Text)
+			}
+		}
+	}
+
+	return text.String(), geminiFinishReason(candidate.FinishReason, len(resp.FunctionCalls()) > 0)
+}
+
+// geminiFinishReason maps a gemini finish reason to a FinishReason
+// gemini reports STOP even when it stops to call functions, so that is decided by the presence of calls
+func geminiFinishReason(reason genai.FinishReason, hasCalls bool) FinishReason {
+	switch reason {
+	case genai.FinishReasonMaxTokens:
+		return FinishLength
+	case genai.FinishReasonSafety, genai.FinishReasonRecitation, genai.FinishReasonBlocklist,
+		genai.FinishReasonProhibitedContent, genai.FinishReasonSPII:
+		return FinishContentFilter
+	}
+	if hasCalls {
+		return FinishToolCalls
+	}
+	return FinishStop
+}
+
+// geminiUsage converts gemini usage metadata to Usage
+func geminiUsage(resp *genai.GenerateContentResponse) Usage {
+	if resp.UsageMetadata == nil {
+		return Usage{}
+	}
+	return Usage{
+		InputTokens:  int64(resp.UsageMetadata.PromptTokenCount),
+		OutputTokens: int64(resp.UsageMetadata.CandidatesTokenCount),
+		TotalTokens:  int64(resp.UsageMetadata.TotalTokenCount),
+	}
+}
+
+// geminiToolCalls converts gemini function calls to tool calls, offset is how many calls the response had before these
+// the gemini api usually leaves call ids empty, those get name#index so parallel calls to one function stay apart
+func geminiToolCalls(calls []*genai.FunctionCall, offset int) ([]domain.ToolCall, error) {
+	var toolCalls []domain.ToolCall
+	for i, call := range calls {
+		args, err := json.Marshal(call.Args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal function call args: %w", err)
+		}
+
+		id := call.ID
+		if id == "" {
+			id = call.Name + "#" + strconv.Itoa(offset+i)
+		}
+		toolCalls = append(toolCalls, domain.ToolCall{ID: id, Name: call.Name, Arguments: string(args)})
+	}
+	return toolCalls, nil
+}
+
+// geminiCallID returns the id to send back to gemini, ids made up by geminiToolCalls are left out
+func geminiCallID(id, name string) string {
+	index, ok := strings.CutPrefix(id, name+"#")
+	if !ok {
+		return id
+	}
+	if _, err := strconv.Atoi(index); err != nil {
+		return id
+	}
+	return ""
+}
+
+// reportGeminiToolCall sends the started and completed events for a complete function call
+func reportGeminiToolCall(call domain.ToolCall, handler StreamHandler) error {
+	progress := ToolCallProgress{ID: call.ID, Name: call.Name}
+	for _, status := range []ToolCallStatus{ToolCallStarted, ToolCallArguments, ToolCallCompleted} {
+		progress.Status = status
+		progress.ArgumentsDelta = ""
+		if status == ToolCallArguments {
+			progress.ArgumentsDelta = call.Arguments
+		}
+		if err := handler(StreamEvent{Type: StreamEventToolCall, ToolCall: progress}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildGeminiMediaPart creates a part for a media item
+// data: urls are sent inline, anything else is passed by reference as file data
+func buildGeminiMediaPart(media domain.MediaItem) (*genai.Part, error) {
+	if mimeType, data, ok, err := parseDataURL(media.URL); ok {
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", media.Type, err)
+		}
+		return genai.NewPartFromBytes(data, mimeType), nil
+	}
+	return genai.NewPartFromURI(media.URL, mediaMIMEType(media)), nil
+}
+
+// buildGeminiUserContent creates the content of a user message for Gemini
+func buildGeminiUserContent(msg domain.Message) *genai.Content {
+	parts := make([]*genai.Part, 0, len(msg.Content.Media)+1)
+
+	// Add text content (with user prefix if multi-user)
+	if text := messageText(msg); text != "" {
+		parts = append(parts, genai.NewPartFromText(text))
+	}
+
+	// Add all media items, ones that can't be represented are left out rather than failing the whole request
+	for _, media := range msg.Content.Media {
+		part, err := buildGeminiMediaPart(media)
+		if err != nil {
+			continue
+		}
+		parts = append(parts, part)
+	}
+
+	return genai.NewContentFromParts(parts, genai.RoleUser)
+}
+
+// buildGeminiModelContent creates the content of an assistant message, including the functions it called
+func buildGeminiModelContent(msg domain.Message) *genai.Content {
+	parts := make([]*genai.Part, 0, len(msg.Content.ToolCalls)+1)
+	if msg.Content.Text != "" {
+		parts = append(parts, genai.NewPartFromText(msg.Content.Text))
+	}
+	for _, call := range msg.Content.ToolCalls {
+		var args map[string]any
+		_ = json.Unmarshal([]byte(call.Arguments), &args)
+		parts = append(parts, &genai.Part{FunctionCall: &genai.FunctionCall{
+			ID:   geminiCallID(call.ID, call.Name),
+			Name: call.Name,
+			Args: args,
+		}})
+	}
+	return genai.NewContentFromParts(parts, genai.RoleModel)
+}
+
+// buildGeminiFunctionResponse creates the user content answering a function call
+// gemini expects the output under "output", or under "error" when the call failed
+func buildGeminiFunctionResponse(result domain.ToolResult) *genai.Content {
+	key := "output"
+	if result.IsError {
+		key = "error"
+	}
+	part := &genai.Part{FunctionResponse: &genai.FunctionResponse{
+		ID:       geminiCallID(result.CallID, result.Name),
+		Name:     result.Name,
+		Response: map[string]any{key: result.Content},
+	}}
+	return genai.NewContentFromParts([]*genai.Part{part}, genai.RoleUser)
+}
+
+// messagesToGeminiInput converts messages to gemini's shape
+// system and developer messages are lifted into the system instruction, assistant messages become the "model" role
+// and tool results are sent back as function responses in user turns
+// consecutive messages with the same role are merged into one content
+func messagesToGeminiInput(messages []domain.Message) ([]*genai.Part, []*genai.Content) {
+	messages = pairToolCalls(messages)
+	var system []*genai.Part
+	contents := make([]*genai.Content, 0, len(messages))
+
+	for _, msg := range messages {
+		var content *genai.Content
+		switch msg.Role {
+		case domain.RoleSystem, domain.RoleDeveloper:
+			if msg.Content.Text != "" {
+				system = append(system, genai.NewPartFromText(msg.Content.Text))
+			}
+			continue
+		case domain.RoleAssistant:
+			content = buildGeminiModelContent(msg)
+			if len(content.Parts) == 0 {
+				continue
+			}
+		case domain.RoleTool:
+			if msg.Content.ToolResult == nil {
+				continue
+			}
+			content = buildGeminiFunctionResponse(*msg.Content.ToolResult)
+		default:
+			content = buildGeminiUserContent(msg)
+			if len(content.Parts) == 0 {
+				continue
+			}
+		}
+
+		if n := len(contents); n > 0 && contents[n-1].Role == content.Role {
+			contents[n-1].Parts = append(contents[n-1].Parts, content.Parts...)
+			continue
+		}
+		contents = append(contents, content)
+	}
+
+	return system, contents
+}
@@ -0,0 +1,190 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"google.golang.org/genai"
+)
+
+// newGeminiFake serves the recorded response for generateContent and the recorded stream for streamGenerateContent
+func newGeminiFake(t *testing.T, response, stream string) (*recordedAPI, GeminiProvider) {
+	t.Helper()
+	api, url := newRecordedAPI(t, func(r *http.Request, _ map[string]any) string {
+		switch {
+		case strings.HasSuffix(r.URL.Path, ":streamGenerateContent"):
+			return stream
+		case strings.HasSuffix(r.URL.Path, ":generateContent"):
+			return response
+		}
+		return ""
+	})
+	provider, err := NewGeminiProvider(context.Background(), "test-key", genai.HTTPOptions{BaseURL: url})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api, provider
+}
+
+var geminiTestConfig = domain.AIConfig{Model: domain.Model{Name: "gemini-2.5-flash"}}
+
+var weatherTool = Tool{
+	Name:        "get_weather",
+	Description: "current weather for a city",
+	Parameters: map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"city": map[string]any{"type": "string"}},
+	},
+}
+
+func userMessage(text string) domain.Message {
+	return domain.Message{Role: domain.RoleUser, Content: domain.MessageContent{Text: text}}
+}
+
+func TestGeminiParallelCallsGetDistinctIDs(t *testing.T) {
+	_, provider := newGeminiFake(t, "gemini_parallel_calls.json", "gemini_parallel_calls.sse")
+
+	resp, err := provider.SendMessage(context.Background(), []domain.Message{userMessage("weather in oslo and bergen?")}, geminiTestConfig, []Tool{weatherTool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := resp.Message.Content.ToolCalls
+	if len(calls) != 2 {
+		t.Fatalf("got %d calls, want 2", len(calls))
+	}
+	if calls[0].ID == calls[1].ID {
+		t.Errorf("parallel calls share the id %q", calls[0].ID)
+	}
+	if calls[0].ID != "get_weather#0" || calls[1].ID != "get_weather#1" {
+		t.Errorf("got ids %q and %q", calls[0].ID, calls[1].ID)
+	}
+	if calls[1].Arguments != `{"city":"Bergen"}` {
+		t.Errorf("got arguments %s", calls[1].Arguments)
+	}
+	if resp.FinishReason != FinishToolCalls {
+		t.Errorf("got finish reason %q, want %q", resp.FinishReason, FinishToolCalls)
+	}
+	if resp.Usage.TotalTokens != 59 {
+		t.Errorf("got %d total tokens, want 59", resp.Usage.TotalTokens)
+	}
+}
+
+func TestGeminiStreamedCallsGetDistinctIDs(t *testing.T) {
+	_, provider := newGeminiFake(t, "gemini_parallel_calls.json", "gemini_parallel_calls.sse")
+
+	var started []string
+	var text strings.Builder
+	handler := func(e StreamEvent) error {
+		switch e.Type {
+		case StreamEventTextDelta:
+			text.WriteString(e.Text)
+		case StreamEventToolCall:
+			if e.ToolCall.Status == ToolCallStarted {
+				started = append(started, e.ToolCall.ID)
+			}
+		}
+		return nil
+	}
+	resp, err := provider.StreamMessage(context.Background(), []domain.Message{userMessage("weather in oslo and bergen?")}, geminiTestConfig, []Tool{weatherTool}, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the calls come in separate chunks, the index keeps counting across them
+	if len(started) != 2 || started[0] != "get_weather#0" || started[1] != "get_weather#1" {
+		t.Errorf("got started calls %q", started)
+	}
+	calls := resp.Message.Content.ToolCalls
+	if len(calls) != 2 || calls[0].ID != started[0] || calls[1].ID != started[1] {
+		t.Errorf("got calls %+v", calls)
+	}
+	if text.String() != "Checking both." || resp.Message.Content.Text != "Checking both." {
+		t.Errorf("got text %q, message %q", text.String(), resp.Message.Content.Text)
+	}
+	if resp.FinishReason != FinishToolCalls {
+		t.Errorf("got finish reason %q, want %q", resp.FinishReason, FinishToolCalls)
+	}
+}
+
+func TestGeminiCallIDsRoundTrip(t *testing.T) {
+	fake, provider := newGeminiFake(t, "gemini_parallel_calls.json", "gemini_parallel_calls.sse")
+	ctx := context.Background()
+
+	history := []domain.Message{userMessage("weather in oslo and bergen?")}
+	resp, err := provider.SendMessage(ctx, history, geminiTestConfig, []Tool{weatherTool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	history = append(history, resp.Message)
+	for i, call := range resp.Message.Content.ToolCalls {
+		history = append(history, domain.Message{Role: domain.RoleTool, Content: domain.MessageContent{
+			ToolResult: &domain.ToolResult{CallID: call.ID, Name: call.Name, Content: []string{"sunny", "rainy"}[i]},
+		}})
+	}
+	if _, err := provider.SendMessage(ctx, history, geminiTestConfig, []Tool{weatherTool}); err != nil {
+		t.Fatal(err)
+	}
+
+	// both calls and both results survive pairing, the made up ids don't go back to gemini
+	var contents struct {
+		Contents []struct {
+			Role  string `json:"role"`
+			Parts []struct {
+				FunctionCall *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"functionCall"`
+				FunctionResponse *struct {
+					ID       string         `json:"id"`
+					Name     string         `json:"name"`
+					Response map[string]any `json:"response"`
+				} `json:"functionResponse"`
+			} `json:"parts"`
+		} `json:"contents"`
+	}
+	fake.decodeRequest(t, &contents)
+	if len(contents.Contents) != 3 {
+		t.Fatalf("got %d contents, want user, model and the function responses", len(contents.Contents))
+	}
+
+	model, responses := contents.Contents[1], contents.Contents[2]
+	if model.Role != "model" || len(model.Parts) != 2 {
+		t.Fatalf("got model content %+v", model)
+	}
+	for _, part := range model.Parts {
+		if part.FunctionCall == nil || part.FunctionCall.Name != "get_weather" || part.FunctionCall.ID != "" {
+			t.Errorf("got function call %+v", part.FunctionCall)
+		}
+	}
+	if responses.Role != "user" || len(responses.Parts) != 2 {
+		t.Fatalf("got response content %+v", responses)
+	}
+	for i, want := range []string{"sunny", "rainy"} {
+		r := responses.Parts[i].FunctionResponse
+		if r == nil || r.ID != "" || r.Response["output"] != want {
+			t.Errorf("got function response %d %+v, want output %q", i, r, want)
+		}
+	}
+}
+
+func TestGeminiCallID(t *testing.T) {
+	tests := []struct {
+		id, name, want string
+	}{
+		{"get_weather#0", "get_weather", ""},
+		{"get_weather#12", "get_weather", ""},
+		{"call_abc", "get_weather", "call_abc"},
+		{"get_weather#x", "get_weather", "get_weather#x"},
+		{"other#0", "get_weather", "other#0"},
+		{"get_weather", "get_weather", "get_weather"}, // only the indexed form is ours
+	}
+	for _, tt := range tests {
+		if got := geminiCallID(tt.id, tt.name); got != tt.want {
+			t.Errorf("geminiCallID(%q, %q) = %q, want %q", tt.id, tt.name, got, tt.want)
+		}
+	}
+}
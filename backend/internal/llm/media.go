@@ -0,0 +1,145 @@
+package llm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/curator4/io/backend/internal/domain"
+)
+
+const (
+	// maxTextAttachment is the most of a text attachment inlined for providers that can't fetch it themselves
+	maxTextAttachment = 1 << 20
+	// attachmentTimeout bounds fetching an attachment
+	attachmentTimeout = 30 * time.Second
+)
+
+// textTypes are the text files people attach most, go only knows them from the system's mime.types,
+// which slim images like alpine don't have
+var textTypes = map[string]string{
+	".txt": "text/plain",
+	".log": "text/plain",
+	".md":  "text/markdown",
+	".csv": "text/csv",
+	".tsv": "text/tab-separated-values",
+}
+
+// attachmentClient fetches attachments, tests swap it for their tls server's client
+var attachmentClient = http.DefaultClient
+
+// mediaMIMEType guesses a media item's mime type from its data url, file name or url, falling back on its media type
+func mediaMIMEType(media domain.MediaItem) string {
+	if mimeType, _, ok, err := parseDataURL(media.URL); ok && err == nil {
+		return mimeType
+	}
+	name := media.FileName
+	if name == "" {
+		if u, err := url.Parse(media.URL); err == nil {
+			name = u.Path
+		}
+	}
+	ext := strings.ToLower(path.Ext(name))
+	if t, ok := textTypes[ext]; ok {
+		return t
+	}
+	if t := mime.TypeByExtension(ext); t != "" {
+		mediaType, _, _ := strings.Cut(t, ";")
+		return mediaType
+	}
+
+	switch media.Type {
+	case "image":
+		return "image/jpeg"
+	case "video":
+		return "video/mp4"
+	case "audio":
+		return "audio/mpeg"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// mediaName names a media item for the model, by its file name or the end of its url
+func mediaName(media domain.MediaItem) string {
+	if media.FileName != "" {
+		return media.FileName
+	}
+	if u, err := url.Parse(media.URL); err == nil && u.Scheme != "data" && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+		return path.Base(u.Path)
+	}
+	return media.Type
+}
+
+// parseDataURL splits a base64 data url into its mime type and bytes, reporting false for urls of other schemes
+func parseDataURL(u string) (string, []byte, bool, error) {
+	rest, ok := strings.CutPrefix(u, "data:")
+	if !ok {
+		return "", nil, false, nil
+	}
+	meta, data, ok := strings.Cut(rest, ",")
+	if !ok || !strings.HasSuffix(meta, ";base64") {
+		return "", nil, true, fmt.Errorf("unsupported data url")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", nil, true, fmt.Errorf("invalid base64 in data url: %w", err)
+	}
+	return strings.TrimSuffix(meta, ";base64"), decoded, true, nil
+}
+
+// mediaText returns the contents of a text attachment, decoded from its data url or fetched over https
+func mediaText(ctx context.Context, media domain.MediaItem) (string, error) {
+	data, err := mediaBytes(ctx, media.URL)
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxTextAttachment {
+		return "", fmt.Errorf("larger than %d bytes", maxTextAttachment)
+	}
+	if !utf8.Valid(data) {
+		return "", fmt.Errorf("not utf-8 text")
+	}
+	return string(data), nil
+}
+
+// mediaBytes reads an attachment from its data url or over https
+// other schemes aren't fetched, the backend shouldn't reach into its own network for a user's url
+func mediaBytes(ctx context.Context, rawURL string) ([]byte, error) {
+	if _, data, ok, err := parseDataURL(rawURL); ok {
+		return data, err
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" {
+		return nil, fmt.Errorf("only https attachments are fetched")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, attachmentTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := attachmentClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch: %s", resp.Status)
+	}
+	// one byte past the limit tells a file that is too large from one right at it
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTextAttachment+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	return data, nil
+}
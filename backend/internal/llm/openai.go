@@ -4,6 +4,7 @@ package llm
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/curator4/io/backend/internal/domain"
@@ -19,47 +20,222 @@ type OpenAIProvider struct {
 	client *openai.Client
 }
 
-// supportedModels maps model names to OpenAI SDK constants
-var supportedModels = map[string]shared.ResponsesModel{
-	"gpt-5.1":    openai.ChatModelGPT5ChatLatest,
-	"gpt-5-nano": openai.ChatModelGPT5Nano,
-	"gpt-5-mini": openai.ChatModelGPT5Mini,
+// NewOpenAIProvider creates an OpenAIProvider authenticated with the given api key
+func NewOpenAIProvider(apikey string) OpenAIProvider {
+	return OpenAIProvider{client: NewOpenAIClient(apikey)}
 }
 
-func (p OpenAIProvider) SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig) (*domain.Message, error) {
+func (p OpenAIProvider) SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool) (*Response, error) {
+	return sendResponses(ctx, p.client, buildParams(messages, config, tools))
+}
+
+// StreamMessage uses the streaming responses api, forwarding text deltas and tool call progress to handler
+func (p OpenAIProvider) StreamMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool, handler StreamHandler) (*Response, error) {
+	return streamResponses(ctx, p.client, buildParams(messages, config, tools), handler)
+}
+
+// ValidateParams knows the responses api has no stop sequences, and reasoning models take no sampling params
+func (p OpenAIProvider) ValidateParams(model domain.Model, params domain.GenerationParams) error {
+	return responsesCapabilities(model).Validate(params)
+}
+
+// TokenEstimator estimates o200k, which averages about four characters per token of english
+// older models like gpt-4 and gpt-3.5 use cl100k, which splits a little finer
+func (p OpenAIProvider) TokenEstimator(model domain.Model) TokenEstimator {
+	return modelEstimator(model, 4)
+}
+
+// responsesCapabilities is what a model behind the responses api accepts
+func responsesCapabilities(model domain.Model) Capabilities {
+	capabilities := Capabilities{
+		MaxOutputTokens: model.MaxOutputTokens,
+		Reasoning:       model.Capabilities.Reasoning,
+	}
+	if !model.Capabilities.Reasoning {
+		capabilities.MaxTemperature, capabilities.TopP = 2, true
+	}
+	return capabilities
+}
 
-	model, ok := supportedModels[config.Model.Name]
-	if !ok {
-		return nil, fmt.Errorf("unknown or unsupported model: %s", config.Model.Name)
+// sendResponses makes a responses api call, shared by OpenAIProvider and responses-mode compatible providers
+func sendResponses(ctx context.Context, client *openai.Client, params responses.ResponseNewParams) (*Response, error) {
+	resp, err := client.Responses.New(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("openai api error: %w", err)
 	}
 
+	return openAIResponse(resp), nil
+}
+
+// streamResponses is the streaming counterpart of sendResponses
+func streamResponses(ctx context.Context, client *openai.Client, params responses.ResponseNewParams, handler StreamHandler) (*Response, error) {
+	stream := client.Responses.NewStreaming(ctx, params)
+	defer stream.Close()
+
+	// text is accumulated from deltas in case the stream ends without a response.completed event
+	var text strings.Builder
+	var final *responses.Response
+
+	for stream.Next() {
+		event := stream.Current()
+
+		var out *StreamEvent
+		switch event.Type {
+		case "response.output_text.delta":
+			text.WriteString(event.Delta)
+			out = &StreamEvent{Type: StreamEventTextDelta, Text: event.Delta}
+		case "response.output_item.added":
+			if isToolCall(event.Item) {
+				out = toolCallEvent(event.Item, ToolCallStarted)
+			}
+		case "response.function_call_arguments.delta", "response.mcp_call_arguments.delta":
+			out = &StreamEvent{
+				Type: StreamEventToolCall,
+				ToolCall: ToolCallProgress{
+					ID:             event.ItemID,
+					Status:         ToolCallArguments,
+					ArgumentsDelta: event.Delta,
+				},
+			}
+		case "response.output_item.done":
+			if isToolCall(event.Item) {
+				out = toolCallEvent(event.Item, ToolCallCompleted)
+			}
+		case "response.completed", "response.incomplete":
+			// incomplete responses (e.g. max output tokens hit) still carry the partial output, same as SendMessage
+			final = &event.Response
+		case "response.failed":
+			return nil, fmt.Errorf("openai response failed: %s", event.Response.Error.Message)
+		case "error":
+			return nil, fmt.Errorf("openai stream error: %s", event.Message)
+		}
+
+		if out != nil {
+			if err := handler(*out); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, fmt.Errorf("openai api error: %w", err)
+	}
+
+	if final != nil {
+		return openAIResponse(final), nil
+	}
+	return &Response{
+		Message:      assistantMessage(text.String(), nil),
+		FinishReason: FinishStop,
+	}, nil
+}
+
+// buildParams builds the responses api request shared by SendMessage and StreamMessage
+// stop sequences have no place in the responses api, ValidateParams keeps them out
+func buildParams(messages []domain.Message, config domain.AIConfig, tools []Tool) responses.ResponseNewParams {
 	input := responses.ResponseNewParamsInputUnion{
 		OfInputItemList: messagesToOpenAIInput(messages),
 	}
 
 	params := responses.ResponseNewParams{
-		Model:           model,
-		Temperature:     openai.Float(0.7),
-		MaxOutputTokens: openai.Int(512),
-		Input:           input,
+		Model: modelID(config.Model),
+		Input: input,
+	}
+	if config.SystemPrompt != "" {
+		params.Instructions = openai.String(config.SystemPrompt)
 	}
 
-	resp, err := p.client.Responses.New(ctx, params)
-	if err != nil {
-		return nil, fmt.Errorf("openai api error: %w", err)
+	gen := config.Params
+	if gen.Temperature != nil {
+		params.Temperature = openai.Float(*gen.Temperature)
+	}
+	if gen.TopP != nil {
+		params.TopP = openai.Float(*gen.TopP)
+	}
+	if gen.MaxOutputTokens != nil {
+		params.MaxOutputTokens = openai.Int(int64(*gen.MaxOutputTokens))
+	}
+	if gen.ReasoningEffort != "" {
+		params.Reasoning = shared.ReasoningParam{Effort: shared.ReasoningEffort(gen.ReasoningEffort)}
+	}
+	if len(gen.ProviderOptions) > 0 {
+		params.SetExtraFields(gen.ProviderOptions)
 	}
 
-	// Parse the assistant's response
-	assistantMessage := &domain.Message{
+	if len(tools) > 0 {
+		params.Tools = openAITools(tools)
+	}
+	return params
+}
+
+// assistantMessage wraps the model's output text and requested tool calls in a new assistant message
+func assistantMessage(text string, calls []domain.ToolCall) domain.Message {
+	return domain.Message{
 		ID:   uuid.New(),
 		Role: domain.RoleAssistant,
 		Content: domain.MessageContent{
-			Text: resp.OutputText(),
+			Text:      text,
+			ToolCalls: calls,
 		},
 		CreatedAt: time.Now(),
 	}
+}
+
+// openAIResponse converts a responses api result to a provider Response
+func openAIResponse(resp *responses.Response) *Response {
+	var calls []domain.ToolCall
+	for _, item := range resp.Output {
+		if item.Type == "function_call" {
+			calls = append(calls, domain.ToolCall{ID: item.CallID, Name: item.Name, Arguments: item.Arguments})
+		}
+	}
+
+	return &Response{
+		Message: assistantMessage(resp.OutputText(), calls),
+		Usage: Usage{
+			InputTokens:  resp.Usage.InputTokens,
+			OutputTokens: resp.Usage.OutputTokens,
+			TotalTokens:  resp.Usage.TotalTokens,
+		},
+		FinishReason: openAIFinishReason(resp),
+	}
+}
+
+// openAIFinishReason derives a FinishReason from a response's status and output items
+func openAIFinishReason(resp *responses.Response) FinishReason {
+	if resp.Status == responses.ResponseStatusIncomplete {
+		if resp.IncompleteDetails.Reason == "content_filter" {
+			return FinishContentFilter
+		}
+		return FinishLength
+	}
+	for _, item := range resp.Output {
+		if item.Type == "function_call" {
+			return FinishToolCalls
+		}
+	}
+	return FinishStop
+}
 
-	return assistantMessage, nil
+// isToolCall reports whether an output item is a function or built-in tool call
+func isToolCall(item responses.ResponseOutputItemUnion) bool {
+	return strings.HasSuffix(item.Type, "_call")
+}
+
+// toolCallEvent builds a tool call progress event from an output item
+// built-in tools (web search etc.) have no name, so their item type is used instead
+func toolCallEvent(item responses.ResponseOutputItemUnion, status ToolCallStatus) *StreamEvent {
+	name := item.Name
+	if name == "" {
+		name = strings.TrimSuffix(item.Type, "_call")
+	}
+	return &StreamEvent{
+		Type: StreamEventToolCall,
+		ToolCall: ToolCallProgress{
+			ID:     item.ID,
+			Name:   name,
+			Status: status,
+		},
+	}
 }
 
 func NewOpenAIClient(apikey string) *openai.Client {
@@ -74,10 +250,7 @@ func buildInputMessage(msg domain.Message) responses.ResponseInputItemUnionParam
 	contentItems := make(responses.ResponseInputMessageContentListParam, 0)
 
 	// Add text content (with user prefix if multi-user)
-	text := msg.Content.Text
-	if msg.User != nil && text != "" {
-		text = fmt.Sprintf("%s: %s", msg.User.Name, text)
-	}
+	text := messageText(msg)
 	if text != "" {
 		contentItems = append(contentItems, responses.ResponseInputContentUnionParam{
 			OfInputText: &responses.ResponseInputTextParam{
@@ -117,43 +290,36 @@ func buildInputMessage(msg domain.Message) responses.ResponseInputItemUnionParam
 }
 
 // buildOutputMessage creates an output message (assistant) for OpenAI
+// it is sent as a plain assistant message, output message items would need the api's own msg_ ids
 func buildOutputMessage(msg domain.Message) responses.ResponseInputItemUnionParam {
-	outputContent := make([]responses.ResponseOutputMessageContentUnionParam, 0)
-
-	if msg.Content.Text != "" {
-		outputContent = append(outputContent, responses.ResponseOutputMessageContentUnionParam{
-			OfOutputText: &responses.ResponseOutputTextParam{
-				Text: msg.Content.Text,
-				Type: "output_text",
-			},
-		})
-	}
-
 	// Note: Assistant-generated media (images from DALL-E, etc.) come through tool calls,
 	// not as direct message content, so we don't include msg.Content.Media here
-
-	return responses.ResponseInputItemUnionParam{
-		OfOutputMessage: &responses.ResponseOutputMessageParam{
-			ID:      msg.ID.String(),
-			Content: outputContent,
-			Status:  "completed",
-			Role:    "assistant",
-			Type:    "message",
-		},
-	}
+	return responses.ResponseInputItemParamOfMessage(msg.Content.Text, responses.EasyInputMessageRoleAssistant)
 }
 
 // messagesToOpenAIInput takes a slice of messages as input, and converts them to a ResponseInputParam for OpenAI
 // the type definitions for all these are a real jungle, rely heavily on the go docs
 // ResponseInputParam is the input, it a slice of ResponseInputItemUnionParams
-// those itemunions can be many things, here just input and output messages, each have their seperate subtypes
+// those itemunions can be many things, here input and output messages plus function calls and their outputs
 func messagesToOpenAIInput(messages []domain.Message) responses.ResponseInputParam {
+	messages = pairToolCalls(messages)
 	items := make([]responses.ResponseInputItemUnionParam, 0, len(messages))
 
 	for _, msg := range messages {
-		if msg.Role == domain.RoleAssistant {
-			items = append(items, buildOutputMessage(msg))
-		} else {
+		switch msg.Role {
+		case domain.RoleAssistant:
+			if msg.Content.Text != "" {
+				items = append(items, buildOutputMessage(msg))
+			}
+			// the call's item id is left out, it would have to be sent along with the reasoning item that preceded it
+			for _, call := range msg.Content.ToolCalls {
+				items = append(items, responses.ResponseInputItemParamOfFunctionCall(call.Arguments, call.ID, call.Name))
+			}
+		case domain.RoleTool:
+			if result := msg.Content.ToolResult; result != nil {
+				items = append(items, responses.ResponseInputItemParamOfFunctionCallOutput(result.CallID, result.Content))
+			}
+		default:
 			items = append(items, buildInputMessage(msg))
 		}
 	}
@@ -0,0 +1,126 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/openai/openai-go/v3/responses"
+)
+
+func TestOpenAIResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		response   string
+		wantText   string
+		wantCalls  int
+		wantFinish FinishReason
+	}{
+		{
+			name: "text",
+			response: `{"status": "completed", "output": [{"type": "message", "role": "assistant",
+				"content": [{"type": "output_text", "text": "hello"}]}],
+				"usage": {"input_tokens": 12, "output_tokens": 3, "total_tokens": 15}}`,
+			wantText:   "hello",
+			wantFinish: FinishStop,
+		},
+		{
+			name: "tool calls",
+			response: `{"status": "completed", "output": [
+				{"type": "function_call", "call_id": "call_1", "name": "current_time", "arguments": "{}"},
+				{"type": "function_call", "call_id": "call_2", "name": "docs__search", "arguments": "{\"q\": \"io\"}"}],
+				"usage": {"input_tokens": 12, "output_tokens": 3, "total_tokens": 15}}`,
+			wantCalls:  2,
+			wantFinish: FinishToolCalls,
+		},
+		{
+			name: "output limit",
+			response: `{"status": "incomplete", "incomplete_details": {"reason": "max_output_tokens"}, "output": [
+				{"type": "message", "role": "assistant", "content": [{"type": "output_text", "text": "cut sh"}]}],
+				"usage": {"input_tokens": 12, "output_tokens": 3, "total_tokens": 15}}`,
+			wantText:   "cut sh",
+			wantFinish: FinishLength,
+		},
+		{
+			name: "content filter",
+			response: `{"status": "incomplete", "incomplete_details": {"reason": "content_filter"}, "output": [],
+				"usage": {"input_tokens": 12, "output_tokens": 0, "total_tokens": 12}}`,
+			wantFinish: FinishContentFilter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp responses.Response
+			if err := json.Unmarshal([]byte(tt.response), &resp); err != nil {
+				t.Fatal(err)
+			}
+			got := openAIResponse(&resp)
+			if got.Message.Content.Text != tt.wantText || len(got.Message.Content.ToolCalls) != tt.wantCalls {
+				t.Errorf("got message %+v", got.Message.Content)
+			}
+			if got.FinishReason != tt.wantFinish {
+				t.Errorf("got finish reason %q, want %q", got.FinishReason, tt.wantFinish)
+			}
+			if got.Usage.InputTokens != 12 || got.Usage.TotalTokens != got.Usage.InputTokens+got.Usage.OutputTokens {
+				t.Errorf("got usage %+v", got.Usage)
+			}
+		})
+	}
+}
+
+func TestOpenAIInputToolItems(t *testing.T) {
+	call := domain.ToolCall{ID: "call_1", Name: "current_time", Arguments: `{"timezone":"UTC"}`}
+	history := []domain.Message{
+		userMessage("what time is it?"),
+		{Role: domain.RoleAssistant, Content: domain.MessageContent{Text: "checking", ToolCalls: []domain.ToolCall{
+			call,
+			// never answered, e.g. the message failed between the call and its result
+			{ID: "call_2", Name: "current_time", Arguments: "{}"},
+		}}},
+		{Role: domain.RoleTool, Content: domain.MessageContent{ToolResult: &domain.ToolResult{CallID: "call_1", Name: "current_time", Content: "12:00"}}},
+		// its call was cut from the history
+		{Role: domain.RoleTool, Content: domain.MessageContent{ToolResult: &domain.ToolResult{CallID: "call_0", Name: "current_time", Content: "11:00"}}},
+	}
+
+	raw, err := json.Marshal(messagesToOpenAIInput(history))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var items []map[string]any
+	if err := json.Unmarshal(raw, &items); err != nil {
+		t.Fatal(err)
+	}
+
+	// unpaired calls and results are dropped, the api rejects either on its own
+	var kinds []string
+	for _, item := range items {
+		kind := item["role"]
+		if kind == nil {
+			kind = item["type"]
+		}
+		kinds = append(kinds, fmt.Sprint(kind))
+	}
+	if got := strings.Join(kinds, " "); got != "user assistant function_call function_call_output" {
+		t.Fatalf("got items %s", raw)
+	}
+	if fc := items[2]; fc["call_id"] != call.ID || fc["name"] != call.Name || fc["arguments"] != call.Arguments {
+		t.Errorf("got function call %v", fc)
+	}
+	if out := items[3]; out["call_id"] != call.ID || out["output"] != "12:00" {
+		t.Errorf("got function call output %v", out)
+	}
+}
+
+func TestOpenAIModelID(t *testing.T) {
+	// the request names the model as the provider knows it, which may differ from its name in io
+	config := domain.AIConfig{Model: domain.Model{Name: "gpt-5", ProviderModelID: "gpt-5-2025-08-07"}}
+	if got := buildParams(nil, config, nil).Model; got != "gpt-5-2025-08-07" {
+		t.Errorf("got model %q", got)
+	}
+	config.Model.ProviderModelID = ""
+	if got := buildParams(nil, config, nil).Model; got != "gpt-5" {
+		t.Errorf("got model %q, want the name without a provider model id", got)
+	}
+}
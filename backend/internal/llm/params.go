@@ -0,0 +1,82 @@
+package llm
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/domain"
+)
+
+// reasoningEfforts are the accepted values of domain.GenerationParams.ReasoningEffort, lowest first
+var reasoningEfforts = []string{"minimal", "low", "medium", "high"}
+
+// Capabilities is what a model accepts in the way of generation params
+type Capabilities struct {
+	MaxTemperature  float64 // 0 if the model takes no temperature
+	TopP            bool
+	MaxOutputTokens int32 // Upper bound for max output tokens, 0 if unknown
+	Reasoning       bool  // Takes a reasoning effort
+	StopSequences   int   // How many stop sequences the model takes, -1 for no limit
+}
+
+// openCapabilities is assumed for models nothing is known about, like the ones behind compatible endpoints
+var openCapabilities = Capabilities{MaxTemperature: 2, TopP: true, Reasoning: true, StopSequences: -1}
+
+// Validate checks params against what a model accepts
+func (c Capabilities) Validate(params domain.GenerationParams) error {
+	if t := params.Temperature; t != nil {
+		if c.MaxTemperature == 0 {
+			return fmt.Errorf("model does not take a temperature")
+		}
+		if *t < 0 || *t > c.MaxTemperature {
+			return fmt.Errorf("temperature must be between 0 and %g", c.MaxTemperature)
+		}
+	}
+	if p := params.TopP; p != nil {
+		if !c.TopP {
+			return fmt.Errorf("model does not take top_p")
+		}
+		if *p <= 0 || *p > 1 {
+			return fmt.Errorf("top_p must be above 0 and at most 1")
+		}
+	}
+	if n := params.MaxOutputTokens; n != nil {
+		if *n <= 0 {
+			return fmt.Errorf("max_output_tokens must be positive")
+		}
+		if c.MaxOutputTokens > 0 && *n > c.MaxOutputTokens {
+			return fmt.Errorf("model outputs at most %d tokens", c.MaxOutputTokens)
+		}
+	}
+	if effort := params.ReasoningEffort; effort != "" {
+		if !slices.Contains(reasoningEfforts, effort) {
+			return fmt.Errorf("reasoning_effort must be one of %s", strings.Join(reasoningEfforts, ", "))
+		}
+		if !c.Reasoning {
+			return fmt.Errorf("model does not take a reasoning effort")
+		}
+	}
+	if n := len(params.StopSequences); n > 0 && c.StopSequences >= 0 && n > c.StopSequences {
+		if c.StopSequences == 0 {
+			return fmt.Errorf("model does not take stop sequences")
+		}
+		return fmt.Errorf("model takes at most %d stop sequences", c.StopSequences)
+	}
+	return nil
+}
+
+// thinkingBudget maps a reasoning effort to a token budget, for providers that budget thinking rather than take an effort
+// minimal is provider specific, so it is passed in
+func thinkingBudget(effort string, minimal int32) int32 {
+	switch effort {
+	case "minimal":
+		return minimal
+	case "low":
+		return 2048
+	case "medium":
+		return 8192
+	default:
+		return 24576
+	}
+}
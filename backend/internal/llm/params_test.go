@@ -0,0 +1,102 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestCapabilitiesValidate(t *testing.T) {
+	chat := Capabilities{MaxTemperature: 1, TopP: true, MaxOutputTokens: 4096, StopSequences: 2}
+
+	tests := []struct {
+		name         string
+		capabilities Capabilities
+		params       domain.GenerationParams
+		wantErr      string
+	}{
+		{"no params", Capabilities{}, domain.GenerationParams{}, ""},
+		{"everything in range", chat, domain.GenerationParams{Temperature: ptr(1.0), TopP: ptr(0.9), MaxOutputTokens: ptr(int32(4096)), StopSequences: []string{"END"}}, ""},
+		{"temperature too high", chat, domain.GenerationParams{Temperature: ptr(1.5)}, "between 0 and 1"},
+		{"negative temperature", chat, domain.GenerationParams{Temperature: ptr(-0.1)}, "between 0 and 1"},
+		{"no temperature taken", Capabilities{}, domain.GenerationParams{Temperature: ptr(0.5)}, "does not take a temperature"},
+		{"top_p of 0", chat, domain.GenerationParams{TopP: ptr(0.0)}, "above 0"},
+		{"no top_p taken", Capabilities{}, domain.GenerationParams{TopP: ptr(0.5)}, "does not take top_p"},
+		{"output limit above the model's", chat, domain.GenerationParams{MaxOutputTokens: ptr(int32(4097))}, "at most 4096"},
+		{"unknown output limit", Capabilities{}, domain.GenerationParams{MaxOutputTokens: ptr(int32(100000))}, ""},
+		{"zero output limit", chat, domain.GenerationParams{MaxOutputTokens: ptr(int32(0))}, "positive"},
+		{"unknown effort", openCapabilities, domain.GenerationParams{ReasoningEffort: "extreme"}, "one of minimal, low, medium, high"},
+		{"effort without reasoning", chat, domain.GenerationParams{ReasoningEffort: "low"}, "does not take a reasoning effort"},
+		{"too many stop sequences", chat, domain.GenerationParams{StopSequences: []string{"a", "b", "c"}}, "at most 2"},
+		{"no stop sequences taken", Capabilities{}, domain.GenerationParams{StopSequences: []string{"a"}}, "does not take stop sequences"},
+		{"unlimited stop sequences", openCapabilities, domain.GenerationParams{StopSequences: make([]string, 20)}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.capabilities.Validate(tt.params)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("got %v, want no error", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("got %v, want an error about %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProviderParamRules(t *testing.T) {
+	reasoning := domain.Model{Name: "thinker", MaxOutputTokens: 64000, Capabilities: domain.ModelCapabilities{Reasoning: true}}
+	plain := domain.Model{Name: "chatter", MaxOutputTokens: 8192}
+
+	tests := []struct {
+		name     string
+		provider Provider
+		model    domain.Model
+		params   domain.GenerationParams
+		wantErr  bool
+	}{
+		{"claude takes temperature up to 1", AnthropicProvider{}, plain, domain.GenerationParams{Temperature: ptr(1.2)}, true},
+		{"claude thinking takes no temperature", AnthropicProvider{}, reasoning, domain.GenerationParams{ReasoningEffort: "low", Temperature: ptr(0.5)}, true},
+		{"claude thinking needs a high top_p", AnthropicProvider{}, reasoning, domain.GenerationParams{ReasoningEffort: "low", TopP: ptr(0.9)}, true},
+		{"claude thinking needs room past the budget", AnthropicProvider{}, reasoning, domain.GenerationParams{ReasoningEffort: "medium", MaxOutputTokens: ptr(int32(8192))}, true},
+		{"claude thinking with room", AnthropicProvider{}, reasoning, domain.GenerationParams{ReasoningEffort: "medium", MaxOutputTokens: ptr(int32(16000))}, false},
+		{"openai takes temperature up to 2", OpenAIProvider{}, plain, domain.GenerationParams{Temperature: ptr(1.2)}, false},
+		{"openai reasoning models take no sampling params", OpenAIProvider{}, reasoning, domain.GenerationParams{Temperature: ptr(1.0)}, true},
+		{"openai takes no stop sequences", OpenAIProvider{}, plain, domain.GenerationParams{StopSequences: []string{"END"}}, true},
+		{"gemini takes five stop sequences", GeminiProvider{}, plain, domain.GenerationParams{StopSequences: make([]string, 6)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.provider.ValidateParams(tt.model, tt.params); (err != nil) != tt.wantErr {
+				t.Errorf("got %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOpenAIParams(t *testing.T) {
+	config := domain.AIConfig{
+		Model: domain.Model{Name: "gpt-5", ProviderModelID: "gpt-5"},
+		Params: domain.GenerationParams{
+			TopP:            ptr(0.5),
+			MaxOutputTokens: ptr(int32(2000)),
+			ReasoningEffort: "high",
+			ProviderOptions: map[string]any{"store": false},
+		},
+	}
+	params := buildParams(nil, config, nil)
+
+	// unset params are left to the api's defaults rather than sent as zero
+	if params.Temperature.Valid() {
+		t.Errorf("got temperature %v, want none", params.Temperature)
+	}
+	if params.TopP.Value != 0.5 || params.MaxOutputTokens.Value != 2000 || params.Reasoning.Effort != "high" {
+		t.Errorf("got top_p %v, max output tokens %v, effort %q", params.TopP, params.MaxOutputTokens, params.Reasoning.Effort)
+	}
+	if store, ok := params.ExtraFields()["store"]; !ok || store != false {
+		t.Errorf("got extra fields %v", params.ExtraFields())
+	}
+}
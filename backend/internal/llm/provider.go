@@ -2,12 +2,96 @@ package llm
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/curator4/io/backend/internal/domain"
 )
 
 // Provider is the interface that all AI providers must implement
 type Provider interface {
-	// SendMessage sends a list of messages to the AI provider and returns the response
-	SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig) (string, error)
+	// SendMessage sends a list of messages to the AI provider and returns the assistant's reply
+	// tools are advertised to the model, which may stop to call them (see FinishToolCalls)
+	SendMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool) (*Response, error)
+	// StreamMessage is like SendMessage, but reports text deltas and tool call progress to handler as they arrive
+	StreamMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []Tool, handler StreamHandler) (*Response, error)
+	// ValidateParams checks that a model accepts the given generation params
+	ValidateParams(model domain.Model, params domain.GenerationParams) error
+	// TokenEstimator estimates tokens for a model, used to fit the history into its context window
+	TokenEstimator(model domain.Model) TokenEstimator
+}
+
+// FinishReason is why the model stopped generating, normalized across providers
+type FinishReason string
+
+const (
+	FinishStop          FinishReason = "stop"           // natural end of the reply
+	FinishLength        FinishReason = "length"         // output token limit hit, reply is truncated
+	FinishToolCalls     FinishReason = "tool_calls"     // model stopped to call tools
+	FinishContentFilter FinishReason = "content_filter" // reply was cut by the provider's safety filter
+)
+
+// Usage is the token accounting of a single generation
+type Usage struct {
+	InputTokens  int64
+	OutputTokens int64
+	TotalTokens  int64
+}
+
+// Response is the result of a generation
+type Response struct {
+	Message      domain.Message
+	Usage        Usage
+	FinishReason FinishReason
+}
+
+// modelID returns what the provider's api calls a model, models from before the registry only have a name
+func modelID(model domain.Model) string {
+	if model.ProviderModelID != "" {
+		return model.ProviderModelID
+	}
+	return model.Name
+}
+
+// messageText returns a message's text, prefixed with the author's name so the model can tell users apart
+func messageText(msg domain.Message) string {
+	text := msg.Content.Text
+	if msg.User != nil && text != "" {
+		text = fmt.Sprintf("%s: %s", msg.User.Name, text)
+	}
+	return text
+}
+
+// pairToolCalls drops tool calls without a result and results without a call
+// providers reject unpaired ones, which a tool loop that was cut short (e.g. by a restart) leaves behind
+func pairToolCalls(messages []domain.Message) []domain.Message {
+	calls := make(map[string]bool)
+	results := make(map[string]bool)
+	for _, msg := range messages {
+		for _, call := range msg.Content.ToolCalls {
+			calls[call.ID] = true
+		}
+		if msg.Content.ToolResult != nil {
+			results[msg.Content.ToolResult.CallID] = true
+		}
+	}
+
+	paired := make([]domain.Message, 0, len(messages))
+	for _, msg := range messages {
+		switch {
+		case msg.Content.ToolResult != nil:
+			if !calls[msg.Content.ToolResult.CallID] {
+				continue
+			}
+		case len(msg.Content.ToolCalls) > 0:
+			answered := make([]domain.ToolCall, 0, len(msg.Content.ToolCalls))
+			for _, call := range msg.Content.ToolCalls {
+				if results[call.ID] {
+					answered = append(answered, call)
+				}
+			}
+			msg.Content.ToolCalls = answered
+		}
+		paired = append(paired, msg)
+	}
+	return paired
 }
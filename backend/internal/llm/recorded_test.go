@@ -0,0 +1,77 @@
+package llm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordedAPI stands in for a provider's api, replaying recorded responses from testdata and keeping the requests
+type recordedAPI struct {
+	mu       sync.Mutex
+	requests []map[string]any
+}
+
+// newRecordedAPI starts the stand-in, route picks the testdata file that answers a request, "" is a 404
+// files ending in .sse are served as an event stream, anything else as json
+func newRecordedAPI(t *testing.T, route func(r *http.Request, body map[string]any) string) (*recordedAPI, string) {
+	t.Helper()
+	api := &recordedAPI{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		api.mu.Lock()
+		api.requests = append(api.requests, body)
+		api.mu.Unlock()
+
+		name := route(r, body)
+		if name == "" {
+			http.NotFound(w, r)
+			return
+		}
+		recorded, err := os.ReadFile("testdata/" + name)
+		if err != nil {
+			t.Errorf("read %s: %v", name, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if strings.HasSuffix(name, ".sse") {
+			w.Header().Set("Content-Type", "text/event-stream")
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.Write(recorded)
+	}))
+	t.Cleanup(srv.Close)
+	return api, srv.URL
+}
+
+// lastRequest returns the body of the most recent request
+func (a *recordedAPI) lastRequest(t *testing.T) map[string]any {
+	t.Helper()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.requests) == 0 {
+		t.Fatal("no request reached the api")
+	}
+	return a.requests[len(a.requests)-1]
+}
+
+// decodeRequest decodes the most recent request body into v
+func (a *recordedAPI) decodeRequest(t *testing.T, v any) {
+	t.Helper()
+	raw, err := json.Marshal(a.lastRequest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,35 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.Get("openai"); err == nil {
+		t.Error("got a provider from an empty registry")
+	}
+
+	first, second := OpenAIProvider{}, GeminiProvider{}
+	r.Register("openai", first)
+	r.Register("gemini", second)
+	if p, err := r.Get("openai"); err != nil || p != Provider(first) {
+		t.Errorf("got %v, %v", p, err)
+	}
+	if got := strings.Join(r.Names(), " "); got != "gemini openai" {
+		t.Errorf("got names %q", got)
+	}
+
+	// registering a name again replaces its provider
+	r.Register("openai", second)
+	if p, _ := r.Get("openai"); p != Provider(second) {
+		t.Errorf("got %v after replacing", p)
+	}
+
+	r.Unregister("openai")
+	if _, err := r.Get("openai"); err == nil || !strings.Contains(err.Error(), `"openai"`) {
+		t.Errorf("got %v after unregistering", err)
+	}
+	r.Unregister("openai")
+}
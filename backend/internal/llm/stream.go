@@ -0,0 +1,42 @@
+package llm
+
+import "github.com/curator4/io/backend/internal/domain"
+
+// StreamEventType is the kind of progress reported while a reply is streamed
+type StreamEventType string
+
+const (
+	StreamEventTextDelta StreamEventType = "text_delta"
+	StreamEventToolCall  StreamEventType = "tool_call"
+
+	// StreamEventToolApproval is emitted by the tool loop rather than a provider, when a call waits for a user's consent
+	StreamEventToolApproval StreamEventType = "tool_approval"
+)
+
+// ToolCallStatus is the stage a streamed tool call is in
+type ToolCallStatus string
+
+const (
+	ToolCallStarted   ToolCallStatus = "started"
+	ToolCallArguments ToolCallStatus = "arguments"
+	ToolCallCompleted ToolCallStatus = "completed"
+)
+
+// ToolCallProgress describes a tool call the model is making while streaming
+type ToolCallProgress struct {
+	ID             string // provider item id, stable across events of the same call
+	Name           string // function name, or the built-in tool type
+	Status         ToolCallStatus
+	ArgumentsDelta string // partial json arguments, only set for ToolCallArguments
+}
+
+// StreamEvent is a single piece of progress from a streaming provider
+type StreamEvent struct {
+	Type     StreamEventType
+	Text     string              // set for StreamEventTextDelta
+	ToolCall ToolCallProgress    // set for StreamEventToolCall
+	Approval domain.ToolApproval // set for StreamEventToolApproval
+}
+
+// StreamHandler receives stream events as they arrive, returning an error aborts the stream
+type StreamHandler func(StreamEvent) error
@@ -0,0 +1,108 @@
+package llm
+
+import (
+	"encoding/json"
+	"math"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/curator4/io/backend/internal/domain"
+)
+
+const (
+	// messageOverheadTokens covers the role and framing tokens every message costs
+	messageOverheadTokens = 4
+	// mediaTokens is what an attachment roughly costs, images are around a thousand tokens on every provider
+	mediaTokens = 1024
+)
+
+// TokenEstimator guesses how many tokens a model sees in text, from the character ratios of its family rather than
+// its vocabulary. estimates can be off either way by some percent, callers budgeting a context window keep a margin
+type TokenEstimator interface {
+	Estimate(text string) int
+}
+
+// estimator approximates a bpe tokenizer without its vocabulary
+// ascii text averages charsPerToken, everything else (cjk, emoji, accents) costs about a token per rune
+// it is meant for budgeting the context, so it rounds up rather than down
+type estimator struct {
+	charsPerToken float64
+}
+
+// Count estimates the tokens in text
+func (e estimator) Estimate(text string) int {
+	if text == "" {
+		return 0
+	}
+	var ascii, other int
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return int(math.Ceil(float64(ascii)/e.charsPerToken)) + other
+}
+
+// families are the vocabularies of the model families we know, by the prefix of their model ids
+// ids are matched lowercased and without separators, so "Llama-3.1-8B" and "llama3.1:8b" are both llama3
+// longer prefixes come first, gpt4o is a different vocabulary than gpt4
+var families = []struct {
+	prefix        string
+	charsPerToken float64
+}{
+	{"gpt4o", 4}, {"gpt41", 4}, {"gpt5", 4}, {"chatgpt", 4}, {"o1", 4}, {"o3", 4}, {"o4", 4}, // o200k
+	{"gpt4", 3.7}, {"gpt35", 3.7}, // cl100k
+	{"claude", 3.5},
+	{"gemini", 4}, {"gemma", 4},
+	{"llama3", 3.8}, {"qwen", 3.8},
+	{"deepseek", 3.5},
+	{"llama2", 3.2}, {"mistral", 3.2}, {"mixtral", 3.2}, // 32k sentencepiece, small vocabularies split finer
+}
+
+// modelEstimator estimates the tokenizer of model's family, fallback is the ratio for models of no known family
+func modelEstimator(model domain.Model, fallback float64) TokenEstimator {
+	id := modelFamilyID(model.ProviderModelID)
+	for _, f := range families {
+		if strings.HasPrefix(id, f.prefix) {
+			return estimator{charsPerToken: f.charsPerToken}
+		}
+	}
+	return estimator{charsPerToken: fallback}
+}
+
+// modelFamilyID normalizes a model id for matching against families
+// servers prefix ids with an organization or path ("meta-llama/Llama-3-8B", "models/gemini-2.0-flash")
+func modelFamilyID(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(path.Base(id)))
+}
+
+// EstimateMessage estimates the tokens a message takes up in a model's context
+func EstimateMessage(t TokenEstimator, m domain.Message) int {
+	n := messageOverheadTokens + t.Estimate(m.Content.Text) + len(m.Content.Media)*mediaTokens
+	for _, call := range m.Content.ToolCalls {
+		n += t.Estimate(call.Name) + t.Estimate(call.Arguments)
+	}
+	if r := m.Content.ToolResult; r != nil {
+		n += t.Estimate(r.Content)
+	}
+	return n
+}
+
+// EstimateTools estimates the tokens the tool definitions take up, schemas are sent as json
+func EstimateTools(t TokenEstimator, tools []Tool) int {
+	var n int
+	for _, tool := range tools {
+		schema, _ := json.Marshal(tool.Parameters)
+		n += messageOverheadTokens + t.Estimate(tool.Name) + t.Estimate(tool.Description) + t.Estimate(string(schema))
+	}
+	return n
+}
@@ -0,0 +1,68 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+)
+
+func TestModelEstimator(t *testing.T) {
+	tests := []struct {
+		id   string
+		want float64
+	}{
+		{"gpt-4o-mini", 4},
+		{"gpt-4.1", 4},
+		{"o3-mini", 4},
+		{"gpt-4-turbo", 3.7},
+		{"gpt-3.5-turbo", 3.7},
+		{"claude-sonnet-4-5", 3.5},
+		{"models/gemini-2.0-flash", 4},
+		{"meta-llama/Llama-3.1-8B-Instruct", 3.8},
+		{"llama3.1:8b", 3.8},
+		{"Qwen/Qwen2.5-7B-Instruct", 3.8},
+		{"mistralai/Mixtral-8x7B-Instruct-v0.1", 3.2},
+		{"llama2:13b", 3.2},
+		{"phi-3", 3.5}, // no known family, the fallback
+		{"", 3.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got := modelEstimator(domain.Model{ProviderModelID: tt.id}, 3.5)
+			if e, ok := got.(estimator); !ok || e.charsPerToken != tt.want {
+				t.Errorf("got %+v, want %v characters per token", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProvidersKeyOnModel(t *testing.T) {
+	text := strings.Repeat("a", 320)
+	// a compatible server may run models of very different vocabularies
+	var p OpenAICompatibleProvider
+	llama2 := p.TokenEstimator(domain.Model{ProviderModelID: "llama2:7b"}).Estimate(text)
+	gpt4o := p.TokenEstimator(domain.Model{ProviderModelID: "gpt-4o"}).Estimate(text)
+	if llama2 != 100 || gpt4o != 80 {
+		t.Errorf("got %d tokens for llama2 and %d for gpt-4o, want 100 and 80", llama2, gpt4o)
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	e := estimator{charsPerToken: 4}
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"abcd", 1},
+		{"abcde", 2},     // rounds up
+		{"日本語", 3},       // a token per rune outside ascii
+		{"hi 日本", 1 + 2}, // "hi " and two runes
+	}
+	for _, tt := range tests {
+		if got := e.Estimate(tt.text); got != tt.want {
+			t.Errorf("%q: got %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
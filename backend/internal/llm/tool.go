@@ -0,0 +1,108 @@
+package llm
+
+import (
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/responses"
+	"github.com/openai/openai-go/v3/shared"
+	"google.golang.org/genai"
+)
+
+// Tool is a function the model may call, e.g. one discovered on an MCP server
+// providers advertise every tool they're given, deciding which ones to pass is up to the caller
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  map[string]any // json schema of the arguments object
+}
+
+// openAITools converts tools to responses api function tools
+// strict mode is off, since arbitrary mcp schemas rarely satisfy its restrictions
+func openAITools(tools []Tool) []responses.ToolUnionParam {
+	params := make([]responses.ToolUnionParam, len(tools))
+	for i, tool := range tools {
+		fn := &responses.FunctionToolParam{
+			Name:       tool.Name,
+			Parameters: tool.Parameters,
+			Strict:     openai.Bool(false),
+		}
+		if tool.Description != "" {
+			fn.Description = openai.String(tool.Description)
+		}
+		params[i] = responses.ToolUnionParam{OfFunction: fn}
+	}
+	return params
+}
+
+// chatTools converts tools to chat completions function tools
+func chatTools(tools []Tool) []openai.ChatCompletionToolUnionParam {
+	params := make([]openai.ChatCompletionToolUnionParam, len(tools))
+	for i, tool := range tools {
+		fn := shared.FunctionDefinitionParam{
+			Name:       tool.Name,
+			Parameters: tool.Parameters,
+		}
+		if tool.Description != "" {
+			fn.Description = openai.String(tool.Description)
+		}
+		params[i] = openai.ChatCompletionFunctionTool(fn)
+	}
+	return params
+}
+
+// claudeTools converts tools to anthropic custom tools
+// the schema's properties and required list have their own fields, everything else is passed through as is
+func claudeTools(tools []Tool) []anthropic.ToolUnionParam {
+	params := make([]anthropic.ToolUnionParam, len(tools))
+	for i, tool := range tools {
+		schema := anthropic.ToolInputSchemaParam{ExtraFields: make(map[string]any)}
+		for key, value := range tool.Parameters {
+			switch key {
+			case "type":
+			case "properties":
+				schema.Properties = value
+			case "required":
+				schema.Required = schemaStrings(value)
+			default:
+				schema.ExtraFields[key] = value
+			}
+		}
+
+		param := &anthropic.ToolParam{Name: tool.Name, InputSchema: schema}
+		if tool.Description != "" {
+			param.Description = anthropic.String(tool.Description)
+		}
+		params[i] = anthropic.ToolUnionParam{OfTool: param}
+	}
+	return params
+}
+
+// geminiTools converts tools to a single gemini tool holding one function declaration per tool
+func geminiTools(tools []Tool) []*genai.Tool {
+	decls := make([]*genai.FunctionDeclaration, len(tools))
+	for i, tool := range tools {
+		decls[i] = &genai.FunctionDeclaration{
+			Name:                 tool.Name,
+			Description:          tool.Description,
+			ParametersJsonSchema: tool.Parameters,
+		}
+	}
+	return []*genai.Tool{{FunctionDeclarations: decls}}
+}
+
+// schemaStrings reads a json schema string list, which decodes as []any
+func schemaStrings(value any) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []any:
+		strs := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// mcphost connects the backend to MCP servers as a host, keeping a session per configured server
+// and exposing the servers' tools to the llm layer
+package mcphost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/curator4/io/backend/internal/domain"
+)
+
+// clientName and clientVersion identify the backend in the initialize handshake
+const (
+	clientName    = "io"
+	clientVersion = "0.1.0"
+)
+
+var (
+	ErrUnknownServer = errors.New("unknown mcp server")
+	ErrUnknownTool   = errors.New("unknown tool")
+)
+
+// Host manages the sessions to all connected MCP servers
+type Host struct {
+	mu       sync.RWMutex
+	sessions map[string]*session
+}
+
+// ServerStatus is a snapshot of a server's session
+type ServerStatus struct {
+	Name      string
+	Transport string
+	Connected bool
+	SessionID string // Assigned by http servers, empty for stdio
+	Tools     int
+	Resources int
+	Prompts   int
+	LastError string // Why the server last failed to connect or disconnected, empty while connected
+}
+
+func NewHost() *Host {
+	return &Host{sessions: make(map[string]*session)}
+}
+
+// Connect starts a session to server, replacing any existing session with the same name
+// the session is kept alive in the background, so a server that fails to connect now is retried later
+// the error of the first connection attempt is returned
+func (h *Host) Connect(ctx context.Context, server domain.MCPServer) error {
+	h.mu.Lock()
+	old := h.sessions[server.Name]
+	sess := newSession(server)
+	h.sessions[server.Name] = sess
+	h.mu.Unlock()
+
+	if old != nil {
+		old.close()
+	}
+	if err := sess.start(ctx); err != nil {
+		return fmt.Errorf("mcp server %s: %w", server.Name, err)
+	}
+	return nil
+}
+
+// Disconnect closes the session to the named server
+func (h *Host) Disconnect(name string) error {
+	h.mu.Lock()
+	sess, ok := h.sessions[name]
+	delete(h.sessions, name)
+	h.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownServer, name)
+	}
+	sess.close()
+	return nil
+}
+
+// Close disconnects from every server
+func (h *Host) Close() {
+	h.mu.Lock()
+	sessions := h.sessions
+	h.sessions = make(map[string]*session)
+	h.mu.Unlock()
+
+	for _, sess := range sessions {
+		sess.close()
+	}
+}
+
+// Status returns the state of every session, ordered by server name
+func (h *Host) Status() []ServerStatus {
+	statuses := make([]ServerStatus, 0)
+	for _, sess := range h.sorted() {
+		statuses = append(statuses, sess.status())
+	}
+	return statuses
+}
+
+// ServerStatus returns the state of the named server's session, reporting false if there is none
+func (h *Host) ServerStatus(name string) (ServerStatus, bool) {
+	h.mu.RLock()
+	sess, ok := h.sessions[name]
+	h.mu.RUnlock()
+
+	if !ok {
+		return ServerStatus{}, false
+	}
+	return sess.status(), true
+}
+
+// sorted returns the sessions ordered by server name, so tools are always advertised in the same order
+func (h *Host) sorted() []*session {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	sessions := make([]*session, 0, len(h.sessions))
+	for _, sess := range h.sessions {
+		sessions = append(sessions, sess)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].server.Name < sessions[j].server.Name
+	})
+	return sessions
+}
@@ -0,0 +1,284 @@
+package mcphost
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// testToken is what the test servers expect in the authorization header
+const testToken = "s3cret"
+
+type echoArgs struct {
+	Text string `json:"text"`
+}
+
+// newEchoServer is an mcp server with a single echo tool
+func newEchoServer() *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "echo", Version: "1.0.0"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "echo", Description: "repeats text"},
+		func(_ context.Context, _ *mcp.CallToolRequest, args echoArgs) (*mcp.CallToolResult, any, error) {
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "echo: " + args.Text}}}, nil, nil
+		})
+	return server
+}
+
+// testServer serves an echo server over http, rejecting requests without the bearer token
+// restart swaps in a fresh server and cuts every connection, like a server process that was restarted
+type testServer struct {
+	*httptest.Server
+	transport string
+
+	mu         sync.Mutex
+	handler    http.Handler
+	authorized int
+	rejected   int
+	sessions   int // sessions the server handed out, across restarts
+}
+
+func newTestServer(t *testing.T, transport string) *testServer {
+	t.Helper()
+	ts := &testServer{transport: transport}
+	ts.handler = ts.newHandler()
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts.mu.Lock()
+		handler := ts.handler
+		ok := r.Header.Get("Authorization") == "Bearer "+testToken
+		if ok {
+			ts.authorized++
+		} else {
+			ts.rejected++
+		}
+		ts.mu.Unlock()
+
+		if !ok {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func (ts *testServer) newHandler() http.Handler {
+	server := newEchoServer()
+	// the handlers only ask for a server when a client starts a new session
+	getServer := func(*http.Request) *mcp.Server {
+		ts.mu.Lock()
+		ts.sessions++
+		ts.mu.Unlock()
+		return server
+	}
+	if ts.transport == "sse" {
+		return mcp.NewSSEHandler(getServer, nil)
+	}
+	return mcp.NewStreamableHTTPHandler(getServer, nil)
+}
+
+func (ts *testServer) restart() {
+	ts.mu.Lock()
+	ts.handler = ts.newHandler()
+	ts.mu.Unlock()
+	ts.CloseClientConnections()
+}
+
+func (ts *testServer) requests() (authorized, rejected int) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.authorized, ts.rejected
+}
+
+func (ts *testServer) sessionCount() int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.sessions
+}
+
+// connect starts a host with a session to ts, the authorization header references an env variable
+func connect(t *testing.T, ts *testServer) (*Host, error) {
+	t.Helper()
+	t.Setenv("IO_MCP_SECRET_TEST_TOKEN", testToken)
+	host := NewHost()
+	t.Cleanup(host.Close)
+	err := host.Connect(context.Background(), domain.MCPServer{
+		Name:      "demo",
+		Transport: ts.transport,
+		URL:       ts.URL,
+		Headers:   map[string]string{"Authorization": "Bearer ${IO_MCP_SECRET_TEST_TOKEN}"},
+		Enabled:   true,
+	})
+	return host, err
+}
+
+// assertEcho checks the echo tool is advertised and answers through the host
+func assertEcho(t *testing.T, host *Host) {
+	t.Helper()
+	tools := host.Tools([]string{"demo"})
+	if len(tools) != 1 || tools[0].Name != "demo__echo" {
+		t.Fatalf("got tools %+v", tools)
+	}
+	if tools[0].Parameters["type"] != "object" {
+		t.Errorf("got schema %v", tools[0].Parameters)
+	}
+	res, err := host.CallTool(context.Background(), "demo__echo", `{"text":"hi"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Content != "echo: hi" || res.IsError {
+		t.Errorf("got result %+v", res)
+	}
+}
+
+func TestStreamableHTTP(t *testing.T) {
+	ts := newTestServer(t, "streamable_http")
+	host, err := connect(t, ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEcho(t, host)
+
+	status, ok := host.ServerStatus("demo")
+	if !ok || !status.Connected || status.SessionID == "" || status.Tools != 1 {
+		t.Errorf("got status %+v", status)
+	}
+}
+
+func TestSSE(t *testing.T) {
+	ts := newTestServer(t, "sse")
+	host, err := connect(t, ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEcho(t, host)
+
+	if status, _ := host.ServerStatus("demo"); !status.Connected || status.Tools != 1 {
+		t.Errorf("got status %+v", status)
+	}
+}
+
+func TestHeaderInjection(t *testing.T) {
+	for _, transport := range []string{"streamable_http", "sse"} {
+		t.Run(transport, func(t *testing.T) {
+			ts := newTestServer(t, transport)
+			host, err := connect(t, ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEcho(t, host)
+
+			// every request carries the expanded header, the handshake and the tool call alike
+			authorized, rejected := ts.requests()
+			if authorized == 0 || rejected != 0 {
+				t.Errorf("got %d authorized and %d rejected requests", authorized, rejected)
+			}
+		})
+	}
+}
+
+func TestWrongHeaderFailsToConnect(t *testing.T) {
+	ts := newTestServer(t, "streamable_http")
+	t.Setenv("IO_MCP_SECRET_TEST_TOKEN", "wrong")
+	host := NewHost()
+	t.Cleanup(host.Close)
+
+	err := host.Connect(context.Background(), domain.MCPServer{
+		Name:      "demo",
+		Transport: "streamable_http",
+		URL:       ts.URL,
+		Headers:   map[string]string{"Authorization": "Bearer ${IO_MCP_SECRET_TEST_TOKEN}"},
+	})
+	if err == nil {
+		t.Fatal("connected without the right token")
+	}
+	if status, _ := host.ServerStatus("demo"); status.Connected || status.LastError == "" {
+		t.Errorf("got status %+v", status)
+	}
+	if _, err := host.CallTool(context.Background(), "demo__echo", `{}`); err == nil {
+		t.Error("called a tool of a server that isn't connected")
+	}
+}
+
+func TestHeadersOnlyReadSecrets(t *testing.T) {
+	ts := newTestServer(t, "streamable_http")
+	t.Setenv("DATABASE_URL", "postgres://io:hunter2@db/io")
+	host := NewHost()
+	t.Cleanup(host.Close)
+
+	err := host.Connect(context.Background(), domain.MCPServer{
+		Name:      "demo",
+		Transport: "streamable_http",
+		URL:       ts.URL,
+		Headers:   map[string]string{"Authorization": "Bearer ${DATABASE_URL}"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "DATABASE_URL") {
+		t.Fatalf("got %v, want the header rejected", err)
+	}
+	// the server never saw a request, let alone the variable
+	if authorized, rejected := ts.requests(); authorized+rejected != 0 {
+		t.Errorf("got %d requests", authorized+rejected)
+	}
+}
+
+func TestExpandHeaders(t *testing.T) {
+	t.Setenv("IO_MCP_SECRET_TOKEN", "s3cret")
+	t.Setenv("OPENAI_API_KEY", "sk-test")
+
+	tests := []struct {
+		value, want string
+		ok          bool
+	}{
+		{"Bearer ${IO_MCP_SECRET_TOKEN}", "Bearer s3cret", true},
+		{"$IO_MCP_SECRET_TOKEN", "s3cret", true},
+		{"${IO_MCP_SECRET_UNSET}", "", true},
+		{"plain", "plain", true},
+		{"Bearer ${OPENAI_API_KEY}", "", false},
+		{"${IO_MCP_SECRET_TOKEN}:$HOME", "", false},
+	}
+	for _, tt := range tests {
+		got, err := expandHeaders(map[string]string{"Authorization": tt.value})
+		if (err == nil) != tt.ok {
+			t.Errorf("%q: got error %v", tt.value, err)
+			continue
+		}
+		if tt.ok && got["Authorization"] != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.value, got["Authorization"], tt.want)
+		}
+	}
+}
+
+func TestReconnectAfterServerDrops(t *testing.T) {
+	for _, transport := range []string{"streamable_http", "sse"} {
+		t.Run(transport, func(t *testing.T) {
+			ts := newTestServer(t, transport)
+			host, err := connect(t, ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			before := ts.sessionCount()
+
+			// the restarted server has forgotten the session, the host has to start a new one
+			ts.restart()
+			deadline := time.Now().Add(10 * time.Second)
+			for {
+				status, _ := host.ServerStatus("demo")
+				if status.Connected && ts.sessionCount() > before {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("no new session after the server dropped, status %+v", status)
+				}
+				time.Sleep(50 * time.Millisecond)
+			}
+			assertEcho(t, host)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package mcphost
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// httpRetries is how often the streamable transport tries to resume a dropped stream before the session is
+// given up on and rebuilt from scratch by the session's own reconnect loop
+const httpRetries = 3
+
+// secretPrefix is what the environment variables header values reference have to start with,
+// so an mcp server row can't send the database url or a provider's key to its url
+const secretPrefix = "IO_MCP_SECRET_"
+
+// headerTransport adds a server's configured headers (usually auth) to every request
+type headerTransport struct {
+	headers map[string]string
+	base    http.RoundTripper
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// httpClient builds the client for an http server, expanding ${ENV} references in its header values
+// there is no overall timeout, streams stay open for the lifetime of the session
+func httpClient(headers map[string]string) (*http.Client, error) {
+	expanded, err := expandHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: headerTransport{headers: expanded, base: http.DefaultTransport}}, nil
+}
+
+// expandHeaders expands the environment variables referenced in header values, only IO_MCP_SECRET_ ones
+func expandHeaders(headers map[string]string) (map[string]string, error) {
+	expanded := make(map[string]string, len(headers))
+	for key, value := range headers {
+		var denied []string
+		expanded[key] = os.Expand(value, func(name string) string {
+			if !strings.HasPrefix(name, secretPrefix) {
+				denied = append(denied, name)
+				return ""
+			}
+			return os.Getenv(name)
+		})
+		if len(denied) > 0 {
+			return nil, fmt.Errorf("header %s references %s, only %s variables can be used", key, strings.Join(denied, ", "), secretPrefix)
+		}
+	}
+	return expanded, nil
+}
@@ -0,0 +1,310 @@
+package mcphost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	connectTimeout = 30 * time.Second // initialize handshake plus discovery
+	keepAlive      = 30 * time.Second // ping interval, a server that stops answering is reconnected
+	minBackoff     = time.Second
+	maxBackoff     = time.Minute
+)
+
+// session keeps the connection to a single mcp server alive, reconnecting with backoff when it drops
+// run owns the client session, everything else only reads the latest state under mu
+type session struct {
+	server domain.MCPServer
+
+	mu        sync.RWMutex
+	cs        *mcp.ClientSession
+	tools     []*mcp.Tool
+	resources []*mcp.Resource
+	prompts   []*mcp.Prompt
+	lastErr   error
+
+	// ctx lives until close, connections are made with it since http transports tie their streams to it
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newSession(server domain.MCPServer) *session {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &session{server: server, ctx: ctx, cancel: cancel, done: make(chan struct{})}
+}
+
+// start makes the first connection attempt and keeps the session alive in the background until close
+// the session keeps retrying even if the first attempt fails, its error is returned for logging
+func (s *session) start(ctx context.Context) error {
+	cs, err := s.connect(ctx)
+	go s.run(s.ctx, cs)
+	return err
+}
+
+// close stops the session and waits for the server connection to shut down
+func (s *session) close() {
+	s.cancel()
+	<-s.done
+}
+
+// run waits on the current connection and reconnects whenever it ends, until ctx is done
+func (s *session) run(ctx context.Context, cs *mcp.ClientSession) {
+	defer close(s.done)
+
+	backoff := minBackoff
+	for {
+		if cs != nil {
+			backoff = minBackoff
+			if !s.wait(ctx, cs) {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+
+		var err error
+		cs, err = s.connect(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("mcp server %s: reconnect failed: %v", s.server.Name, err)
+		}
+	}
+}
+
+// wait blocks until cs ends or ctx is done, reporting whether the session should reconnect
+func (s *session) wait(ctx context.Context, cs *mcp.ClientSession) bool {
+	ended := make(chan error, 1)
+	go func() { ended <- cs.Wait() }()
+
+	select {
+	case <-ctx.Done():
+		cs.Close()
+		<-ended
+		s.setConnected(nil, errors.New("disconnected"))
+		return false
+	case err := <-ended:
+		if err == nil {
+			err = errors.New("connection closed by server")
+		}
+		log.Printf("mcp server %s: session ended: %v", s.server.Name, err)
+		s.setConnected(nil, err)
+		return true
+	}
+}
+
+// connect launches the server, performs the initialize handshake and discovers its tools, resources and prompts
+func (s *session) connect(ctx context.Context) (*mcp.ClientSession, error) {
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+
+	transport, err := s.transport()
+	if err != nil {
+		s.setConnected(nil, err)
+		return nil, err
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName, Version: clientVersion}, &mcp.ClientOptions{
+		KeepAlive: keepAlive,
+		// list changes are refreshed off the notification goroutine, so the refresh can't block the connection
+		ToolListChangedHandler: func(_ context.Context, req *mcp.ToolListChangedRequest) {
+			go s.refresh(req.Session)
+		},
+		ResourceListChangedHandler: func(_ context.Context, req *mcp.ResourceListChangedRequest) {
+			go s.refresh(req.Session)
+		},
+		PromptListChangedHandler: func(_ context.Context, req *mcp.PromptListChangedRequest) {
+			go s.refresh(req.Session)
+		},
+	})
+
+	cs, err := client.Connect(ctx, lifetimeTransport{Transport: transport, ctx: s.ctx}, nil)
+	if err != nil {
+		err = fmt.Errorf("failed to initialize: %w", err)
+		s.setConnected(nil, err)
+		return nil, err
+	}
+
+	tools, resources, prompts, err := discover(ctx, cs)
+	if err != nil {
+		cs.Close()
+		s.setConnected(nil, err)
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cs, s.tools, s.resources, s.prompts, s.lastErr = cs, tools, resources, prompts, nil
+	s.mu.Unlock()
+	return cs, nil
+}
+
+// transport builds the client transport for the server's configured transport type
+func (s *session) transport() (mcp.Transport, error) {
+	switch s.server.Transport {
+	case "stdio", "":
+		if s.server.Command == "" {
+			return nil, errors.New("stdio server has no command")
+		}
+		// not CommandContext, the process has to outlive the connect context
+		cmd := exec.Command(s.server.Command, s.server.Args...)
+		cmd.Env = os.Environ()
+		for key, value := range s.server.Env {
+			cmd.Env = append(cmd.Env, key+"="+value)
+		}
+		cmd.Stderr = os.Stderr
+		return &mcp.CommandTransport{Command: cmd}, nil
+	case "streamable_http":
+		if s.server.URL == "" {
+			return nil, errors.New("http server has no url")
+		}
+		client, err := httpClient(s.server.Headers)
+		if err != nil {
+			return nil, err
+		}
+		// the transport tracks the Mcp-Session-Id and resumes dropped streams by itself
+		return &mcp.StreamableClientTransport{
+			Endpoint:   s.server.URL,
+			HTTPClient: client,
+			MaxRetries: httpRetries,
+		}, nil
+	case "sse":
+		if s.server.URL == "" {
+			return nil, errors.New("sse server has no url")
+		}
+		client, err := httpClient(s.server.Headers)
+		if err != nil {
+			return nil, err
+		}
+		return &mcp.SSEClientTransport{
+			Endpoint:   s.server.URL,
+			HTTPClient: client,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported transport: %s", s.server.Transport)
+	}
+}
+
+// lifetimeTransport connects with the session's context instead of the handshake's, which has a timeout
+type lifetimeTransport struct {
+	mcp.Transport
+	ctx context.Context
+}
+
+func (t lifetimeTransport) Connect(context.Context) (mcp.Connection, error) {
+	return t.Transport.Connect(t.ctx)
+}
+
+// refresh rediscovers the server's capabilities after a list changed notification
+func (s *session) refresh(cs *mcp.ClientSession) {
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+
+	tools, resources, prompts, err := discover(ctx, cs)
+	if err != nil {
+		log.Printf("mcp server %s: refresh failed: %v", s.server.Name, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cs == cs {
+		s.tools, s.resources, s.prompts = tools, resources, prompts
+	}
+}
+
+// setConnected records the current client session, clearing the discovered lists when disconnected
+func (s *session) setConnected(cs *mcp.ClientSession, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cs, s.lastErr = cs, err
+	if cs == nil {
+		s.tools, s.resources, s.prompts = nil, nil, nil
+	}
+}
+
+// client returns the live client session, or an error if the server is currently disconnected
+func (s *session) client() (*mcp.ClientSession, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.cs == nil {
+		if s.lastErr != nil {
+			return nil, fmt.Errorf("mcp server %s is not connected: %w", s.server.Name, s.lastErr)
+		}
+		return nil, fmt.Errorf("mcp server %s is not connected", s.server.Name)
+	}
+	return s.cs, nil
+}
+
+// discover lists everything the server advertises, skipping capabilities it didn't declare
+func discover(ctx context.Context, cs *mcp.ClientSession) ([]*mcp.Tool, []*mcp.Resource, []*mcp.Prompt, error) {
+	var tools []*mcp.Tool
+	var resources []*mcp.Resource
+	var prompts []*mcp.Prompt
+
+	caps := cs.InitializeResult().Capabilities
+	if caps == nil {
+		return nil, nil, nil, nil
+	}
+	if caps.Tools != nil {
+		for tool, err := range cs.Tools(ctx, nil) {
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to list tools: %w", err)
+			}
+			tools = append(tools, tool)
+		}
+	}
+	if caps.Resources != nil {
+		for resource, err := range cs.Resources(ctx, nil) {
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to list resources: %w", err)
+			}
+			resources = append(resources, resource)
+		}
+	}
+	if caps.Prompts != nil {
+		for prompt, err := range cs.Prompts(ctx, nil) {
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to list prompts: %w", err)
+			}
+			prompts = append(prompts, prompt)
+		}
+	}
+	return tools, resources, prompts, nil
+}
+
+// status takes a snapshot of the session
+func (s *session) status() ServerStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := ServerStatus{
+		Name:      s.server.Name,
+		Transport: s.server.Transport,
+		Connected: s.cs != nil,
+		Tools:     len(s.tools),
+		Resources: len(s.resources),
+		Prompts:   len(s.prompts),
+	}
+	if s.cs != nil {
+		status.SessionID = s.cs.ID()
+	}
+	if s.lastErr != nil {
+		status.LastError = s.lastErr.Error()
+	}
+	return status
+}
@@ -0,0 +1,205 @@
+package mcphost
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/llm"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// toolSeparator joins server and tool names, tools are namespaced since servers may share tool names
+	toolSeparator = "__"
+	// maxToolNameLength is the longest function name the provider apis accept
+	maxToolNameLength = 64
+	// toolHashLength is how many hex characters of a hash long tool names get
+	toolHashLength = 8
+)
+
+// ToolResult is the outcome of a tool call, flattened to text for the model
+type ToolResult struct {
+	Content string
+	IsError bool // The tool ran but reported failure, the model should see this rather than the call failing
+}
+
+// Tools returns the tools of the named servers that are connected, named "<server>__<tool>"
+func (h *Host) Tools(servers []string) []llm.Tool {
+	wanted := make(map[string]bool, len(servers))
+	for _, name := range servers {
+		wanted[name] = true
+	}
+
+	var tools []llm.Tool
+	for _, t := range h.registry() {
+		if !wanted[t.sess.server.Name] {
+			continue
+		}
+		tools = append(tools, llm.Tool{
+			Name:        t.name,
+			Description: t.tool.Description,
+			Parameters:  toolSchema(t.tool.InputSchema),
+		})
+	}
+	return tools
+}
+
+// CallTool calls a tool by the name it was advertised under, arguments is the model's json arguments object
+func (h *Host) CallTool(ctx context.Context, name string, arguments string) (ToolResult, error) {
+	sess, tool, ok := h.lookup(name)
+	if !ok {
+		return ToolResult{}, fmt.Errorf("%w: %s", ErrUnknownTool, name)
+	}
+
+	var args map[string]any
+	if strings.TrimSpace(arguments) != "" {
+		if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+			return ToolResult{}, fmt.Errorf("invalid arguments for %s: %w", name, err)
+		}
+	}
+
+	cs, err := sess.client()
+	if err != nil {
+		return ToolResult{}, err
+	}
+	res, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: tool, Arguments: args})
+	if err != nil {
+		return ToolResult{}, fmt.Errorf("mcp server %s: %s failed: %w", sess.server.Name, tool, err)
+	}
+
+	return ToolResult{Content: resultText(res), IsError: res.IsError}, nil
+}
+
+// lookup finds the session and original tool name behind an advertised tool name
+func (h *Host) lookup(name string) (*session, string, bool) {
+	for _, t := range h.registry() {
+		if t.name == name {
+			return t.sess, t.tool.Name, true
+		}
+	}
+	return nil, "", false
+}
+
+// registeredTool is a tool under the name it is advertised as
+type registeredTool struct {
+	name string
+	sess *session
+	tool *mcp.Tool
+}
+
+// registry lists the tools of every connected server under their advertised names
+// names are unique, a tool whose name is already taken (servers or tools differing only in characters
+// that get replaced) is rejected, the server that sorts first keeps the name
+func (h *Host) registry() []registeredTool {
+	var tools []registeredTool
+	taken := make(map[string]string)
+	for _, sess := range h.sorted() {
+		sess.mu.RLock()
+		for _, tool := range sess.tools {
+			name := toolName(sess.server.Name, tool.Name)
+			if owner, ok := taken[name]; ok {
+				log.Printf("mcp server %s: skipping tool %s, %s is already the name of a tool of %s", sess.server.Name, tool.Name, name, owner)
+				continue
+			}
+			taken[name] = sess.server.Name
+			tools = append(tools, registeredTool{name: name, sess: sess, tool: tool})
+		}
+		sess.mu.RUnlock()
+	}
+	return tools
+}
+
+// toolName builds the name a tool is advertised under
+// provider apis only accept [a-zA-Z0-9_-]{1,64}, anything else is replaced
+// names too long get the server part cut and a hash of the full names appended to it, so they stay distinct
+func toolName(server, tool string) string {
+	name := sanitizeName(server) + toolSeparator + sanitizeName(tool)
+	if len(name) <= maxToolNameLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(server + "\x00" + tool))
+	hash := "_" + hex.EncodeToString(sum[:])[:toolHashLength]
+	// the tool part keeps room for at least one character of the server and the hash
+	toolPart := sanitizeName(tool)
+	if room := maxToolNameLength - len(toolSeparator) - len(hash) - 1; len(toolPart) > room {
+		toolPart = toolPart[:room]
+	}
+	serverPart := sanitizeName(server)
+	serverPart = serverPart[:min(len(serverPart), maxToolNameLength-len(toolSeparator)-len(toolPart)-len(hash))]
+	return serverPart + hash + toolSeparator + toolPart
+}
+
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// toolSchema normalizes a tool's input schema to a json schema object, which is what every provider expects
+func toolSchema(schema any) map[string]any {
+	m, ok := schema.(map[string]any)
+	if !ok {
+		// re-decode whatever the server sent, falling back on an empty object schema
+		m = make(map[string]any)
+		if data, err := json.Marshal(schema); err == nil {
+			_ = json.Unmarshal(data, &m)
+		}
+	}
+	if m == nil {
+		m = make(map[string]any)
+	}
+	if _, ok := m["type"]; !ok {
+		m["type"] = "object"
+	}
+	if _, ok := m["properties"]; !ok {
+		m["properties"] = map[string]any{}
+	}
+	return m
+}
+
+// resultText flattens a tool result's content to text
+// media can't be passed back as a function output everywhere, so it is only described
+func resultText(res *mcp.CallToolResult) string {
+	parts := make([]string, 0, len(res.Content))
+	for _, content := range res.Content {
+		switch c := content.(type) {
+		case *mcp.TextContent:
+			parts = append(parts, c.Text)
+		case *mcp.ImageContent:
+			parts = append(parts, fmt.Sprintf("[image: %s]", c.MIMEType))
+		case *mcp.AudioContent:
+			parts = append(parts, fmt.Sprintf("[audio: %s]", c.MIMEType))
+		case *mcp.ResourceLink:
+			parts = append(parts, fmt.Sprintf("[resource: %s]", c.URI))
+		case *mcp.EmbeddedResource:
+			if c.Resource == nil {
+				continue
+			}
+			if c.Resource.Text != "" {
+				parts = append(parts, c.Resource.Text)
+			} else {
+				parts = append(parts, fmt.Sprintf("[resource: %s]", c.Resource.URI))
+			}
+		}
+	}
+
+	// structured output is only used if the server didn't also send it as content
+	if len(parts) == 0 && res.StructuredContent != nil {
+		if data, err := json.Marshal(res.StructuredContent); err == nil {
+			parts = append(parts, string(data))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
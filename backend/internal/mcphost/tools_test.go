@@ -0,0 +1,101 @@
+package mcphost
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// validToolName is what the provider apis accept as a function name
+var validToolName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+func TestToolName(t *testing.T) {
+	long := strings.Repeat("s", 70)
+	tests := []struct {
+		name, server, tool string
+		want               string // empty to only check the name is valid
+	}{
+		{"short", "github", "create_issue", "github__create_issue"},
+		{"replaced characters", "my.server", "get file", "my_server__get_file"},
+		{"exactly the limit", strings.Repeat("s", 40), strings.Repeat("t", 22), strings.Repeat("s", 40) + "__" + strings.Repeat("t", 22)},
+		{"long server", long, "search", ""},
+		{"long tool", "github", strings.Repeat("t", 70), ""},
+		{"both long", long, strings.Repeat("t", 70), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toolName(tt.server, tt.tool)
+			if !validToolName.MatchString(got) {
+				t.Errorf("%q isn't a valid tool name", got)
+			}
+			if !strings.Contains(got, toolSeparator) {
+				t.Errorf("%q lost the separator", got)
+			}
+			if tt.want != "" && got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToolNameKeepsLongNamesDistinct(t *testing.T) {
+	// these only differ past the limit, cutting them would name them the same
+	server := strings.Repeat("s", 60)
+	names := map[string]bool{}
+	for _, pair := range [][2]string{{server + "_a", "search"}, {server + "_b", "search"}, {server + "_a", "fetch"}} {
+		name := toolName(pair[0], pair[1])
+		if names[name] {
+			t.Errorf("%s %s is named %q like another tool", pair[0], pair[1], name)
+		}
+		names[name] = true
+		if !strings.HasSuffix(name, toolSeparator+pair[1]) {
+			t.Errorf("%q doesn't end in the tool's name", name)
+		}
+	}
+}
+
+// hostWithTools is a host with sessions that advertise tools, without any connection
+func hostWithTools(servers map[string][]string) *Host {
+	host := NewHost()
+	for server, tools := range servers {
+		sess := newSession(domain.MCPServer{Name: server})
+		for _, tool := range tools {
+			sess.tools = append(sess.tools, &mcp.Tool{Name: tool})
+		}
+		host.sessions[server] = sess
+	}
+	return host
+}
+
+func TestDuplicateToolNamesRejected(t *testing.T) {
+	host := hostWithTools(map[string][]string{
+		"my.server": {"search", "get.file", "get_file"},
+		"my_server": {"search", "other"},
+	})
+
+	tools := host.Tools([]string{"my.server", "my_server"})
+	var names []string
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	want := []string{"my_server__search", "my_server__get_file", "my_server__other"}
+	if strings.Join(names, " ") != strings.Join(want, " ") {
+		t.Fatalf("got tools %v, want %v", names, want)
+	}
+
+	// each name leads to the tool that kept it
+	for name, want := range map[string][2]string{
+		"my_server__search":   {"my.server", "search"},
+		"my_server__get_file": {"my.server", "get.file"},
+		"my_server__other":    {"my_server", "other"},
+	} {
+		sess, tool, ok := host.lookup(name)
+		if !ok || sess.server.Name != want[0] || tool != want[1] {
+			t.Errorf("%s leads to %v %s, want %v", name, ok, tool, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/service"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	transcriptPrefix   = "io://conversations/"
+	transcriptTemplate = transcriptPrefix + "{conversation_id}/transcript"
+)
+
+// readTranscript renders a conversation as plain text, one message per paragraph
+func (h *handlers) readTranscript(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	id, ok := strings.CutSuffix(strings.TrimPrefix(uri, transcriptPrefix), "/transcript")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	conv, messages, err := h.svc.LoadConversation(ctx, id, h.user(req.Extra))
+	if err != nil {
+		// conversations the user can't see are reported as missing, same as unknown ones
+		if errors.Is(err, service.ErrNotFound) || errors.Is(err, service.ErrPermissionDenied) || errors.Is(err, service.ErrInvalidArgument) {
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+		return nil, toolError(err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      uri,
+			MIMEType: "text/plain",
+			Text:     transcript(conv, messages),
+		}},
+	}, nil
+}
+
+// transcript renders a conversation's messages as text
+func transcript(conv domain.Conversation, messages []domain.Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", conv.Name)
+
+	for _, m := range messages {
+		speaker := author(m)
+		if speaker == "" {
+			speaker = string(m.Role)
+		}
+		fmt.Fprintf(&b, "\n[%s] %s:", m.CreatedAt.Format("2006-01-02 15:04"), speaker)
+
+		if m.Content.Text != "" {
+			fmt.Fprintf(&b, " %s", m.Content.Text)
+		}
+		for _, media := range m.Content.Media {
+			fmt.Fprintf(&b, " [%s: %s]", media.Type, media.URL)
+		}
+		for _, call := range m.Content.ToolCalls {
+			fmt.Fprintf(&b, " [calls %s(%s)]", call.Name, call.Arguments)
+		}
+		if r := m.Content.ToolResult; r != nil {
+			fmt.Fprintf(&b, " [%s returned: %s]", r.Name, r.Content)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,130 @@
+// mcpserver exposes io itself as an MCP server, so other agents can talk to io and read its conversations
+// it is a thin adapter over the service layer, like the grpc server in internal/server
+package mcpserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/curator4/io/backend/internal/service"
+
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// userKey is where the frontend user of a bearer token is kept in its auth.TokenInfo
+const userKey = "io_user_id"
+
+// New creates an MCP server that acts as the given frontend user (see service.SendMessageInput.UserID)
+func New(svc *service.Service, userID string) *mcp.Server {
+	return newServer(&handlers{svc: svc, userID: userID})
+}
+
+// NewHTTPHandler serves io over streamable http, tokens maps bearer tokens to the frontend user they act as
+// every request needs one of the tokens, so a session can't be carried on under another user's token
+func NewHTTPHandler(svc *service.Service, tokens map[string]string) http.Handler {
+	server := newServer(&handlers{svc: svc})
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return server }, nil)
+	return auth.RequireBearerToken(verifyToken(tokens), nil)(handler)
+}
+
+// ParseTokens reads comma separated token=user_id pairs, the format of IO_MCP_TOKENS
+func ParseTokens(s string) (map[string]string, error) {
+	tokens := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		token, userID, ok := strings.Cut(pair, "=")
+		token, userID = strings.TrimSpace(token), strings.TrimSpace(userID)
+		if !ok || token == "" || userID == "" {
+			return nil, fmt.Errorf("invalid token pair, want token=user_id")
+		}
+		if _, dup := tokens[token]; dup {
+			return nil, fmt.Errorf("token of %s is given twice", userID)
+		}
+		tokens[token] = userID
+	}
+	return tokens, nil
+}
+
+// verifyToken looks bearer tokens up in tokens, comparing against every one in constant time
+func verifyToken(tokens map[string]string) auth.TokenVerifier {
+	return func(_ context.Context, token string, _ *http.Request) (*auth.TokenInfo, error) {
+		var userID string
+		for known, user := range tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(known)) == 1 {
+				userID = user
+			}
+		}
+		if userID == "" {
+			return nil, auth.ErrInvalidToken
+		}
+		// the tokens don't expire, but the sdk wants an expiration, it is checked right away
+		return &auth.TokenInfo{Expiration: time.Now().Add(time.Minute), Extra: map[string]any{userKey: userID}}, nil
+	}
+}
+
+// newServer creates the MCP server with io's tools and resources
+func newServer(h *handlers) *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "io", Version: "0.1.0"}, nil)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "send_message",
+		Description: "Send a message to io and get its reply. Continues the most recent conversation unless conversation_id is given.",
+	}, h.sendMessage)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_conversations",
+		Description: "List your conversations with io, most recently used first.",
+	}, h.listConversations)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "load_conversation",
+		Description: "Load the messages of a conversation.",
+	}, h.loadConversation)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "transcript",
+		Description: "Plain text transcript of a conversation",
+		MIMEType:    "text/plain",
+		URITemplate: transcriptTemplate,
+	}, h.readTranscript)
+
+	return server
+}
+
+// handlers implements the tools and resources on behalf of a user
+type handlers struct {
+	svc    *service.Service
+	userID string // who stdio clients act as, http clients act as the user of their token
+}
+
+// user returns the frontend user a request acts as
+func (h *handlers) user(extra *mcp.RequestExtra) string {
+	if extra != nil && extra.TokenInfo != nil {
+		if userID, ok := extra.TokenInfo.Extra[userKey].(string); ok {
+			return userID
+		}
+	}
+	return h.userID
+}
+
+// toolError turns service errors into messages for the calling client, internal errors are logged and not spelled out
+func toolError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument), errors.Is(err, service.ErrNotFound),
+		errors.Is(err, service.ErrPermissionDenied), errors.Is(err, service.ErrUnavailable),
+		errors.Is(err, service.ErrFailedPrecondition), errors.Is(err, service.ErrAlreadyExists),
+		errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	default:
+		log.Printf("mcp server error: %v", err)
+		return errors.New("internal error")
+	}
+}
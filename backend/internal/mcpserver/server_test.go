@@ -0,0 +1,107 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/service"
+
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestParseTokens(t *testing.T) {
+	tokens, err := ParseTokens(" abc=user-1, def = user-2 ,,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 || tokens["abc"] != "user-1" || tokens["def"] != "user-2" {
+		t.Errorf("got %v", tokens)
+	}
+
+	for _, s := range []string{"abc", "=user-1", "abc=", "abc=user-1,abc=user-2"} {
+		if _, err := ParseTokens(s); err == nil {
+			t.Errorf("parsed %q", s)
+		}
+	}
+}
+
+// initialize is the first request of an mcp session
+const initialize = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`
+
+func TestHTTPHandlerNeedsToken(t *testing.T) {
+	srv := httptest.NewServer(NewHTTPHandler(nil, map[string]string{"s3cret": "user-1"}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          int
+	}{
+		{"no token", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer guess", http.StatusUnauthorized},
+		{"valid token", "Bearer s3cret", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(initialize))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", "application/json, text/event-stream")
+			// the old user header alone doesn't get anyone in
+			req.Header.Set("X-Io-User-Id", "user-2")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestsActAsTheirTokensUser(t *testing.T) {
+	verify := verifyToken(map[string]string{"a": "user-a", "b": "user-b"})
+	h := &handlers{userID: "stdio-user"}
+
+	for token, want := range map[string]string{"a": "user-a", "b": "user-b"} {
+		info, err := verify(context.Background(), token, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := h.user(&mcp.RequestExtra{TokenInfo: info}); got != want {
+			t.Errorf("token %s acts as %q, want %q", token, got, want)
+		}
+	}
+	if _, err := verify(context.Background(), "c", nil); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("got %v for an unknown token", err)
+	}
+	// stdio requests carry no token
+	if got := h.user(nil); got != "stdio-user" {
+		t.Errorf("stdio request acts as %q", got)
+	}
+}
+
+func TestToolError(t *testing.T) {
+	for _, sentinel := range []error{
+		service.ErrInvalidArgument, service.ErrNotFound, service.ErrPermissionDenied, service.ErrUnavailable,
+		service.ErrFailedPrecondition, service.ErrAlreadyExists, context.Canceled, context.DeadlineExceeded,
+	} {
+		err := fmt.Errorf("conversation %w", sentinel)
+		if got := toolError(err); got != err {
+			t.Errorf("%v came out as %v", err, got)
+		}
+	}
+	if got := toolError(errors.New("pq: connection refused")); got.Error() != "internal error" {
+		t.Errorf("internal error came out as %v", got)
+	}
+}
@@ -0,0 +1,124 @@
+package mcpserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/service"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type sendMessageInput struct {
+	Text           string `json:"text" jsonschema:"the message to send"`
+	ConversationID string `json:"conversation_id,omitempty" jsonschema:"conversation to continue, defaults to the most recent one"`
+}
+
+type sendMessageOutput struct {
+	ConversationID string `json:"conversation_id"`
+	Reply          string `json:"reply"`
+	FinishReason   string `json:"finish_reason"`
+}
+
+type listConversationsOutput struct {
+	Conversations []conversation `json:"conversations"`
+}
+
+type conversation struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+type loadConversationInput struct {
+	ConversationID string `json:"conversation_id" jsonschema:"id of the conversation, see list_conversations"`
+}
+
+type loadConversationOutput struct {
+	Conversation conversation `json:"conversation"`
+	Messages     []message    `json:"messages"`
+}
+
+type message struct {
+	ID        string                `json:"id"`
+	Role      string                `json:"role"`
+	Author    string                `json:"author,omitempty"`
+	Content   domain.MessageContent `json:"content"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+func (h *handlers) sendMessage(ctx context.Context, req *mcp.CallToolRequest, in sendMessageInput) (*mcp.CallToolResult, sendMessageOutput, error) {
+	result, err := h.svc.SendMessage(ctx, service.SendMessageInput{
+		UserID:         h.user(req.Extra),
+		ConversationID: in.ConversationID,
+		Frontend:       "mcp",
+		Content:        domain.MessageContent{Text: in.Text},
+	})
+	if err != nil {
+		return nil, sendMessageOutput{}, toolError(err)
+	}
+
+	return nil, sendMessageOutput{
+		ConversationID: result.ConversationID.String(),
+		Reply:          result.AssistantMessage.Content.Text,
+		FinishReason:   string(result.FinishReason),
+	}, nil
+}
+
+func (h *handlers) listConversations(ctx context.Context, req *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, listConversationsOutput, error) {
+	conversations, err := h.svc.ListConversations(ctx, h.user(req.Extra))
+	if err != nil {
+		return nil, listConversationsOutput{}, toolError(err)
+	}
+
+	out := listConversationsOutput{Conversations: make([]conversation, len(conversations))}
+	for i, c := range conversations {
+		out.Conversations[i] = conversationOutput(c)
+	}
+	return nil, out, nil
+}
+
+func (h *handlers) loadConversation(ctx context.Context, req *mcp.CallToolRequest, in loadConversationInput) (*mcp.CallToolResult, loadConversationOutput, error) {
+	conv, messages, err := h.svc.LoadConversation(ctx, in.ConversationID, h.user(req.Extra))
+	if err != nil {
+		return nil, loadConversationOutput{}, toolError(err)
+	}
+
+	out := loadConversationOutput{
+		Conversation: conversationOutput(conv),
+		Messages:     make([]message, len(messages)),
+	}
+	for i, m := range messages {
+		out.Messages[i] = message{
+			ID:        m.ID.String(),
+			Role:      string(m.Role),
+			Author:    author(m),
+			Content:   m.Content,
+			CreatedAt: m.CreatedAt,
+		}
+	}
+	return nil, out, nil
+}
+
+func conversationOutput(c domain.Conversation) conversation {
+	return conversation{
+		ID:         c.ID.String(),
+		Name:       c.Name,
+		CreatedAt:  c.CreatedAt,
+		LastUsedAt: c.LastUsedAt,
+	}
+}
+
+// author names who wrote a message, for transcripts
+func author(m domain.Message) string {
+	switch {
+	case m.User != nil && m.User.Name != "":
+		return m.User.Name
+	case m.Role == domain.RoleAssistant:
+		return "io"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,63 @@
+package pgvector
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []Vector{
+		{},
+		{1, 2.5, 3},
+		{-0.25, 0, 1e-7, 123456.78, math.MaxFloat32, -math.SmallestNonzeroFloat32},
+		{0.1, 0.2, 0.3},
+	}
+	for _, v := range tests {
+		value, err := v.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		text, ok := value.(string)
+		if !ok {
+			t.Fatalf("value of %v is a %T, want a string", v, value)
+		}
+
+		// postgres hands vectors back as bytes
+		var got Vector
+		if err := got.Scan([]byte(text)); err != nil {
+			t.Fatalf("scan %q: %v", text, err)
+		}
+		if !slices.Equal(got, v) {
+			t.Errorf("%v came back as %v through %q", v, got, text)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	value, _ := Vector{1, -2.5, 0.125}.Value()
+	if value != "[1,-2.5,0.125]" {
+		t.Errorf("got %v", value)
+	}
+	value, _ = Vector(nil).Value()
+	if value != "[]" {
+		t.Errorf("got %v for a nil vector", value)
+	}
+}
+
+func TestScan(t *testing.T) {
+	var v Vector
+	if err := v.Scan(" [1, 2.5 ,3] "); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(v, Vector{1, 2.5, 3}) {
+		t.Errorf("got %v", v)
+	}
+
+	for _, src := range []any{"1,2,3", "[1,2", "[1,,2]", "[a]", 42, nil} {
+		var v Vector
+		if err := v.Scan(src); err == nil {
+			t.Errorf("scanned %#v into %v", src, v)
+		}
+	}
+}
@@ -151,17 +151,149 @@ func (x *MediaItem) GetFileName() string {
 	return ""
 }
 
+// A tool invocation requested by the model
+type ToolCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Provider-assigned call id, the result refers back to it
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Arguments     string                 `protobuf:"bytes,3,opt,name=arguments,proto3" json:"arguments,omitempty"` // JSON arguments object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall) Reset() {
+	*x = ToolCall{}
+	mi := &file_io_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall) ProtoMessage() {}
+
+func (x *ToolCall) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
+func (*ToolCall) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ToolCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToolCall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCall) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+// The output of a tool call
+type ToolResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallId        string                 `protobuf:"bytes,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	IsError       bool                   `protobuf:"varint,4,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult) Reset() {
+	*x = ToolResult{}
+	mi := &file_io_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult) ProtoMessage() {}
+
+func (x *ToolResult) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult.ProtoReflect.Descriptor instead.
+func (*ToolResult) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ToolResult) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *ToolResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolResult) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ToolResult) GetIsError() bool {
+	if x != nil {
+		return x.IsError
+	}
+	return false
+}
+
 type MessageContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	Media         []*MediaItem           `protobuf:"bytes,2,rep,name=media,proto3" json:"media,omitempty"`
+	ToolCalls     []*ToolCall            `protobuf:"bytes,3,rep,name=tool_calls,json=toolCalls,proto3" json:"tool_calls,omitempty"`    // Assistant messages only
+	ToolResult    *ToolResult            `protobuf:"bytes,4,opt,name=tool_result,json=toolResult,proto3" json:"tool_result,omitempty"` // Tool messages only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageContent) Reset() {
 	*x = MessageContent{}
-	mi := &file_io_proto_msgTypes[2]
+	mi := &file_io_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +305,7 @@ func (x *MessageContent) String() string {
 func (*MessageContent) ProtoMessage() {}
 
 func (x *MessageContent) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[2]
+	mi := &file_io_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +318,7 @@ func (x *MessageContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageContent.ProtoReflect.Descriptor instead.
 func (*MessageContent) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{2}
+	return file_io_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MessageContent) GetText() string {
@@ -203,12 +335,26 @@ func (x *MessageContent) GetMedia() []*MediaItem {
 	return nil
 }
 
+func (x *MessageContent) GetToolCalls() []*ToolCall {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+func (x *MessageContent) GetToolResult() *ToolResult {
+	if x != nil {
+		return x.ToolResult
+	}
+	return nil
+}
+
 type Message struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // null for assistant messages
-	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                   // "user", "assistant", "system"
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                   // "user", "assistant", "system", "developer", "tool"
 	Content        *MessageContent        `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
@@ -217,7 +363,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_io_proto_msgTypes[3]
+	mi := &file_io_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -229,7 +375,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[3]
+	mi := &file_io_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +388,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{3}
+	return file_io_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Message) GetId() string {
@@ -288,18 +434,19 @@ func (x *Message) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type Conversation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PersonalityVersionId string                 `protobuf:"bytes,5,opt,name=personality_version_id,json=personalityVersionId,proto3" json:"personality_version_id,omitempty"` // Overrides the ai config's personality when set
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Conversation) Reset() {
 	*x = Conversation{}
-	mi := &file_io_proto_msgTypes[4]
+	mi := &file_io_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +458,7 @@ func (x *Conversation) String() string {
 func (*Conversation) ProtoMessage() {}
 
 func (x *Conversation) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[4]
+	mi := &file_io_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +471,7 @@ func (x *Conversation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
 func (*Conversation) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{4}
+	return file_io_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Conversation) GetId() string {
@@ -355,19 +502,30 @@ func (x *Conversation) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Conversation) GetPersonalityVersionId() string {
+	if x != nil {
+		return x.PersonalityVersionId
+	}
+	return ""
+}
+
 type Provider struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,5,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`                                                            // Set for OpenAI-compatible endpoints (grok, ollama, ...)
+	ApiMode       string                 `protobuf:"bytes,6,opt,name=api_mode,json=apiMode,proto3" json:"api_mode,omitempty"`                                                            // "chat_completions" or "responses"
+	ApiKeyEnv     string                 `protobuf:"bytes,7,opt,name=api_key_env,json=apiKeyEnv,proto3" json:"api_key_env,omitempty"`                                                    // Name of the environment variable holding the api key, starts with IO_PROVIDER_KEY_
+	Headers       map[string]string      `protobuf:"bytes,8,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Extra headers sent to the endpoint
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Provider) Reset() {
 	*x = Provider{}
-	mi := &file_io_proto_msgTypes[5]
+	mi := &file_io_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -379,7 +537,7 @@ func (x *Provider) String() string {
 func (*Provider) ProtoMessage() {}
 
 func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[5]
+	mi := &file_io_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +550,7 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Provider.ProtoReflect.Descriptor instead.
 func (*Provider) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{5}
+	return file_io_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Provider) GetId() string {
@@ -423,20 +581,56 @@ func (x *Provider) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Provider) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Provider) GetApiMode() string {
+	if x != nil {
+		return x.ApiMode
+	}
+	return ""
+}
+
+func (x *Provider) GetApiKeyEnv() string {
+	if x != nil {
+		return x.ApiKeyEnv
+	}
+	return ""
+}
+
+func (x *Provider) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 type Model struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProviderId    string                 `protobuf:"bytes,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProviderId        string                 `protobuf:"bytes,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProviderModelId   string                 `protobuf:"bytes,6,opt,name=provider_model_id,json=providerModelId,proto3" json:"provider_model_id,omitempty"`  // What the provider's api calls the model
+	ContextWindow     int32                  `protobuf:"varint,7,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`         // In tokens, 0 if unknown
+	MaxOutputTokens   int32                  `protobuf:"varint,8,opt,name=max_output_tokens,json=maxOutputTokens,proto3" json:"max_output_tokens,omitempty"` // 0 if unknown
+	SupportsVision    bool                   `protobuf:"varint,9,opt,name=supports_vision,json=supportsVision,proto3" json:"supports_vision,omitempty"`
+	SupportsTools     bool                   `protobuf:"varint,10,opt,name=supports_tools,json=supportsTools,proto3" json:"supports_tools,omitempty"`
+	SupportsStreaming bool                   `protobuf:"varint,11,opt,name=supports_streaming,json=supportsStreaming,proto3" json:"supports_streaming,omitempty"`
+	SupportsReasoning bool                   `protobuf:"varint,12,opt,name=supports_reasoning,json=supportsReasoning,proto3" json:"supports_reasoning,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Model) Reset() {
 	*x = Model{}
-	mi := &file_io_proto_msgTypes[6]
+	mi := &file_io_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +642,7 @@ func (x *Model) String() string {
 func (*Model) ProtoMessage() {}
 
 func (x *Model) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[6]
+	mi := &file_io_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +655,7 @@ func (x *Model) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Model.ProtoReflect.Descriptor instead.
 func (*Model) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{6}
+	return file_io_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Model) GetId() string {
@@ -499,124 +693,90 @@ func (x *Model) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type AIConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Model         *Model                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	SystemPrompt  string                 `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AIConfig) Reset() {
-	*x = AIConfig{}
-	mi := &file_io_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AIConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AIConfig) ProtoMessage() {}
-
-func (x *AIConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[7]
+func (x *Model) GetProviderModelId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProviderModelId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AIConfig.ProtoReflect.Descriptor instead.
-func (*AIConfig) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{7}
+	return ""
 }
 
-func (x *AIConfig) GetId() string {
+func (x *Model) GetContextWindow() int32 {
 	if x != nil {
-		return x.Id
+		return x.ContextWindow
 	}
-	return ""
+	return 0
 }
 
-func (x *AIConfig) GetName() string {
+func (x *Model) GetMaxOutputTokens() int32 {
 	if x != nil {
-		return x.Name
+		return x.MaxOutputTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *AIConfig) GetModel() *Model {
+func (x *Model) GetSupportsVision() bool {
 	if x != nil {
-		return x.Model
+		return x.SupportsVision
 	}
-	return nil
+	return false
 }
 
-func (x *AIConfig) GetSystemPrompt() string {
+func (x *Model) GetSupportsTools() bool {
 	if x != nil {
-		return x.SystemPrompt
+		return x.SupportsTools
 	}
-	return ""
+	return false
 }
 
-func (x *AIConfig) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Model) GetSupportsStreaming() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.SupportsStreaming
 	}
-	return nil
+	return false
 }
 
-func (x *AIConfig) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Model) GetSupportsReasoning() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SupportsReasoning
 	}
-	return nil
+	return false
 }
 
-func (x *AIConfig) GetLastUsedAt() *timestamppb.Timestamp {
+func (x *Model) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastUsedAt
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-// Request/Response messages
-type SendMessageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Content        *MessageContent        `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                           // "user", "assistant", "system"
-	ConversationId string                 `protobuf:"bytes,4,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // Optional - specify which conversation to use
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// How a model generates, unset fields leave the provider's default
+type GenerationParams struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Temperature     *float64               `protobuf:"fixed64,1,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	TopP            *float64               `protobuf:"fixed64,2,opt,name=top_p,json=topP,proto3,oneof" json:"top_p,omitempty"`
+	MaxOutputTokens *int32                 `protobuf:"varint,3,opt,name=max_output_tokens,json=maxOutputTokens,proto3,oneof" json:"max_output_tokens,omitempty"`
+	ReasoningEffort string                 `protobuf:"bytes,4,opt,name=reasoning_effort,json=reasoningEffort,proto3" json:"reasoning_effort,omitempty"` // "minimal", "low", "medium" or "high"
+	StopSequences   []string               `protobuf:"bytes,5,rep,name=stop_sequences,json=stopSequences,proto3" json:"stop_sequences,omitempty"`
+	ProviderOptions string                 `protobuf:"bytes,6,opt,name=provider_options,json=providerOptions,proto3" json:"provider_options,omitempty"` // JSON object merged into the provider's request body
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	mi := &file_io_proto_msgTypes[8]
+func (x *GenerationParams) Reset() {
+	*x = GenerationParams{}
+	mi := &file_io_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *GenerationParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*GenerationParams) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[8]
+func (x *GenerationParams) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,63 +787,5822 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GenerationParams.ProtoReflect.Descriptor instead.
+func (*GenerationParams) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GenerationParams) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *GenerationParams) GetTopP() float64 {
+	if x != nil && x.TopP != nil {
+		return *x.TopP
+	}
+	return 0
+}
+
+func (x *GenerationParams) GetMaxOutputTokens() int32 {
+	if x != nil && x.MaxOutputTokens != nil {
+		return *x.MaxOutputTokens
+	}
+	return 0
+}
+
+func (x *GenerationParams) GetReasoningEffort() string {
+	if x != nil {
+		return x.ReasoningEffort
+	}
+	return ""
+}
+
+func (x *GenerationParams) GetStopSequences() []string {
+	if x != nil {
+		return x.StopSequences
+	}
+	return nil
+}
+
+func (x *GenerationParams) GetProviderOptions() string {
+	if x != nil {
+		return x.ProviderOptions
+	}
+	return ""
+}
+
+type AIConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Model                *Model                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	SystemPrompt         string                 `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastUsedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	Params               *GenerationParams      `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	ContextStrategy      string                 `protobuf:"bytes,9,opt,name=context_strategy,json=contextStrategy,proto3" json:"context_strategy,omitempty"` // "drop_oldest" or "truncate_oldest", see ContextInfo
+	PersonalityVersionId string                 `protobuf:"bytes,10,opt,name=personality_version_id,json=personalityVersionId,proto3" json:"personality_version_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AIConfig) Reset() {
+	*x = AIConfig{}
+	mi := &file_io_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIConfig) ProtoMessage() {}
+
+func (x *AIConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIConfig.ProtoReflect.Descriptor instead.
+func (*AIConfig) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AIConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AIConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AIConfig) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *AIConfig) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *AIConfig) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AIConfig) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AIConfig) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *AIConfig) GetParams() *GenerationParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *AIConfig) GetContextStrategy() string {
+	if x != nil {
+		return x.ContextStrategy
+	}
+	return ""
+}
+
+func (x *AIConfig) GetPersonalityVersionId() string {
+	if x != nil {
+		return x.PersonalityVersionId
+	}
+	return ""
+}
+
+// A personality's definition is versioned, edits add a version and references keep pointing at theirs
+type Personality struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Latest        *PersonalityVersion    `protobuf:"bytes,4,opt,name=latest,proto3" json:"latest,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Personality) Reset() {
+	*x = Personality{}
+	mi := &file_io_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Personality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Personality) ProtoMessage() {}
+
+func (x *Personality) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Personality.ProtoReflect.Descriptor instead.
+func (*Personality) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Personality) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Personality) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Personality) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Personality) GetLatest() *PersonalityVersion {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *Personality) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Personality) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PersonalityVersion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PersonalityId  string                 `protobuf:"bytes,2,opt,name=personality_id,json=personalityId,proto3" json:"personality_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // The personality's name
+	Version        int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	PromptTemplate string                 `protobuf:"bytes,5,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	StyleRules     []string               `protobuf:"bytes,6,rep,name=style_rules,json=styleRules,proto3" json:"style_rules,omitempty"`
+	Examples       []*ExampleDialogue     `protobuf:"bytes,7,rep,name=examples,proto3" json:"examples,omitempty"`
+	DefaultParams  *GenerationParams      `protobuf:"bytes,8,opt,name=default_params,json=defaultParams,proto3" json:"default_params,omitempty"` // Fill in what the ai config leaves unset, stop sequences and provider options aren't used
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PersonalityVersion) Reset() {
+	*x = PersonalityVersion{}
+	mi := &file_io_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalityVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalityVersion) ProtoMessage() {}
+
+func (x *PersonalityVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalityVersion.ProtoReflect.Descriptor instead.
+func (*PersonalityVersion) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PersonalityVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PersonalityVersion) GetPersonalityId() string {
+	if x != nil {
+		return x.PersonalityId
+	}
+	return ""
+}
+
+func (x *PersonalityVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalityVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PersonalityVersion) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *PersonalityVersion) GetStyleRules() []string {
+	if x != nil {
+		return x.StyleRules
+	}
+	return nil
+}
+
+func (x *PersonalityVersion) GetExamples() []*ExampleDialogue {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *PersonalityVersion) GetDefaultParams() *GenerationParams {
+	if x != nil {
+		return x.DefaultParams
+	}
+	return nil
+}
+
+func (x *PersonalityVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExampleDialogue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Assistant     string                 `protobuf:"bytes,2,opt,name=assistant,proto3" json:"assistant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExampleDialogue) Reset() {
+	*x = ExampleDialogue{}
+	mi := &file_io_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExampleDialogue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExampleDialogue) ProtoMessage() {}
+
+func (x *ExampleDialogue) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExampleDialogue.ProtoReflect.Descriptor instead.
+func (*ExampleDialogue) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExampleDialogue) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ExampleDialogue) GetAssistant() string {
+	if x != nil {
+		return x.Assistant
+	}
+	return ""
+}
+
+// A fact kept across conversations, recalled into the system prompt when a message is about it
+type Memory struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Global           bool                   `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"` // Recalled in every user's conversations, edited through AdminService
+	Content          string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	SourceMessageIds []string               `protobuf:"bytes,4,rep,name=source_message_ids,json=sourceMessageIds,proto3" json:"source_message_ids,omitempty"` // Messages the memory was learned from
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Memory) Reset() {
+	*x = Memory{}
+	mi := &file_io_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memory) ProtoMessage() {}
+
+func (x *Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memory.ProtoReflect.Descriptor instead.
+func (*Memory) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Memory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Memory) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+func (x *Memory) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Memory) GetSourceMessageIds() []string {
+	if x != nil {
+		return x.SourceMessageIds
+	}
+	return nil
+}
+
+func (x *Memory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Memory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Text a user handed io, it is embedded and only seen through semantic search
+type Document struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // In characters
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_io_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Document) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Document) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Document) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Document) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// A message that matched a search, see SearchMessages
+type MessageSearchResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MessageId        string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationId   string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ConversationName string                 `protobuf:"bytes,3,opt,name=conversation_name,json=conversationName,proto3" json:"conversation_name,omitempty"`
+	Role             string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Snippet          string                 `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"` // Fragments of the text with the matches wrapped in **
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MessageSearchResult) Reset() {
+	*x = MessageSearchResult{}
+	mi := &file_io_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSearchResult) ProtoMessage() {}
+
+func (x *MessageSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSearchResult.ProtoReflect.Descriptor instead.
+func (*MessageSearchResult) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MessageSearchResult) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetConversationName() string {
+	if x != nil {
+		return x.ConversationName
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Request/Response messages
+type SendMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Content        *MessageContent        `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                           // "user", "assistant", "system"
+	ConversationId string                 `protobuf:"bytes,4,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // Optional, defaults to the user's active conversation in the channel
+	ChannelId      string                 `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                // Optional frontend channel, users have a separate active config and conversation per channel
+	Frontend       string                 `protobuf:"bytes,6,opt,name=frontend,proto3" json:"frontend,omitempty"`                                   // Optional frontend name, like "discord", system prompts can refer to it
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_io_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SendMessageRequest) GetContent() *MessageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetFrontend() string {
+	if x != nil {
+		return x.Frontend
+	}
+	return ""
+}
+
+type Usage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputTokens   int64                  `protobuf:"varint,1,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
+	OutputTokens  int64                  `protobuf:"varint,2,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
+	TotalTokens   int64                  `protobuf:"varint,3,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Usage) Reset() {
+	*x = Usage{}
+	mi := &file_io_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usage) ProtoMessage() {}
+
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Usage) GetInputTokens() int64 {
+	if x != nil {
+		return x.InputTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetOutputTokens() int64 {
+	if x != nil {
+		return x.OutputTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+type SendMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserMessage      *Message               `protobuf:"bytes,1,opt,name=user_message,json=userMessage,proto3" json:"user_message,omitempty"`                // The message that was sent
+	AssistantMessage *Message               `protobuf:"bytes,2,opt,name=assistant_message,json=assistantMessage,proto3" json:"assistant_message,omitempty"` // The AI's response
+	ConversationId   string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Usage            *Usage                 `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`                                   // Token usage of the generation
+	FinishReason     string                 `protobuf:"bytes,5,opt,name=finish_reason,json=finishReason,proto3" json:"finish_reason,omitempty"` // "stop", "length", "tool_calls", "content_filter"
+	ToolMessages     []*Message             `protobuf:"bytes,6,rep,name=tool_messages,json=toolMessages,proto3" json:"tool_messages,omitempty"` // Tool calls and results leading up to the assistant message, in order
+	Context          *ContextInfo           `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`                               // How the history was fit into the model's context window
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_io_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SendMessageResponse) GetUserMessage() *Message {
+	if x != nil {
+		return x.UserMessage
+	}
+	return nil
+}
+
+func (x *SendMessageResponse) GetAssistantMessage() *Message {
+	if x != nil {
+		return x.AssistantMessage
+	}
+	return nil
+}
+
+func (x *SendMessageResponse) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SendMessageResponse) GetUsage() *Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *SendMessageResponse) GetFinishReason() string {
+	if x != nil {
+		return x.FinishReason
+	}
+	return ""
+}
+
+func (x *SendMessageResponse) GetToolMessages() []*Message {
+	if x != nil {
+		return x.ToolMessages
+	}
+	return nil
+}
+
+func (x *SendMessageResponse) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Token counts are estimates, providers only report exact usage after the fact
+type ContextInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BudgetTokens       int32                  `protobuf:"varint,1,opt,name=budget_tokens,json=budgetTokens,proto3" json:"budget_tokens,omitempty"`                // Room left for history after the system prompt, tools and output, 0 if the context window is unknown
+	HistoryTokens      int32                  `protobuf:"varint,2,opt,name=history_tokens,json=historyTokens,proto3" json:"history_tokens,omitempty"`             // Of the history that was sent
+	DroppedMessages    int32                  `protobuf:"varint,3,opt,name=dropped_messages,json=droppedMessages,proto3" json:"dropped_messages,omitempty"`       // Oldest messages left out
+	TruncatedMessages  int32                  `protobuf:"varint,4,opt,name=truncated_messages,json=truncatedMessages,proto3" json:"truncated_messages,omitempty"` // Messages sent shortened
+	Strategy           string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	SummarizedMessages int32                  `protobuf:"varint,6,opt,name=summarized_messages,json=summarizedMessages,proto3" json:"summarized_messages,omitempty"` // Older messages the conversation's summary was sent in place of
+	RetrievedChunks    int32                  `protobuf:"varint,7,opt,name=retrieved_chunks,json=retrievedChunks,proto3" json:"retrieved_chunks,omitempty"`          // Related passages from earlier conversations, memories and documents sent along
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ContextInfo) Reset() {
+	*x = ContextInfo{}
+	mi := &file_io_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContextInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextInfo) ProtoMessage() {}
+
+func (x *ContextInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextInfo.ProtoReflect.Descriptor instead.
+func (*ContextInfo) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ContextInfo) GetBudgetTokens() int32 {
+	if x != nil {
+		return x.BudgetTokens
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetHistoryTokens() int32 {
+	if x != nil {
+		return x.HistoryTokens
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetDroppedMessages() int32 {
+	if x != nil {
+		return x.DroppedMessages
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetTruncatedMessages() int32 {
+	if x != nil {
+		return x.TruncatedMessages
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ContextInfo) GetSummarizedMessages() int32 {
+	if x != nil {
+		return x.SummarizedMessages
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetRetrievedChunks() int32 {
+	if x != nil {
+		return x.RetrievedChunks
+	}
+	return 0
+}
+
+// Streaming events, see SendMessageStream
+type TextDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextDelta) Reset() {
+	*x = TextDelta{}
+	mi := &file_io_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextDelta) ProtoMessage() {}
+
+func (x *TextDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextDelta.ProtoReflect.Descriptor instead.
+func (*TextDelta) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TextDelta) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ToolCallProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                       // "started", "arguments", "completed"
+	ArgumentsDelta string                 `protobuf:"bytes,4,opt,name=arguments_delta,json=argumentsDelta,proto3" json:"arguments_delta,omitempty"` // set when status is "arguments"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToolCallProgress) Reset() {
+	*x = ToolCallProgress{}
+	mi := &file_io_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCallProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCallProgress) ProtoMessage() {}
+
+func (x *ToolCallProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCallProgress.ProtoReflect.Descriptor instead.
+func (*ToolCallProgress) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ToolCallProgress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToolCallProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCallProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ToolCallProgress) GetArgumentsDelta() string {
+	if x != nil {
+		return x.ArgumentsDelta
+	}
+	return ""
+}
+
+// A tool call waiting for ApproveToolCall or RejectToolCall, the generation is paused until then
+type ToolApprovalRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId     string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ToolCall       *ToolCall              `protobuf:"bytes,3,opt,name=tool_call,json=toolCall,proto3" json:"tool_call,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // The call is rejected if nobody decides by then
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToolApprovalRequest) Reset() {
+	*x = ToolApprovalRequest{}
+	mi := &file_io_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolApprovalRequest) ProtoMessage() {}
+
+func (x *ToolApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolApprovalRequest.ProtoReflect.Descriptor instead.
+func (*ToolApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ToolApprovalRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *ToolApprovalRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ToolApprovalRequest) GetToolCall() *ToolCall {
+	if x != nil {
+		return x.ToolCall
+	}
+	return nil
+}
+
+func (x *ToolApprovalRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SendMessageStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SendMessageStreamResponse_TextDelta
+	//	*SendMessageStreamResponse_ToolCall
+	//	*SendMessageStreamResponse_Done
+	//	*SendMessageStreamResponse_ToolApproval
+	Event         isSendMessageStreamResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageStreamResponse) Reset() {
+	*x = SendMessageStreamResponse{}
+	mi := &file_io_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageStreamResponse) ProtoMessage() {}
+
+func (x *SendMessageStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageStreamResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageStreamResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendMessageStreamResponse) GetEvent() isSendMessageStreamResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SendMessageStreamResponse) GetTextDelta() *TextDelta {
+	if x != nil {
+		if x, ok := x.Event.(*SendMessageStreamResponse_TextDelta); ok {
+			return x.TextDelta
+		}
+	}
+	return nil
+}
+
+func (x *SendMessageStreamResponse) GetToolCall() *ToolCallProgress {
+	if x != nil {
+		if x, ok := x.Event.(*SendMessageStreamResponse_ToolCall); ok {
+			return x.ToolCall
+		}
+	}
+	return nil
+}
+
+func (x *SendMessageStreamResponse) GetDone() *SendMessageResponse {
+	if x != nil {
+		if x, ok := x.Event.(*SendMessageStreamResponse_Done); ok {
+			return x.Done
+		}
+	}
+	return nil
+}
+
+func (x *SendMessageStreamResponse) GetToolApproval() *ToolApprovalRequest {
+	if x != nil {
+		if x, ok := x.Event.(*SendMessageStreamResponse_ToolApproval); ok {
+			return x.ToolApproval
+		}
+	}
+	return nil
+}
+
+type isSendMessageStreamResponse_Event interface {
+	isSendMessageStreamResponse_Event()
+}
+
+type SendMessageStreamResponse_TextDelta struct {
+	TextDelta *TextDelta `protobuf:"bytes,1,opt,name=text_delta,json=textDelta,proto3,oneof"`
+}
+
+type SendMessageStreamResponse_ToolCall struct {
+	ToolCall *ToolCallProgress `protobuf:"bytes,2,opt,name=tool_call,json=toolCall,proto3,oneof"`
+}
+
+type SendMessageStreamResponse_Done struct {
+	Done *SendMessageResponse `protobuf:"bytes,3,opt,name=done,proto3,oneof"` // The persisted messages, always the last event
+}
+
+type SendMessageStreamResponse_ToolApproval struct {
+	ToolApproval *ToolApprovalRequest `protobuf:"bytes,4,opt,name=tool_approval,json=toolApproval,proto3,oneof"`
+}
+
+func (*SendMessageStreamResponse_TextDelta) isSendMessageStreamResponse_Event() {}
+
+func (*SendMessageStreamResponse_ToolCall) isSendMessageStreamResponse_Event() {}
+
+func (*SendMessageStreamResponse_Done) isSendMessageStreamResponse_Event() {}
+
+func (*SendMessageStreamResponse_ToolApproval) isSendMessageStreamResponse_Event() {}
+
+type ListConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_io_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListConversationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsResponse) Reset() {
+	*x = ListConversationsResponse{}
+	mi := &file_io_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsResponse) ProtoMessage() {}
+
+func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type LoadConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LoadConversationRequest) Reset() {
+	*x = LoadConversationRequest{}
+	mi := &file_io_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadConversationRequest) ProtoMessage() {}
+
+func (x *LoadConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadConversationRequest.ProtoReflect.Descriptor instead.
+func (*LoadConversationRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LoadConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *LoadConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LoadConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	Messages      []*Message             `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadConversationResponse) Reset() {
+	*x = LoadConversationResponse{}
+	mi := &file_io_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadConversationResponse) ProtoMessage() {}
+
+func (x *LoadConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadConversationResponse.ProtoReflect.Descriptor instead.
+func (*LoadConversationResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LoadConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *LoadConversationResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ActiveState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AIConfig              `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`             // Used for the user's next message in the channel
+	Conversation  *Conversation          `protobuf:"bytes,2,opt,name=conversation,proto3" json:"conversation,omitempty"` // Unset when the next message starts a new conversation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActiveState) Reset() {
+	*x = ActiveState{}
+	mi := &file_io_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveState) ProtoMessage() {}
+
+func (x *ActiveState) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveState.ProtoReflect.Descriptor instead.
+func (*ActiveState) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ActiveState) GetConfig() *AIConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ActiveState) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type GetActiveStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveStateRequest) Reset() {
+	*x = GetActiveStateRequest{}
+	mi := &file_io_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveStateRequest) ProtoMessage() {}
+
+func (x *GetActiveStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveStateRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveStateRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetActiveStateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetActiveStateRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type GetActiveStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *ActiveState           `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveStateResponse) Reset() {
+	*x = GetActiveStateResponse{}
+	mi := &file_io_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveStateResponse) ProtoMessage() {}
+
+func (x *GetActiveStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveStateResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveStateResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetActiveStateResponse) GetState() *ActiveState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type ResumeConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResumeConversationRequest) Reset() {
+	*x = ResumeConversationRequest{}
+	mi := &file_io_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeConversationRequest) ProtoMessage() {}
+
+func (x *ResumeConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeConversationRequest.ProtoReflect.Descriptor instead.
+func (*ResumeConversationRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResumeConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ResumeConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResumeConversationRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ResumeConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeConversationResponse) Reset() {
+	*x = ResumeConversationResponse{}
+	mi := &file_io_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeConversationResponse) ProtoMessage() {}
+
+func (x *ResumeConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeConversationResponse.ProtoReflect.Descriptor instead.
+func (*ResumeConversationResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResumeConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ClearActiveConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearActiveConversationRequest) Reset() {
+	*x = ClearActiveConversationRequest{}
+	mi := &file_io_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearActiveConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearActiveConversationRequest) ProtoMessage() {}
+
+func (x *ClearActiveConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearActiveConversationRequest.ProtoReflect.Descriptor instead.
+func (*ClearActiveConversationRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClearActiveConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearActiveConversationRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ClearActiveConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearActiveConversationResponse) Reset() {
+	*x = ClearActiveConversationResponse{}
+	mi := &file_io_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearActiveConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearActiveConversationResponse) ProtoMessage() {}
+
+func (x *ClearActiveConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearActiveConversationResponse.ProtoReflect.Descriptor instead.
+func (*ClearActiveConversationResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ClearActiveConversationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPersonalitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPersonalitiesRequest) Reset() {
+	*x = ListPersonalitiesRequest{}
+	mi := &file_io_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPersonalitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonalitiesRequest) ProtoMessage() {}
+
+func (x *ListPersonalitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonalitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonalitiesRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{36}
+}
+
+type ListPersonalitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Personalities []*Personality         `protobuf:"bytes,1,rep,name=personalities,proto3" json:"personalities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPersonalitiesResponse) Reset() {
+	*x = ListPersonalitiesResponse{}
+	mi := &file_io_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPersonalitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonalitiesResponse) ProtoMessage() {}
+
+func (x *ListPersonalitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonalitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListPersonalitiesResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListPersonalitiesResponse) GetPersonalities() []*Personality {
+	if x != nil {
+		return x.Personalities
+	}
+	return nil
+}
+
+type SetConversationPersonalityRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId       string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PersonalityVersionId string                 `protobuf:"bytes,3,opt,name=personality_version_id,json=personalityVersionId,proto3" json:"personality_version_id,omitempty"` // Empty goes back to the ai config's personality
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetConversationPersonalityRequest) Reset() {
+	*x = SetConversationPersonalityRequest{}
+	mi := &file_io_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationPersonalityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationPersonalityRequest) ProtoMessage() {}
+
+func (x *SetConversationPersonalityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationPersonalityRequest.ProtoReflect.Descriptor instead.
+func (*SetConversationPersonalityRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetConversationPersonalityRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SetConversationPersonalityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetConversationPersonalityRequest) GetPersonalityVersionId() string {
+	if x != nil {
+		return x.PersonalityVersionId
+	}
+	return ""
+}
+
+type SetConversationPersonalityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConversationPersonalityResponse) Reset() {
+	*x = SetConversationPersonalityResponse{}
+	mi := &file_io_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationPersonalityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationPersonalityResponse) ProtoMessage() {}
+
+func (x *SetConversationPersonalityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationPersonalityResponse.ProtoReflect.Descriptor instead.
+func (*SetConversationPersonalityResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetConversationPersonalityResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ListMemoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoriesRequest) Reset() {
+	*x = ListMemoriesRequest{}
+	mi := &file_io_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoriesRequest) ProtoMessage() {}
+
+func (x *ListMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListMemoriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListMemoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memories      []*Memory              `protobuf:"bytes,1,rep,name=memories,proto3" json:"memories,omitempty"` // The user's own, followed by the global ones
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoriesResponse) Reset() {
+	*x = ListMemoriesResponse{}
+	mi := &file_io_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoriesResponse) ProtoMessage() {}
+
+func (x *ListMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListMemoriesResponse) GetMemories() []*Memory {
+	if x != nil {
+		return x.Memories
+	}
+	return nil
+}
+
+type CreateMemoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoryRequest) Reset() {
+	*x = CreateMemoryRequest{}
+	mi := &file_io_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoryRequest) ProtoMessage() {}
+
+func (x *CreateMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateMemoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateMemoryRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateMemoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        *Memory                `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"` // An existing memory when the content duplicates it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoryResponse) Reset() {
+	*x = CreateMemoryResponse{}
+	mi := &file_io_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoryResponse) ProtoMessage() {}
+
+func (x *CreateMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateMemoryResponse) GetMemory() *Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type UpdateMemoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoryRequest) Reset() {
+	*x = UpdateMemoryRequest{}
+	mi := &file_io_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoryRequest) ProtoMessage() {}
+
+func (x *UpdateMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateMemoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMemoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemoryRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateMemoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        *Memory                `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoryResponse) Reset() {
+	*x = UpdateMemoryResponse{}
+	mi := &file_io_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoryResponse) ProtoMessage() {}
+
+func (x *UpdateMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateMemoryResponse) GetMemory() *Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type DeleteMemoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoryRequest) Reset() {
+	*x = DeleteMemoryRequest{}
+	mi := &file_io_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoryRequest) ProtoMessage() {}
+
+func (x *DeleteMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteMemoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMemoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteMemoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoryResponse) Reset() {
+	*x = DeleteMemoryResponse{}
+	mi := &file_io_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoryResponse) ProtoMessage() {}
+
+func (x *DeleteMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteMemoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UploadDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // Plain text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	mi := &file_io_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UploadDocumentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UploadDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentResponse) Reset() {
+	*x = UploadDocumentResponse{}
+	mi := &file_io_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse) ProtoMessage() {}
+
+func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UploadDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ListDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsRequest) Reset() {
+	*x = ListDocumentsRequest{}
+	mi := &file_io_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListDocumentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*Document            `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsResponse) Reset() {
+	*x = ListDocumentsResponse{}
+	mi := &file_io_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListDocumentsResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type DeleteDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
+	mi := &file_io_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteDocumentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
+	mi := &file_io_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteDocumentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SearchMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query          string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                                         // Web search syntax: words, "quoted phrases", or, -excluded
+	ConversationId string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // Optional, search just this conversation
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                           // Optional, like "user" or "assistant"
+	Since          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`                                         // Optional, inclusive
+	Until          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`                                         // Optional, exclusive
+	PageSize       int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                  // Defaults to 20, at most 100
+	Cursor         string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`                                       // Optional, next_cursor of the previous page, with the same filters
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_io_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SearchMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MessageSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                         // Newest first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_io_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchMessagesResponse) GetResults() []*MessageSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DeleteConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteConversationRequest) Reset() {
+	*x = DeleteConversationRequest{}
+	mi := &file_io_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationRequest) ProtoMessage() {}
+
+func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DeleteConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConversationResponse) Reset() {
+	*x = DeleteConversationResponse{}
+	mi := &file_io_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationResponse) ProtoMessage() {}
+
+func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteConversationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAIConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIConfigsRequest) Reset() {
+	*x = ListAIConfigsRequest{}
+	mi := &file_io_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIConfigsRequest) ProtoMessage() {}
+
+func (x *ListAIConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListAIConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{58}
+}
+
+type ListAIConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*AIConfig            `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIConfigsResponse) Reset() {
+	*x = ListAIConfigsResponse{}
+	mi := &file_io_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIConfigsResponse) ProtoMessage() {}
+
+func (x *ListAIConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListAIConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAIConfigsResponse) GetConfigs() []*AIConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type SwitchAIConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Optional, switches only this channel instead of the user's overall config
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchAIConfigRequest) Reset() {
+	*x = SwitchAIConfigRequest{}
+	mi := &file_io_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchAIConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchAIConfigRequest) ProtoMessage() {}
+
+func (x *SwitchAIConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchAIConfigRequest.ProtoReflect.Descriptor instead.
+func (*SwitchAIConfigRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SwitchAIConfigRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *SwitchAIConfigRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SwitchAIConfigRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type SwitchAIConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Config        *AIConfig              `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchAIConfigResponse) Reset() {
+	*x = SwitchAIConfigResponse{}
+	mi := &file_io_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchAIConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchAIConfigResponse) ProtoMessage() {}
+
+func (x *SwitchAIConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchAIConfigResponse.ProtoReflect.Descriptor instead.
+func (*SwitchAIConfigResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SwitchAIConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SwitchAIConfigResponse) GetConfig() *AIConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type Tool struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name as advertised to the model, mcp tools are "<server>__<tool>"
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,3,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"` // Calls wait for ApproveToolCall or RejectToolCall
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Tool) Reset() {
+	*x = Tool{}
+	mi := &file_io_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tool) ProtoMessage() {}
+
+func (x *Tool) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tool.ProtoReflect.Descriptor instead.
+func (*Tool) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Tool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tool) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+type ToolRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToolName      string                 `protobuf:"bytes,1,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"` // Tool name or glob, e.g. "filesystem__write_*"
+	Rule          string                 `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`                         // "allow", "deny" or "approve" (allowed, but every call needs a user's approval)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolRule) Reset() {
+	*x = ToolRule{}
+	mi := &file_io_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolRule) ProtoMessage() {}
+
+func (x *ToolRule) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolRule.ProtoReflect.Descriptor instead.
+func (*ToolRule) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ToolRule) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *ToolRule) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type UpdateAIConfigParamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	Params        *GenerationParams      `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAIConfigParamsRequest) Reset() {
+	*x = UpdateAIConfigParamsRequest{}
+	mi := &file_io_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAIConfigParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAIConfigParamsRequest) ProtoMessage() {}
+
+func (x *UpdateAIConfigParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAIConfigParamsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAIConfigParamsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateAIConfigParamsRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *UpdateAIConfigParamsRequest) GetParams() *GenerationParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type UpdateAIConfigParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AIConfig              `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAIConfigParamsResponse) Reset() {
+	*x = UpdateAIConfigParamsResponse{}
+	mi := &file_io_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAIConfigParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAIConfigParamsResponse) ProtoMessage() {}
+
+func (x *UpdateAIConfigParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAIConfigParamsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAIConfigParamsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateAIConfigParamsResponse) GetConfig() *AIConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetAIConfigToolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIConfigToolsRequest) Reset() {
+	*x = GetAIConfigToolsRequest{}
+	mi := &file_io_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIConfigToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIConfigToolsRequest) ProtoMessage() {}
+
+func (x *GetAIConfigToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIConfigToolsRequest.ProtoReflect.Descriptor instead.
+func (*GetAIConfigToolsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetAIConfigToolsRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+type GetAIConfigToolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	McpServers    []string               `protobuf:"bytes,1,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"` // Names of the attached mcp servers
+	Rules         []*ToolRule            `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Tools         []*Tool                `protobuf:"bytes,3,rep,name=tools,proto3" json:"tools,omitempty"` // Tools the config currently advertises to the model
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIConfigToolsResponse) Reset() {
+	*x = GetAIConfigToolsResponse{}
+	mi := &file_io_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIConfigToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIConfigToolsResponse) ProtoMessage() {}
+
+func (x *GetAIConfigToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIConfigToolsResponse.ProtoReflect.Descriptor instead.
+func (*GetAIConfigToolsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetAIConfigToolsResponse) GetMcpServers() []string {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *GetAIConfigToolsResponse) GetRules() []*ToolRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetAIConfigToolsResponse) GetTools() []*Tool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type AttachMCPServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	ServerName    string                 `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachMCPServerRequest) Reset() {
+	*x = AttachMCPServerRequest{}
+	mi := &file_io_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMCPServerRequest) ProtoMessage() {}
+
+func (x *AttachMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*AttachMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AttachMCPServerRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *AttachMCPServerRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type AttachMCPServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachMCPServerResponse) Reset() {
+	*x = AttachMCPServerResponse{}
+	mi := &file_io_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachMCPServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMCPServerResponse) ProtoMessage() {}
+
+func (x *AttachMCPServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMCPServerResponse.ProtoReflect.Descriptor instead.
+func (*AttachMCPServerResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AttachMCPServerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DetachMCPServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	ServerName    string                 `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachMCPServerRequest) Reset() {
+	*x = DetachMCPServerRequest{}
+	mi := &file_io_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachMCPServerRequest) ProtoMessage() {}
+
+func (x *DetachMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*DetachMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DetachMCPServerRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *DetachMCPServerRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type DetachMCPServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachMCPServerResponse) Reset() {
+	*x = DetachMCPServerResponse{}
+	mi := &file_io_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachMCPServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachMCPServerResponse) ProtoMessage() {}
+
+func (x *DetachMCPServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachMCPServerResponse.ProtoReflect.Descriptor instead.
+func (*DetachMCPServerResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DetachMCPServerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetToolRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	Rule          *ToolRule              `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetToolRuleRequest) Reset() {
+	*x = SetToolRuleRequest{}
+	mi := &file_io_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetToolRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetToolRuleRequest) ProtoMessage() {}
+
+func (x *SetToolRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetToolRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetToolRuleRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetToolRuleRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *SetToolRuleRequest) GetRule() *ToolRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type SetToolRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetToolRuleResponse) Reset() {
+	*x = SetToolRuleResponse{}
+	mi := &file_io_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetToolRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetToolRuleResponse) ProtoMessage() {}
+
+func (x *SetToolRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetToolRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetToolRuleResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetToolRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveToolRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	ToolName      string                 `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveToolRuleRequest) Reset() {
+	*x = RemoveToolRuleRequest{}
+	mi := &file_io_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveToolRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveToolRuleRequest) ProtoMessage() {}
+
+func (x *RemoveToolRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveToolRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveToolRuleRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RemoveToolRuleRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *RemoveToolRuleRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+type RemoveToolRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveToolRuleResponse) Reset() {
+	*x = RemoveToolRuleResponse{}
+	mi := &file_io_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveToolRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveToolRuleResponse) ProtoMessage() {}
+
+func (x *RemoveToolRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveToolRuleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveToolRuleResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RemoveToolRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ApproveToolCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must participate in the conversation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveToolCallRequest) Reset() {
+	*x = ApproveToolCallRequest{}
+	mi := &file_io_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveToolCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveToolCallRequest) ProtoMessage() {}
+
+func (x *ApproveToolCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveToolCallRequest.ProtoReflect.Descriptor instead.
+func (*ApproveToolCallRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ApproveToolCallRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *ApproveToolCallRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApproveToolCallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveToolCallResponse) Reset() {
+	*x = ApproveToolCallResponse{}
+	mi := &file_io_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveToolCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveToolCallResponse) ProtoMessage() {}
+
+func (x *ApproveToolCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveToolCallResponse.ProtoReflect.Descriptor instead.
+func (*ApproveToolCallResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ApproveToolCallResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RejectToolCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must participate in the conversation
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`               // Optional, passed on to the model
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectToolCallRequest) Reset() {
+	*x = RejectToolCallRequest{}
+	mi := &file_io_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectToolCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectToolCallRequest) ProtoMessage() {}
+
+func (x *RejectToolCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectToolCallRequest.ProtoReflect.Descriptor instead.
+func (*RejectToolCallRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RejectToolCallRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *RejectToolCallRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RejectToolCallRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectToolCallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectToolCallResponse) Reset() {
+	*x = RejectToolCallResponse{}
+	mi := &file_io_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectToolCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectToolCallResponse) ProtoMessage() {}
+
+func (x *RejectToolCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectToolCallResponse.ProtoReflect.Descriptor instead.
+func (*RejectToolCallResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RejectToolCallResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// A configured mcp server and the state of its session
+type MCPServerStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Transport     string                 `protobuf:"bytes,2,opt,name=transport,proto3" json:"transport,omitempty"`                  // "stdio", "streamable_http" or "sse"
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`                     // Connected when the backend starts
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                          // "connected", "connecting", "reconnecting" or "disconnected"
+	SessionId     string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Assigned by http servers
+	Tools         int32                  `protobuf:"varint,6,opt,name=tools,proto3" json:"tools,omitempty"`
+	Resources     int32                  `protobuf:"varint,7,opt,name=resources,proto3" json:"resources,omitempty"`
+	Prompts       int32                  `protobuf:"varint,8,opt,name=prompts,proto3" json:"prompts,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // Why the session last failed, empty while connected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MCPServerStatus) Reset() {
+	*x = MCPServerStatus{}
+	mi := &file_io_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MCPServerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MCPServerStatus) ProtoMessage() {}
+
+func (x *MCPServerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MCPServerStatus.ProtoReflect.Descriptor instead.
+func (*MCPServerStatus) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MCPServerStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MCPServerStatus) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *MCPServerStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MCPServerStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *MCPServerStatus) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MCPServerStatus) GetTools() int32 {
+	if x != nil {
+		return x.Tools
+	}
+	return 0
+}
+
+func (x *MCPServerStatus) GetResources() int32 {
+	if x != nil {
+		return x.Resources
+	}
+	return 0
+}
+
+func (x *MCPServerStatus) GetPrompts() int32 {
+	if x != nil {
+		return x.Prompts
+	}
+	return 0
+}
+
+func (x *MCPServerStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ListMCPServersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMCPServersRequest) Reset() {
+	*x = ListMCPServersRequest{}
+	mi := &file_io_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMCPServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMCPServersRequest) ProtoMessage() {}
+
+func (x *ListMCPServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMCPServersRequest.ProtoReflect.Descriptor instead.
+func (*ListMCPServersRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{81}
+}
+
+type ListMCPServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*MCPServerStatus     `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMCPServersResponse) Reset() {
+	*x = ListMCPServersResponse{}
+	mi := &file_io_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMCPServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMCPServersResponse) ProtoMessage() {}
+
+func (x *ListMCPServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMCPServersResponse.ProtoReflect.Descriptor instead.
+func (*ListMCPServersResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListMCPServersResponse) GetServers() []*MCPServerStatus {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type ConnectMCPServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectMCPServerRequest) Reset() {
+	*x = ConnectMCPServerRequest{}
+	mi := &file_io_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectMCPServerRequest) ProtoMessage() {}
+
+func (x *ConnectMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*ConnectMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ConnectMCPServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConnectMCPServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *MCPServerStatus       `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectMCPServerResponse) Reset() {
+	*x = ConnectMCPServerResponse{}
+	mi := &file_io_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectMCPServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectMCPServerResponse) ProtoMessage() {}
+
+func (x *ConnectMCPServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectMCPServerResponse.ProtoReflect.Descriptor instead.
+func (*ConnectMCPServerResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ConnectMCPServerResponse) GetServer() *MCPServerStatus {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type DisconnectMCPServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectMCPServerRequest) Reset() {
+	*x = DisconnectMCPServerRequest{}
+	mi := &file_io_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectMCPServerRequest) ProtoMessage() {}
+
+func (x *DisconnectMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DisconnectMCPServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DisconnectMCPServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectMCPServerResponse) Reset() {
+	*x = DisconnectMCPServerResponse{}
+	mi := &file_io_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectMCPServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectMCPServerResponse) ProtoMessage() {}
+
+func (x *DisconnectMCPServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectMCPServerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectMCPServerResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DisconnectMCPServerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestartMCPServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartMCPServerRequest) Reset() {
+	*x = RestartMCPServerRequest{}
+	mi := &file_io_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartMCPServerRequest) ProtoMessage() {}
+
+func (x *RestartMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*RestartMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RestartMCPServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestartMCPServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *MCPServerStatus       `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartMCPServerResponse) Reset() {
+	*x = RestartMCPServerResponse{}
+	mi := &file_io_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartMCPServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartMCPServerResponse) ProtoMessage() {}
+
+func (x *RestartMCPServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartMCPServerResponse.ProtoReflect.Descriptor instead.
+func (*RestartMCPServerResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RestartMCPServerResponse) GetServer() *MCPServerStatus {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	mi := &file_io_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{89}
+}
+
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_io_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type ProviderSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`         // Leave empty for the built-in openai, anthropic and gemini clients
+	ApiKeyEnv     string                 `protobuf:"bytes,3,opt,name=api_key_env,json=apiKeyEnv,proto3" json:"api_key_env,omitempty"` // Has to start with IO_PROVIDER_KEY_, other variables are off limits
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiMode       string                 `protobuf:"bytes,5,opt,name=api_mode,json=apiMode,proto3" json:"api_mode,omitempty"` // "chat_completions" (default) or "responses"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderSpec) Reset() {
+	*x = ProviderSpec{}
+	mi := &file_io_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSpec) ProtoMessage() {}
+
+func (x *ProviderSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSpec.ProtoReflect.Descriptor instead.
+func (*ProviderSpec) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ProviderSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderSpec) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *ProviderSpec) GetApiKeyEnv() string {
+	if x != nil {
+		return x.ApiKeyEnv
+	}
+	return ""
+}
+
+func (x *ProviderSpec) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ProviderSpec) GetApiMode() string {
+	if x != nil {
+		return x.ApiMode
+	}
+	return ""
+}
+
+type CreateProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *ProviderSpec          `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProviderRequest) Reset() {
+	*x = CreateProviderRequest{}
+	mi := &file_io_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProviderRequest) ProtoMessage() {}
+
+func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
+func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateProviderRequest) GetProvider() *ProviderSpec {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type CreateProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProviderResponse) Reset() {
+	*x = CreateProviderResponse{}
+	mi := &file_io_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProviderResponse) ProtoMessage() {}
+
+func (x *CreateProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProviderResponse.ProtoReflect.Descriptor instead.
+func (*CreateProviderResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateProviderResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type UpdateProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider      *ProviderSpec          `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProviderRequest) Reset() {
+	*x = UpdateProviderRequest{}
+	mi := &file_io_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProviderRequest) ProtoMessage() {}
+
+func (x *UpdateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProviderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateProviderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProviderRequest) GetProvider() *ProviderSpec {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type UpdateProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProviderResponse) Reset() {
+	*x = UpdateProviderResponse{}
+	mi := &file_io_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProviderResponse) ProtoMessage() {}
+
+func (x *UpdateProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProviderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProviderResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateProviderResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type DeleteProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProviderRequest) Reset() {
+	*x = DeleteProviderRequest{}
+	mi := &file_io_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderRequest) ProtoMessage() {}
+
+func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteProviderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProviderResponse) Reset() {
+	*x = DeleteProviderResponse{}
+	mi := &file_io_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderResponse) ProtoMessage() {}
+
+func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteProviderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ModelSpec struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProviderModelId   string                 `protobuf:"bytes,2,opt,name=provider_model_id,json=providerModelId,proto3" json:"provider_model_id,omitempty"` // Defaults to name
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ContextWindow     int32                  `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`         // 0 if unknown
+	MaxOutputTokens   int32                  `protobuf:"varint,5,opt,name=max_output_tokens,json=maxOutputTokens,proto3" json:"max_output_tokens,omitempty"` // 0 if unknown
+	SupportsVision    bool                   `protobuf:"varint,6,opt,name=supports_vision,json=supportsVision,proto3" json:"supports_vision,omitempty"`
+	SupportsTools     bool                   `protobuf:"varint,7,opt,name=supports_tools,json=supportsTools,proto3" json:"supports_tools,omitempty"`
+	SupportsStreaming bool                   `protobuf:"varint,8,opt,name=supports_streaming,json=supportsStreaming,proto3" json:"supports_streaming,omitempty"`
+	SupportsReasoning bool                   `protobuf:"varint,9,opt,name=supports_reasoning,json=supportsReasoning,proto3" json:"supports_reasoning,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ModelSpec) Reset() {
+	*x = ModelSpec{}
+	mi := &file_io_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelSpec) ProtoMessage() {}
+
+func (x *ModelSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelSpec.ProtoReflect.Descriptor instead.
+func (*ModelSpec) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ModelSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModelSpec) GetProviderModelId() string {
+	if x != nil {
+		return x.ProviderModelId
+	}
+	return ""
+}
+
+func (x *ModelSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ModelSpec) GetContextWindow() int32 {
+	if x != nil {
+		return x.ContextWindow
+	}
+	return 0
+}
+
+func (x *ModelSpec) GetMaxOutputTokens() int32 {
+	if x != nil {
+		return x.MaxOutputTokens
+	}
+	return 0
+}
+
+func (x *ModelSpec) GetSupportsVision() bool {
+	if x != nil {
+		return x.SupportsVision
+	}
+	return false
+}
+
+func (x *ModelSpec) GetSupportsTools() bool {
+	if x != nil {
+		return x.SupportsTools
+	}
+	return false
+}
+
+func (x *ModelSpec) GetSupportsStreaming() bool {
+	if x != nil {
+		return x.SupportsStreaming
+	}
+	return false
+}
+
+func (x *ModelSpec) GetSupportsReasoning() bool {
+	if x != nil {
+		return x.SupportsReasoning
+	}
+	return false
+}
+
+type ListModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId    string                 `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"` // Optional, lists every model when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModelsRequest) Reset() {
+	*x = ListModelsRequest{}
+	mi := &file_io_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelsRequest) ProtoMessage() {}
+
+func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListModelsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListModelsRequest) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+type ListModelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModelsResponse) Reset() {
+	*x = ListModelsResponse{}
+	mi := &file_io_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelsResponse) ProtoMessage() {}
+
+func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListModelsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListModelsResponse) GetModels() []*Model {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+type CreateModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId    string                 `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	Model         *ModelSpec             `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateModelRequest) Reset() {
+	*x = CreateModelRequest{}
+	mi := &file_io_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateModelRequest) ProtoMessage() {}
+
+func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateModelRequest.ProtoReflect.Descriptor instead.
+func (*CreateModelRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateModelRequest) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *CreateModelRequest) GetModel() *ModelSpec {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type CreateModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *Model                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateModelResponse) Reset() {
+	*x = CreateModelResponse{}
+	mi := &file_io_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateModelResponse) ProtoMessage() {}
+
+func (x *CreateModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateModelResponse.ProtoReflect.Descriptor instead.
+func (*CreateModelResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateModelResponse) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type UpdateModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Model         *ModelSpec             `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"` // A model can't move to another provider
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateModelRequest) Reset() {
+	*x = UpdateModelRequest{}
+	mi := &file_io_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelRequest) ProtoMessage() {}
+
+func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModelRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateModelRequest) GetModel() *ModelSpec {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type UpdateModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *Model                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateModelResponse) Reset() {
+	*x = UpdateModelResponse{}
+	mi := &file_io_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelResponse) ProtoMessage() {}
+
+func (x *UpdateModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateModelResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateModelResponse) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type DeleteModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteModelRequest) Reset() {
+	*x = DeleteModelRequest{}
+	mi := &file_io_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModelRequest) ProtoMessage() {}
+
+func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModelRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteModelResponse) Reset() {
+	*x = DeleteModelResponse{}
+	mi := &file_io_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModelResponse) ProtoMessage() {}
+
+func (x *DeleteModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteModelResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteModelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AIConfigSpec struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ModelId              string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	SystemPrompt         string                 `protobuf:"bytes,3,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`                           // A text/template, see service.promptData for what it can refer to, checked on save
+	ContextStrategy      string                 `protobuf:"bytes,4,opt,name=context_strategy,json=contextStrategy,proto3" json:"context_strategy,omitempty"`                  // Defaults to "drop_oldest"
+	PersonalityVersionId string                 `protobuf:"bytes,5,opt,name=personality_version_id,json=personalityVersionId,proto3" json:"personality_version_id,omitempty"` // Optional
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AIConfigSpec) Reset() {
+	*x = AIConfigSpec{}
+	mi := &file_io_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIConfigSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIConfigSpec) ProtoMessage() {}
+
+func (x *AIConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIConfigSpec.ProtoReflect.Descriptor instead.
+func (*AIConfigSpec) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AIConfigSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AIConfigSpec) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *AIConfigSpec) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *AIConfigSpec) GetContextStrategy() string {
+	if x != nil {
+		return x.ContextStrategy
+	}
+	return ""
+}
+
+func (x *AIConfigSpec) GetPersonalityVersionId() string {
+	if x != nil {
+		return x.PersonalityVersionId
+	}
+	return ""
+}
+
+type CreateAIConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AIConfigSpec          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAIConfigRequest) Reset() {
+	*x = CreateAIConfigRequest{}
+	mi := &file_io_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAIConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAIConfigRequest) ProtoMessage() {}
+
+func (x *CreateAIConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAIConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateAIConfigRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateAIConfigRequest) GetConfig() *AIConfigSpec {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CreateAIConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AIConfig              `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAIConfigResponse) Reset() {
+	*x = CreateAIConfigResponse{}
+	mi := &file_io_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAIConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAIConfigResponse) ProtoMessage() {}
+
+func (x *CreateAIConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAIConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateAIConfigResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateAIConfigResponse) GetConfig() *AIConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateAIConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Config        *AIConfigSpec          `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"` // Generation params are kept, see UpdateAIConfigParams
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAIConfigRequest) Reset() {
+	*x = UpdateAIConfigRequest{}
+	mi := &file_io_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAIConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAIConfigRequest) ProtoMessage() {}
+
+func (x *UpdateAIConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAIConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAIConfigRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateAIConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAIConfigRequest) GetConfig() *AIConfigSpec {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateAIConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AIConfig              `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAIConfigResponse) Reset() {
+	*x = UpdateAIConfigResponse{}
+	mi := &file_io_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAIConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAIConfigResponse) ProtoMessage() {}
+
+func (x *UpdateAIConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SendMessageRequest) GetContent() *MessageContent {
+// Deprecated: Use UpdateAIConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAIConfigResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateAIConfigResponse) GetConfig() *AIConfig {
 	if x != nil {
-		return x.Content
+		return x.Config
 	}
 	return nil
 }
 
-func (x *SendMessageRequest) GetUserId() string {
+type DeleteAIConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAIConfigRequest) Reset() {
+	*x = DeleteAIConfigRequest{}
+	mi := &file_io_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAIConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAIConfigRequest) ProtoMessage() {}
+
+func (x *DeleteAIConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[112]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAIConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAIConfigRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteAIConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetRole() string {
+type DeleteAIConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAIConfigResponse) Reset() {
+	*x = DeleteAIConfigResponse{}
+	mi := &file_io_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAIConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAIConfigResponse) ProtoMessage() {}
+
+func (x *DeleteAIConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[113]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAIConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAIConfigResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeleteAIConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PersonalitySpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PromptTemplate string                 `protobuf:"bytes,3,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	StyleRules     []string               `protobuf:"bytes,4,rep,name=style_rules,json=styleRules,proto3" json:"style_rules,omitempty"`
+	Examples       []*ExampleDialogue     `protobuf:"bytes,5,rep,name=examples,proto3" json:"examples,omitempty"`
+	DefaultParams  *GenerationParams      `protobuf:"bytes,6,opt,name=default_params,json=defaultParams,proto3" json:"default_params,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PersonalitySpec) Reset() {
+	*x = PersonalitySpec{}
+	mi := &file_io_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalitySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalitySpec) ProtoMessage() {}
+
+func (x *PersonalitySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalitySpec.ProtoReflect.Descriptor instead.
+func (*PersonalitySpec) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PersonalitySpec) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetConversationId() string {
+func (x *PersonalitySpec) GetDescription() string {
 	if x != nil {
-		return x.ConversationId
+		return x.Description
 	}
 	return ""
 }
 
-type SendMessageResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserMessage      *Message               `protobuf:"bytes,1,opt,name=user_message,json=userMessage,proto3" json:"user_message,omitempty"`                // The message that was sent
-	AssistantMessage *Message               `protobuf:"bytes,2,opt,name=assistant_message,json=assistantMessage,proto3" json:"assistant_message,omitempty"` // The AI's response
-	ConversationId   string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *PersonalitySpec) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
 }
 
-func (x *SendMessageResponse) Reset() {
-	*x = SendMessageResponse{}
-	mi := &file_io_proto_msgTypes[9]
+func (x *PersonalitySpec) GetStyleRules() []string {
+	if x != nil {
+		return x.StyleRules
+	}
+	return nil
+}
+
+func (x *PersonalitySpec) GetExamples() []*ExampleDialogue {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *PersonalitySpec) GetDefaultParams() *GenerationParams {
+	if x != nil {
+		return x.DefaultParams
+	}
+	return nil
+}
+
+type CreatePersonalityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Personality   *PersonalitySpec       `protobuf:"bytes,1,opt,name=personality,proto3" json:"personality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonalityRequest) Reset() {
+	*x = CreatePersonalityRequest{}
+	mi := &file_io_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageResponse) String() string {
+func (x *CreatePersonalityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageResponse) ProtoMessage() {}
+func (*CreatePersonalityRequest) ProtoMessage() {}
 
-func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[9]
+func (x *CreatePersonalityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,54 +6613,136 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendMessageResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreatePersonalityRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonalityRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *SendMessageResponse) GetUserMessage() *Message {
+func (x *CreatePersonalityRequest) GetPersonality() *PersonalitySpec {
 	if x != nil {
-		return x.UserMessage
+		return x.Personality
 	}
 	return nil
 }
 
-func (x *SendMessageResponse) GetAssistantMessage() *Message {
+type CreatePersonalityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Personality   *Personality           `protobuf:"bytes,1,opt,name=personality,proto3" json:"personality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonalityResponse) Reset() {
+	*x = CreatePersonalityResponse{}
+	mi := &file_io_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonalityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonalityResponse) ProtoMessage() {}
+
+func (x *CreatePersonalityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[116]
 	if x != nil {
-		return x.AssistantMessage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonalityResponse.ProtoReflect.Descriptor instead.
+func (*CreatePersonalityResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreatePersonalityResponse) GetPersonality() *Personality {
+	if x != nil {
+		return x.Personality
 	}
 	return nil
 }
 
-func (x *SendMessageResponse) GetConversationId() string {
+type UpdatePersonalityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Personality   *PersonalitySpec       `protobuf:"bytes,2,opt,name=personality,proto3" json:"personality,omitempty"` // Adds a version when the definition changed, name and description are updated in place
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePersonalityRequest) Reset() {
+	*x = UpdatePersonalityRequest{}
+	mi := &file_io_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonalityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonalityRequest) ProtoMessage() {}
+
+func (x *UpdatePersonalityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[117]
 	if x != nil {
-		return x.ConversationId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonalityRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonalityRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UpdatePersonalityRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type ListConversationsRequest struct {
+func (x *UpdatePersonalityRequest) GetPersonality() *PersonalitySpec {
+	if x != nil {
+		return x.Personality
+	}
+	return nil
+}
+
+type UpdatePersonalityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Personality   *Personality           `protobuf:"bytes,1,opt,name=personality,proto3" json:"personality,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListConversationsRequest) Reset() {
-	*x = ListConversationsRequest{}
-	mi := &file_io_proto_msgTypes[10]
+func (x *UpdatePersonalityResponse) Reset() {
+	*x = UpdatePersonalityResponse{}
+	mi := &file_io_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConversationsRequest) String() string {
+func (x *UpdatePersonalityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConversationsRequest) ProtoMessage() {}
+func (*UpdatePersonalityResponse) ProtoMessage() {}
 
-func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[10]
+func (x *UpdatePersonalityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,40 +6753,84 @@ func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
-func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdatePersonalityResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePersonalityResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *ListConversationsRequest) GetUserId() string {
+func (x *UpdatePersonalityResponse) GetPersonality() *Personality {
 	if x != nil {
-		return x.UserId
+		return x.Personality
+	}
+	return nil
+}
+
+type DeletePersonalityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePersonalityRequest) Reset() {
+	*x = DeletePersonalityRequest{}
+	mi := &file_io_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePersonalityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonalityRequest) ProtoMessage() {}
+
+func (x *DeletePersonalityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonalityRequest.ProtoReflect.Descriptor instead.
+func (*DeletePersonalityRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeletePersonalityRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type ListConversationsResponse struct {
+type DeletePersonalityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListConversationsResponse) Reset() {
-	*x = ListConversationsResponse{}
-	mi := &file_io_proto_msgTypes[11]
+func (x *DeletePersonalityResponse) Reset() {
+	*x = DeletePersonalityResponse{}
+	mi := &file_io_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConversationsResponse) String() string {
+func (x *DeletePersonalityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConversationsResponse) ProtoMessage() {}
+func (*DeletePersonalityResponse) ProtoMessage() {}
 
-func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[11]
+func (x *DeletePersonalityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,41 +6841,40 @@ func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
-func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeletePersonalityResponse.ProtoReflect.Descriptor instead.
+func (*DeletePersonalityResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *ListConversationsResponse) GetConversations() []*Conversation {
+func (x *DeletePersonalityResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Conversations
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type LoadConversationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListPersonalityVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonalityId string                 `protobuf:"bytes,1,opt,name=personality_id,json=personalityId,proto3" json:"personality_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoadConversationRequest) Reset() {
-	*x = LoadConversationRequest{}
-	mi := &file_io_proto_msgTypes[12]
+func (x *ListPersonalityVersionsRequest) Reset() {
+	*x = ListPersonalityVersionsRequest{}
+	mi := &file_io_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoadConversationRequest) String() string {
+func (x *ListPersonalityVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadConversationRequest) ProtoMessage() {}
+func (*ListPersonalityVersionsRequest) ProtoMessage() {}
 
-func (x *LoadConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[12]
+func (x *ListPersonalityVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,48 +6885,40 @@ func (x *LoadConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadConversationRequest.ProtoReflect.Descriptor instead.
-func (*LoadConversationRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *LoadConversationRequest) GetConversationId() string {
-	if x != nil {
-		return x.ConversationId
-	}
-	return ""
+// Deprecated: Use ListPersonalityVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonalityVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *LoadConversationRequest) GetUserId() string {
+func (x *ListPersonalityVersionsRequest) GetPersonalityId() string {
 	if x != nil {
-		return x.UserId
+		return x.PersonalityId
 	}
 	return ""
 }
 
-type LoadConversationResponse struct {
+type ListPersonalityVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
-	Messages      []*Message             `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Versions      []*PersonalityVersion  `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoadConversationResponse) Reset() {
-	*x = LoadConversationResponse{}
-	mi := &file_io_proto_msgTypes[13]
+func (x *ListPersonalityVersionsResponse) Reset() {
+	*x = ListPersonalityVersionsResponse{}
+	mi := &file_io_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoadConversationResponse) String() string {
+func (x *ListPersonalityVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadConversationResponse) ProtoMessage() {}
+func (*ListPersonalityVersionsResponse) ProtoMessage() {}
 
-func (x *LoadConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[13]
+func (x *ListPersonalityVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,48 +6929,39 @@ func (x *LoadConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadConversationResponse.ProtoReflect.Descriptor instead.
-func (*LoadConversationResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *LoadConversationResponse) GetConversation() *Conversation {
-	if x != nil {
-		return x.Conversation
-	}
-	return nil
+// Deprecated: Use ListPersonalityVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPersonalityVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *LoadConversationResponse) GetMessages() []*Message {
+func (x *ListPersonalityVersionsResponse) GetVersions() []*PersonalityVersion {
 	if x != nil {
-		return x.Messages
+		return x.Versions
 	}
 	return nil
 }
 
-type DeleteConversationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListGlobalMemoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteConversationRequest) Reset() {
-	*x = DeleteConversationRequest{}
-	mi := &file_io_proto_msgTypes[14]
+func (x *ListGlobalMemoriesRequest) Reset() {
+	*x = ListGlobalMemoriesRequest{}
+	mi := &file_io_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConversationRequest) String() string {
+func (x *ListGlobalMemoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConversationRequest) ProtoMessage() {}
+func (*ListGlobalMemoriesRequest) ProtoMessage() {}
 
-func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[14]
+func (x *ListGlobalMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,47 +6972,33 @@ func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConversationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *DeleteConversationRequest) GetConversationId() string {
-	if x != nil {
-		return x.ConversationId
-	}
-	return ""
-}
-
-func (x *DeleteConversationRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ListGlobalMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListGlobalMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{123}
 }
 
-type DeleteConversationResponse struct {
+type ListGlobalMemoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Memories      []*Memory              `protobuf:"bytes,1,rep,name=memories,proto3" json:"memories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteConversationResponse) Reset() {
-	*x = DeleteConversationResponse{}
-	mi := &file_io_proto_msgTypes[15]
+func (x *ListGlobalMemoriesResponse) Reset() {
+	*x = ListGlobalMemoriesResponse{}
+	mi := &file_io_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConversationResponse) String() string {
+func (x *ListGlobalMemoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConversationResponse) ProtoMessage() {}
+func (*ListGlobalMemoriesResponse) ProtoMessage() {}
 
-func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[15]
+func (x *ListGlobalMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,39 +7009,40 @@ func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConversationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListGlobalMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListGlobalMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *DeleteConversationResponse) GetSuccess() bool {
+func (x *ListGlobalMemoriesResponse) GetMemories() []*Memory {
 	if x != nil {
-		return x.Success
+		return x.Memories
 	}
-	return false
+	return nil
 }
 
-type ListAIConfigsRequest struct {
+type CreateGlobalMemoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAIConfigsRequest) Reset() {
-	*x = ListAIConfigsRequest{}
-	mi := &file_io_proto_msgTypes[16]
+func (x *CreateGlobalMemoryRequest) Reset() {
+	*x = CreateGlobalMemoryRequest{}
+	mi := &file_io_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAIConfigsRequest) String() string {
+func (x *CreateGlobalMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAIConfigsRequest) ProtoMessage() {}
+func (*CreateGlobalMemoryRequest) ProtoMessage() {}
 
-func (x *ListAIConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[16]
+func (x *CreateGlobalMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,33 +7053,40 @@ func (x *ListAIConfigsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAIConfigsRequest.ProtoReflect.Descriptor instead.
-func (*ListAIConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateGlobalMemoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateGlobalMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{125}
 }
 
-type ListAIConfigsResponse struct {
+func (x *CreateGlobalMemoryRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateGlobalMemoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Configs       []*AIConfig            `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	Memory        *Memory                `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"` // An existing memory when the content duplicates it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAIConfigsResponse) Reset() {
-	*x = ListAIConfigsResponse{}
-	mi := &file_io_proto_msgTypes[17]
+func (x *CreateGlobalMemoryResponse) Reset() {
+	*x = CreateGlobalMemoryResponse{}
+	mi := &file_io_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAIConfigsResponse) String() string {
+func (x *CreateGlobalMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAIConfigsResponse) ProtoMessage() {}
+func (*CreateGlobalMemoryResponse) ProtoMessage() {}
 
-func (x *ListAIConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[17]
+func (x *CreateGlobalMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,41 +7097,41 @@ func (x *ListAIConfigsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAIConfigsResponse.ProtoReflect.Descriptor instead.
-func (*ListAIConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateGlobalMemoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateGlobalMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *ListAIConfigsResponse) GetConfigs() []*AIConfig {
+func (x *CreateGlobalMemoryResponse) GetMemory() *Memory {
 	if x != nil {
-		return x.Configs
+		return x.Memory
 	}
 	return nil
 }
 
-type SwitchAIConfigRequest struct {
+type UpdateGlobalMemoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SwitchAIConfigRequest) Reset() {
-	*x = SwitchAIConfigRequest{}
-	mi := &file_io_proto_msgTypes[18]
+func (x *UpdateGlobalMemoryRequest) Reset() {
+	*x = UpdateGlobalMemoryRequest{}
+	mi := &file_io_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SwitchAIConfigRequest) String() string {
+func (x *UpdateGlobalMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchAIConfigRequest) ProtoMessage() {}
+func (*UpdateGlobalMemoryRequest) ProtoMessage() {}
 
-func (x *SwitchAIConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[18]
+func (x *UpdateGlobalMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,48 +7142,47 @@ func (x *SwitchAIConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchAIConfigRequest.ProtoReflect.Descriptor instead.
-func (*SwitchAIConfigRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UpdateGlobalMemoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGlobalMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *SwitchAIConfigRequest) GetConfigId() string {
+func (x *UpdateGlobalMemoryRequest) GetId() string {
 	if x != nil {
-		return x.ConfigId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SwitchAIConfigRequest) GetUserId() string {
+func (x *UpdateGlobalMemoryRequest) GetContent() string {
 	if x != nil {
-		return x.UserId
+		return x.Content
 	}
 	return ""
 }
 
-type SwitchAIConfigResponse struct {
+type UpdateGlobalMemoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Config        *AIConfig              `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Memory        *Memory                `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SwitchAIConfigResponse) Reset() {
-	*x = SwitchAIConfigResponse{}
-	mi := &file_io_proto_msgTypes[19]
+func (x *UpdateGlobalMemoryResponse) Reset() {
+	*x = UpdateGlobalMemoryResponse{}
+	mi := &file_io_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SwitchAIConfigResponse) String() string {
+func (x *UpdateGlobalMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchAIConfigResponse) ProtoMessage() {}
+func (*UpdateGlobalMemoryResponse) ProtoMessage() {}
 
-func (x *SwitchAIConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[19]
+func (x *UpdateGlobalMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,46 +7193,40 @@ func (x *SwitchAIConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchAIConfigResponse.ProtoReflect.Descriptor instead.
-func (*SwitchAIConfigResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *SwitchAIConfigResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use UpdateGlobalMemoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGlobalMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *SwitchAIConfigResponse) GetConfig() *AIConfig {
+func (x *UpdateGlobalMemoryResponse) GetMemory() *Memory {
 	if x != nil {
-		return x.Config
+		return x.Memory
 	}
 	return nil
 }
 
-type ListProvidersRequest struct {
+type DeleteGlobalMemoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProvidersRequest) Reset() {
-	*x = ListProvidersRequest{}
-	mi := &file_io_proto_msgTypes[20]
+func (x *DeleteGlobalMemoryRequest) Reset() {
+	*x = DeleteGlobalMemoryRequest{}
+	mi := &file_io_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersRequest) String() string {
+func (x *DeleteGlobalMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersRequest) ProtoMessage() {}
+func (*DeleteGlobalMemoryRequest) ProtoMessage() {}
 
-func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[20]
+func (x *DeleteGlobalMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,33 +7237,40 @@ func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
-func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeleteGlobalMemoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGlobalMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{129}
 }
 
-type ListProvidersResponse struct {
+func (x *DeleteGlobalMemoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGlobalMemoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Providers     []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProvidersResponse) Reset() {
-	*x = ListProvidersResponse{}
-	mi := &file_io_proto_msgTypes[21]
+func (x *DeleteGlobalMemoryResponse) Reset() {
+	*x = DeleteGlobalMemoryResponse{}
+	mi := &file_io_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersResponse) String() string {
+func (x *DeleteGlobalMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersResponse) ProtoMessage() {}
+func (*DeleteGlobalMemoryResponse) ProtoMessage() {}
 
-func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_io_proto_msgTypes[21]
+func (x *DeleteGlobalMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_io_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,16 +7281,16 @@ func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
-func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_io_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteGlobalMemoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGlobalMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_io_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ListProvidersResponse) GetProviders() []*Provider {
+func (x *DeleteGlobalMemoryResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Providers
+		return x.Success
 	}
-	return nil
+	return false
 }
 
 var File_io_proto protoreflect.FileDescriptor
@@ -1287,10 +7308,24 @@ const file_io_proto_rawDesc = "" +
 	"\tMediaItem\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1b\n" +
-	"\tfile_name\x18\x03 \x01(\tR\bfileName\"I\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"L\n" +
+	"\bToolCall\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\targuments\x18\x03 \x01(\tR\targuments\"n\n" +
+	"\n" +
+	"ToolResult\x12\x17\n" +
+	"\acall_id\x18\x01 \x01(\tR\x06callId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x19\n" +
+	"\bis_error\x18\x04 \x01(\bR\aisError\"\xa7\x01\n" +
 	"\x0eMessageContent\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12#\n" +
-	"\x05media\x18\x02 \x03(\v2\r.io.MediaItemR\x05media\"\xd8\x01\n" +
+	"\x05media\x18\x02 \x03(\v2\r.io.MediaItemR\x05media\x12+\n" +
+	"\n" +
+	"tool_calls\x18\x03 \x03(\v2\f.io.ToolCallR\ttoolCalls\x12/\n" +
+	"\vtool_result\x18\x04 \x01(\v2\x0e.io.ToolResultR\n" +
+	"toolResult\"\xd8\x01\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x17\n" +
@@ -1298,21 +7333,29 @@ const file_io_proto_rawDesc = "" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12,\n" +
 	"\acontent\x18\x05 \x01(\v2\x12.io.MessageContentR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xde\x01\n" +
 	"\fConversation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa4\x01\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\x16personality_version_id\x18\x05 \x01(\tR\x14personalityVersionId\"\xeb\x02\n" +
 	"\bProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x01\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bbase_url\x18\x05 \x01(\tR\abaseUrl\x12\x19\n" +
+	"\bapi_mode\x18\x06 \x01(\tR\aapiMode\x12\x1e\n" +
+	"\vapi_key_env\x18\a \x01(\tR\tapiKeyEnv\x123\n" +
+	"\aheaders\x18\b \x03(\v2\x19.io.Provider.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x04\n" +
 	"\x05Model\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vprovider_id\x18\x02 \x01(\tR\n" +
@@ -1320,7 +7363,27 @@ const file_io_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\x02\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12*\n" +
+	"\x11provider_model_id\x18\x06 \x01(\tR\x0fproviderModelId\x12%\n" +
+	"\x0econtext_window\x18\a \x01(\x05R\rcontextWindow\x12*\n" +
+	"\x11max_output_tokens\x18\b \x01(\x05R\x0fmaxOutputTokens\x12'\n" +
+	"\x0fsupports_vision\x18\t \x01(\bR\x0esupportsVision\x12%\n" +
+	"\x0esupports_tools\x18\n" +
+	" \x01(\bR\rsupportsTools\x12-\n" +
+	"\x12supports_streaming\x18\v \x01(\bR\x11supportsStreaming\x12-\n" +
+	"\x12supports_reasoning\x18\f \x01(\bR\x11supportsReasoning\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x02\n" +
+	"\x10GenerationParams\x12%\n" +
+	"\vtemperature\x18\x01 \x01(\x01H\x00R\vtemperature\x88\x01\x01\x12\x18\n" +
+	"\x05top_p\x18\x02 \x01(\x01H\x01R\x04topP\x88\x01\x01\x12/\n" +
+	"\x11max_output_tokens\x18\x03 \x01(\x05H\x02R\x0fmaxOutputTokens\x88\x01\x01\x12)\n" +
+	"\x10reasoning_effort\x18\x04 \x01(\tR\x0freasoningEffort\x12%\n" +
+	"\x0estop_sequences\x18\x05 \x03(\tR\rstopSequences\x12)\n" +
+	"\x10provider_options\x18\x06 \x01(\tR\x0fproviderOptionsB\x0e\n" +
+	"\f_temperatureB\b\n" +
+	"\x06_top_pB\x14\n" +
+	"\x12_max_output_tokens\"\xb7\x03\n" +
 	"\bAIConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -1331,16 +7394,108 @@ const file_io_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
 	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"\x98\x01\n" +
+	"lastUsedAt\x12,\n" +
+	"\x06params\x18\b \x01(\v2\x14.io.GenerationParamsR\x06params\x12)\n" +
+	"\x10context_strategy\x18\t \x01(\tR\x0fcontextStrategy\x124\n" +
+	"\x16personality_version_id\x18\n" +
+	" \x01(\tR\x14personalityVersionId\"\xf9\x01\n" +
+	"\vPersonality\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\x06latest\x18\x04 \x01(\v2\x16.io.PersonalityVersionR\x06latest\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xec\x02\n" +
+	"\x12PersonalityVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0epersonality_id\x18\x02 \x01(\tR\rpersonalityId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12'\n" +
+	"\x0fprompt_template\x18\x05 \x01(\tR\x0epromptTemplate\x12\x1f\n" +
+	"\vstyle_rules\x18\x06 \x03(\tR\n" +
+	"styleRules\x12/\n" +
+	"\bexamples\x18\a \x03(\v2\x13.io.ExampleDialogueR\bexamples\x12;\n" +
+	"\x0edefault_params\x18\b \x01(\v2\x14.io.GenerationParamsR\rdefaultParams\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"C\n" +
+	"\x0fExampleDialogue\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1c\n" +
+	"\tassistant\x18\x02 \x01(\tR\tassistant\"\xee\x01\n" +
+	"\x06Memory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06global\x18\x02 \x01(\bR\x06global\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12,\n" +
+	"\x12source_message_ids\x18\x04 \x03(\tR\x10sourceMessageIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"}\n" +
+	"\bDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf3\x01\n" +
+	"\x13MessageSearchResult\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12+\n" +
+	"\x11conversation_name\x18\x03 \x01(\tR\x10conversationName\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x01\n" +
 	"\x12SendMessageRequest\x12,\n" +
 	"\acontent\x18\x01 \x01(\v2\x12.io.MessageContentR\acontent\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12'\n" +
-	"\x0fconversation_id\x18\x04 \x01(\tR\x0econversationId\"\xa8\x01\n" +
+	"\x0fconversation_id\x18\x04 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x05 \x01(\tR\tchannelId\x12\x1a\n" +
+	"\bfrontend\x18\x06 \x01(\tR\bfrontend\"r\n" +
+	"\x05Usage\x12!\n" +
+	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
+	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12!\n" +
+	"\ftotal_tokens\x18\x03 \x01(\x03R\vtotalTokens\"\xcb\x02\n" +
 	"\x13SendMessageResponse\x12.\n" +
 	"\fuser_message\x18\x01 \x01(\v2\v.io.MessageR\vuserMessage\x128\n" +
 	"\x11assistant_message\x18\x02 \x01(\v2\v.io.MessageR\x10assistantMessage\x12'\n" +
-	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\"3\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\x05usage\x18\x04 \x01(\v2\t.io.UsageR\x05usage\x12#\n" +
+	"\rfinish_reason\x18\x05 \x01(\tR\ffinishReason\x120\n" +
+	"\rtool_messages\x18\x06 \x03(\v2\v.io.MessageR\ftoolMessages\x12)\n" +
+	"\acontext\x18\a \x01(\v2\x0f.io.ContextInfoR\acontext\"\xab\x02\n" +
+	"\vContextInfo\x12#\n" +
+	"\rbudget_tokens\x18\x01 \x01(\x05R\fbudgetTokens\x12%\n" +
+	"\x0ehistory_tokens\x18\x02 \x01(\x05R\rhistoryTokens\x12)\n" +
+	"\x10dropped_messages\x18\x03 \x01(\x05R\x0fdroppedMessages\x12-\n" +
+	"\x12truncated_messages\x18\x04 \x01(\x05R\x11truncatedMessages\x12\x1a\n" +
+	"\bstrategy\x18\x05 \x01(\tR\bstrategy\x12/\n" +
+	"\x13summarized_messages\x18\x06 \x01(\x05R\x12summarizedMessages\x12)\n" +
+	"\x10retrieved_chunks\x18\a \x01(\x05R\x0fretrievedChunks\"\x1f\n" +
+	"\tTextDelta\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"w\n" +
+	"\x10ToolCallProgress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0farguments_delta\x18\x04 \x01(\tR\x0eargumentsDelta\"\xc5\x01\n" +
+	"\x13ToolApprovalRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12)\n" +
+	"\ttool_call\x18\x03 \x01(\v2\f.io.ToolCallR\btoolCall\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf8\x01\n" +
+	"\x19SendMessageStreamResponse\x12.\n" +
+	"\n" +
+	"text_delta\x18\x01 \x01(\v2\r.io.TextDeltaH\x00R\ttextDelta\x123\n" +
+	"\ttool_call\x18\x02 \x01(\v2\x14.io.ToolCallProgressH\x00R\btoolCall\x12-\n" +
+	"\x04done\x18\x03 \x01(\v2\x17.io.SendMessageResponseH\x00R\x04done\x12>\n" +
+	"\rtool_approval\x18\x04 \x01(\v2\x17.io.ToolApprovalRequestH\x00R\ftoolApprovalB\a\n" +
+	"\x05event\"3\n" +
 	"\x18ListConversationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"S\n" +
 	"\x19ListConversationsResponse\x126\n" +
@@ -1350,7 +7505,89 @@ const file_io_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"y\n" +
 	"\x18LoadConversationResponse\x124\n" +
 	"\fconversation\x18\x01 \x01(\v2\x10.io.ConversationR\fconversation\x12'\n" +
-	"\bmessages\x18\x02 \x03(\v2\v.io.MessageR\bmessages\"]\n" +
+	"\bmessages\x18\x02 \x03(\v2\v.io.MessageR\bmessages\"i\n" +
+	"\vActiveState\x12$\n" +
+	"\x06config\x18\x01 \x01(\v2\f.io.AIConfigR\x06config\x124\n" +
+	"\fconversation\x18\x02 \x01(\v2\x10.io.ConversationR\fconversation\"O\n" +
+	"\x15GetActiveStateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"?\n" +
+	"\x16GetActiveStateResponse\x12%\n" +
+	"\x05state\x18\x01 \x01(\v2\x0f.io.ActiveStateR\x05state\"|\n" +
+	"\x19ResumeConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\"R\n" +
+	"\x1aResumeConversationResponse\x124\n" +
+	"\fconversation\x18\x01 \x01(\v2\x10.io.ConversationR\fconversation\"X\n" +
+	"\x1eClearActiveConversationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\";\n" +
+	"\x1fClearActiveConversationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1a\n" +
+	"\x18ListPersonalitiesRequest\"R\n" +
+	"\x19ListPersonalitiesResponse\x125\n" +
+	"\rpersonalities\x18\x01 \x03(\v2\x0f.io.PersonalityR\rpersonalities\"\x9b\x01\n" +
+	"!SetConversationPersonalityRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
+	"\x16personality_version_id\x18\x03 \x01(\tR\x14personalityVersionId\"Z\n" +
+	"\"SetConversationPersonalityResponse\x124\n" +
+	"\fconversation\x18\x01 \x01(\v2\x10.io.ConversationR\fconversation\".\n" +
+	"\x13ListMemoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\">\n" +
+	"\x14ListMemoriesResponse\x12&\n" +
+	"\bmemories\x18\x01 \x03(\v2\n" +
+	".io.MemoryR\bmemories\"H\n" +
+	"\x13CreateMemoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\":\n" +
+	"\x14CreateMemoryResponse\x12\"\n" +
+	"\x06memory\x18\x01 \x01(\v2\n" +
+	".io.MemoryR\x06memory\"X\n" +
+	"\x13UpdateMemoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\":\n" +
+	"\x14UpdateMemoryResponse\x12\"\n" +
+	"\x06memory\x18\x01 \x01(\v2\n" +
+	".io.MemoryR\x06memory\">\n" +
+	"\x13DeleteMemoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"0\n" +
+	"\x14DeleteMemoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"^\n" +
+	"\x15UploadDocumentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"B\n" +
+	"\x16UploadDocumentResponse\x12(\n" +
+	"\bdocument\x18\x01 \x01(\v2\f.io.DocumentR\bdocument\"/\n" +
+	"\x14ListDocumentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"C\n" +
+	"\x15ListDocumentsResponse\x12*\n" +
+	"\tdocuments\x18\x01 \x03(\v2\f.io.DocumentR\tdocuments\"@\n" +
+	"\x15DeleteDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
+	"\x16DeleteDocumentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9c\x02\n" +
+	"\x15SearchMessagesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12'\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"l\n" +
+	"\x16SearchMessagesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.io.MessageSearchResultR\aresults\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"]\n" +
 	"\x19DeleteConversationRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"6\n" +
@@ -1358,24 +7595,268 @@ const file_io_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x16\n" +
 	"\x14ListAIConfigsRequest\"?\n" +
 	"\x15ListAIConfigsResponse\x12&\n" +
-	"\aconfigs\x18\x01 \x03(\v2\f.io.AIConfigR\aconfigs\"M\n" +
+	"\aconfigs\x18\x01 \x03(\v2\f.io.AIConfigR\aconfigs\"l\n" +
 	"\x15SwitchAIConfigRequest\x12\x1b\n" +
 	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"X\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\"X\n" +
 	"\x16SwitchAIConfigResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12$\n" +
-	"\x06config\x18\x02 \x01(\v2\f.io.AIConfigR\x06config\"\x16\n" +
+	"\x06config\x18\x02 \x01(\v2\f.io.AIConfigR\x06config\"i\n" +
+	"\x04Tool\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12+\n" +
+	"\x11requires_approval\x18\x03 \x01(\bR\x10requiresApproval\";\n" +
+	"\bToolRule\x12\x1b\n" +
+	"\ttool_name\x18\x01 \x01(\tR\btoolName\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\"h\n" +
+	"\x1bUpdateAIConfigParamsRequest\x12\x1b\n" +
+	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\x12,\n" +
+	"\x06params\x18\x02 \x01(\v2\x14.io.GenerationParamsR\x06params\"D\n" +
+	"\x1cUpdateAIConfigParamsResponse\x12$\n" +
+	"\x06config\x18\x01 \x01(\v2\f.io.AIConfigR\x06config\"6\n" +
+	"\x17GetAIConfigToolsRequest\x12\x1b\n" +
+	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\"\x7f\n" +
+	"\x18GetAIConfigToolsResponse\x12\x1f\n" +
+	"\vmcp_servers\x18\x01 \x03(\tR\n" +
+	"mcpServers\x12\"\n" +
+	"\x05rules\x18\x02 \x03(\v2\f.io.ToolRuleR\x05rules\x12\x1e\n" +
+	"\x05tools\x18\x03 \x03(\v2\b.io.ToolR\x05tools\"V\n" +
+	"\x16AttachMCPServerRequest\x12\x1b\n" +
+	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\x12\x1f\n" +
+	"\vserver_name\x18\x02 \x01(\tR\n" +
+	"serverName\"3\n" +
+	"\x17AttachMCPServerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\x16DetachMCPServerRequest\x12\x1b\n" +
+	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\x12\x1f\n" +
+	"\vserver_name\x18\x02 \x01(\tR\n" +
+	"serverName\"3\n" +
+	"\x17DetachMCPServerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x12SetToolRuleRequest\x12\x1b\n" +
+	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\x12 \n" +
+	"\x04rule\x18\x02 \x01(\v2\f.io.ToolRuleR\x04rule\"/\n" +
+	"\x13SetToolRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x15RemoveToolRuleRequest\x12\x1b\n" +
+	"\tconfig_id\x18\x01 \x01(\tR\bconfigId\x12\x1b\n" +
+	"\ttool_name\x18\x02 \x01(\tR\btoolName\"2\n" +
+	"\x16RemoveToolRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\x16ApproveToolCallRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\x17ApproveToolCallResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"i\n" +
+	"\x15RejectToolCallRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"2\n" +
+	"\x16RejectToolCallResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xff\x01\n" +
+	"\x0fMCPServerStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\ttransport\x18\x02 \x01(\tR\ttransport\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05tools\x18\x06 \x01(\x05R\x05tools\x12\x1c\n" +
+	"\tresources\x18\a \x01(\x05R\tresources\x12\x18\n" +
+	"\aprompts\x18\b \x01(\x05R\aprompts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\"\x17\n" +
+	"\x15ListMCPServersRequest\"G\n" +
+	"\x16ListMCPServersResponse\x12-\n" +
+	"\aservers\x18\x01 \x03(\v2\x13.io.MCPServerStatusR\aservers\"-\n" +
+	"\x17ConnectMCPServerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"G\n" +
+	"\x18ConnectMCPServerResponse\x12+\n" +
+	"\x06server\x18\x01 \x01(\v2\x13.io.MCPServerStatusR\x06server\"0\n" +
+	"\x1aDisconnectMCPServerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"\x1bDisconnectMCPServerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x17RestartMCPServerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"G\n" +
+	"\x18RestartMCPServerResponse\x12+\n" +
+	"\x06server\x18\x01 \x01(\v2\x13.io.MCPServerStatusR\x06server\"\x16\n" +
 	"\x14ListProvidersRequest\"C\n" +
 	"\x15ListProvidersResponse\x12*\n" +
-	"\tproviders\x18\x01 \x03(\v2\f.io.ProviderR\tproviders2\x96\x04\n" +
+	"\tproviders\x18\x01 \x03(\v2\f.io.ProviderR\tproviders\"\xed\x01\n" +
+	"\fProviderSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12\x1e\n" +
+	"\vapi_key_env\x18\x03 \x01(\tR\tapiKeyEnv\x127\n" +
+	"\aheaders\x18\x04 \x03(\v2\x1d.io.ProviderSpec.HeadersEntryR\aheaders\x12\x19\n" +
+	"\bapi_mode\x18\x05 \x01(\tR\aapiMode\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x15CreateProviderRequest\x12,\n" +
+	"\bprovider\x18\x01 \x01(\v2\x10.io.ProviderSpecR\bprovider\"B\n" +
+	"\x16CreateProviderResponse\x12(\n" +
+	"\bprovider\x18\x01 \x01(\v2\f.io.ProviderR\bprovider\"U\n" +
+	"\x15UpdateProviderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\bprovider\x18\x02 \x01(\v2\x10.io.ProviderSpecR\bprovider\"B\n" +
+	"\x16UpdateProviderResponse\x12(\n" +
+	"\bprovider\x18\x01 \x01(\v2\f.io.ProviderR\bprovider\"'\n" +
+	"\x15DeleteProviderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteProviderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xee\x02\n" +
+	"\tModelSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x11provider_model_id\x18\x02 \x01(\tR\x0fproviderModelId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
+	"\x0econtext_window\x18\x04 \x01(\x05R\rcontextWindow\x12*\n" +
+	"\x11max_output_tokens\x18\x05 \x01(\x05R\x0fmaxOutputTokens\x12'\n" +
+	"\x0fsupports_vision\x18\x06 \x01(\bR\x0esupportsVision\x12%\n" +
+	"\x0esupports_tools\x18\a \x01(\bR\rsupportsTools\x12-\n" +
+	"\x12supports_streaming\x18\b \x01(\bR\x11supportsStreaming\x12-\n" +
+	"\x12supports_reasoning\x18\t \x01(\bR\x11supportsReasoning\"4\n" +
+	"\x11ListModelsRequest\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\tR\n" +
+	"providerId\"7\n" +
+	"\x12ListModelsResponse\x12!\n" +
+	"\x06models\x18\x01 \x03(\v2\t.io.ModelR\x06models\"Z\n" +
+	"\x12CreateModelRequest\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\tR\n" +
+	"providerId\x12#\n" +
+	"\x05model\x18\x02 \x01(\v2\r.io.ModelSpecR\x05model\"6\n" +
+	"\x13CreateModelResponse\x12\x1f\n" +
+	"\x05model\x18\x01 \x01(\v2\t.io.ModelR\x05model\"I\n" +
+	"\x12UpdateModelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\x05model\x18\x02 \x01(\v2\r.io.ModelSpecR\x05model\"6\n" +
+	"\x13UpdateModelResponse\x12\x1f\n" +
+	"\x05model\x18\x01 \x01(\v2\t.io.ModelR\x05model\"$\n" +
+	"\x12DeleteModelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x13DeleteModelResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc3\x01\n" +
+	"\fAIConfigSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12#\n" +
+	"\rsystem_prompt\x18\x03 \x01(\tR\fsystemPrompt\x12)\n" +
+	"\x10context_strategy\x18\x04 \x01(\tR\x0fcontextStrategy\x124\n" +
+	"\x16personality_version_id\x18\x05 \x01(\tR\x14personalityVersionId\"A\n" +
+	"\x15CreateAIConfigRequest\x12(\n" +
+	"\x06config\x18\x01 \x01(\v2\x10.io.AIConfigSpecR\x06config\">\n" +
+	"\x16CreateAIConfigResponse\x12$\n" +
+	"\x06config\x18\x01 \x01(\v2\f.io.AIConfigR\x06config\"Q\n" +
+	"\x15UpdateAIConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x06config\x18\x02 \x01(\v2\x10.io.AIConfigSpecR\x06config\">\n" +
+	"\x16UpdateAIConfigResponse\x12$\n" +
+	"\x06config\x18\x01 \x01(\v2\f.io.AIConfigR\x06config\"'\n" +
+	"\x15DeleteAIConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteAIConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xff\x01\n" +
+	"\x0fPersonalitySpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x0fprompt_template\x18\x03 \x01(\tR\x0epromptTemplate\x12\x1f\n" +
+	"\vstyle_rules\x18\x04 \x03(\tR\n" +
+	"styleRules\x12/\n" +
+	"\bexamples\x18\x05 \x03(\v2\x13.io.ExampleDialogueR\bexamples\x12;\n" +
+	"\x0edefault_params\x18\x06 \x01(\v2\x14.io.GenerationParamsR\rdefaultParams\"Q\n" +
+	"\x18CreatePersonalityRequest\x125\n" +
+	"\vpersonality\x18\x01 \x01(\v2\x13.io.PersonalitySpecR\vpersonality\"N\n" +
+	"\x19CreatePersonalityResponse\x121\n" +
+	"\vpersonality\x18\x01 \x01(\v2\x0f.io.PersonalityR\vpersonality\"a\n" +
+	"\x18UpdatePersonalityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\vpersonality\x18\x02 \x01(\v2\x13.io.PersonalitySpecR\vpersonality\"N\n" +
+	"\x19UpdatePersonalityResponse\x121\n" +
+	"\vpersonality\x18\x01 \x01(\v2\x0f.io.PersonalityR\vpersonality\"*\n" +
+	"\x18DeletePersonalityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x19DeletePersonalityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\x1eListPersonalityVersionsRequest\x12%\n" +
+	"\x0epersonality_id\x18\x01 \x01(\tR\rpersonalityId\"U\n" +
+	"\x1fListPersonalityVersionsResponse\x122\n" +
+	"\bversions\x18\x01 \x03(\v2\x16.io.PersonalityVersionR\bversions\"\x1b\n" +
+	"\x19ListGlobalMemoriesRequest\"D\n" +
+	"\x1aListGlobalMemoriesResponse\x12&\n" +
+	"\bmemories\x18\x01 \x03(\v2\n" +
+	".io.MemoryR\bmemories\"5\n" +
+	"\x19CreateGlobalMemoryRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"@\n" +
+	"\x1aCreateGlobalMemoryResponse\x12\"\n" +
+	"\x06memory\x18\x01 \x01(\v2\n" +
+	".io.MemoryR\x06memory\"E\n" +
+	"\x19UpdateGlobalMemoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"@\n" +
+	"\x1aUpdateGlobalMemoryResponse\x12\"\n" +
+	"\x06memory\x18\x01 \x01(\v2\n" +
+	".io.MemoryR\x06memory\"+\n" +
+	"\x19DeleteGlobalMemoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x1aDeleteGlobalMemoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xff\x0e\n" +
 	"\tIOService\x12>\n" +
-	"\vSendMessage\x12\x16.io.SendMessageRequest\x1a\x17.io.SendMessageResponse\x12P\n" +
+	"\vSendMessage\x12\x16.io.SendMessageRequest\x1a\x17.io.SendMessageResponse\x12L\n" +
+	"\x11SendMessageStream\x12\x16.io.SendMessageRequest\x1a\x1d.io.SendMessageStreamResponse0\x01\x12P\n" +
 	"\x11ListConversations\x12\x1c.io.ListConversationsRequest\x1a\x1d.io.ListConversationsResponse\x12M\n" +
 	"\x10LoadConversation\x12\x1b.io.LoadConversationRequest\x1a\x1c.io.LoadConversationResponse\x12S\n" +
-	"\x12DeleteConversation\x12\x1d.io.DeleteConversationRequest\x1a\x1e.io.DeleteConversationResponse\x12D\n" +
+	"\x12DeleteConversation\x12\x1d.io.DeleteConversationRequest\x1a\x1e.io.DeleteConversationResponse\x12G\n" +
+	"\x0eSearchMessages\x12\x19.io.SearchMessagesRequest\x1a\x1a.io.SearchMessagesResponse\x12G\n" +
+	"\x0eGetActiveState\x12\x19.io.GetActiveStateRequest\x1a\x1a.io.GetActiveStateResponse\x12S\n" +
+	"\x12ResumeConversation\x12\x1d.io.ResumeConversationRequest\x1a\x1e.io.ResumeConversationResponse\x12b\n" +
+	"\x17ClearActiveConversation\x12\".io.ClearActiveConversationRequest\x1a#.io.ClearActiveConversationResponse\x12P\n" +
+	"\x11ListPersonalities\x12\x1c.io.ListPersonalitiesRequest\x1a\x1d.io.ListPersonalitiesResponse\x12k\n" +
+	"\x1aSetConversationPersonality\x12%.io.SetConversationPersonalityRequest\x1a&.io.SetConversationPersonalityResponse\x12A\n" +
+	"\fListMemories\x12\x17.io.ListMemoriesRequest\x1a\x18.io.ListMemoriesResponse\x12A\n" +
+	"\fCreateMemory\x12\x17.io.CreateMemoryRequest\x1a\x18.io.CreateMemoryResponse\x12A\n" +
+	"\fUpdateMemory\x12\x17.io.UpdateMemoryRequest\x1a\x18.io.UpdateMemoryResponse\x12A\n" +
+	"\fDeleteMemory\x12\x17.io.DeleteMemoryRequest\x1a\x18.io.DeleteMemoryResponse\x12G\n" +
+	"\x0eUploadDocument\x12\x19.io.UploadDocumentRequest\x1a\x1a.io.UploadDocumentResponse\x12D\n" +
+	"\rListDocuments\x12\x18.io.ListDocumentsRequest\x1a\x19.io.ListDocumentsResponse\x12G\n" +
+	"\x0eDeleteDocument\x12\x19.io.DeleteDocumentRequest\x1a\x1a.io.DeleteDocumentResponse\x12D\n" +
 	"\rListAIConfigs\x12\x18.io.ListAIConfigsRequest\x1a\x19.io.ListAIConfigsResponse\x12G\n" +
-	"\x0eSwitchAIConfig\x12\x19.io.SwitchAIConfigRequest\x1a\x1a.io.SwitchAIConfigResponse\x12D\n" +
-	"\rListProviders\x12\x18.io.ListProvidersRequest\x1a\x19.io.ListProvidersResponseB/Z-github.com/curator4/io/backend/internal/protob\x06proto3"
+	"\x0eSwitchAIConfig\x12\x19.io.SwitchAIConfigRequest\x1a\x1a.io.SwitchAIConfigResponse\x12M\n" +
+	"\x10GetAIConfigTools\x12\x1b.io.GetAIConfigToolsRequest\x1a\x1c.io.GetAIConfigToolsResponse\x12J\n" +
+	"\x0fApproveToolCall\x12\x1a.io.ApproveToolCallRequest\x1a\x1b.io.ApproveToolCallResponse\x12G\n" +
+	"\x0eRejectToolCall\x12\x19.io.RejectToolCallRequest\x1a\x1a.io.RejectToolCallResponse\x12G\n" +
+	"\x0eListMCPServers\x12\x19.io.ListMCPServersRequest\x1a\x1a.io.ListMCPServersResponse\x12D\n" +
+	"\rListProviders\x12\x18.io.ListProvidersRequest\x1a\x19.io.ListProvidersResponse2\xe1\x0f\n" +
+	"\fAdminService\x12G\n" +
+	"\x0eCreateProvider\x12\x19.io.CreateProviderRequest\x1a\x1a.io.CreateProviderResponse\x12G\n" +
+	"\x0eUpdateProvider\x12\x19.io.UpdateProviderRequest\x1a\x1a.io.UpdateProviderResponse\x12G\n" +
+	"\x0eDeleteProvider\x12\x19.io.DeleteProviderRequest\x1a\x1a.io.DeleteProviderResponse\x12;\n" +
+	"\n" +
+	"ListModels\x12\x15.io.ListModelsRequest\x1a\x16.io.ListModelsResponse\x12>\n" +
+	"\vCreateModel\x12\x16.io.CreateModelRequest\x1a\x17.io.CreateModelResponse\x12>\n" +
+	"\vUpdateModel\x12\x16.io.UpdateModelRequest\x1a\x17.io.UpdateModelResponse\x12>\n" +
+	"\vDeleteModel\x12\x16.io.DeleteModelRequest\x1a\x17.io.DeleteModelResponse\x12G\n" +
+	"\x0eCreateAIConfig\x12\x19.io.CreateAIConfigRequest\x1a\x1a.io.CreateAIConfigResponse\x12G\n" +
+	"\x0eUpdateAIConfig\x12\x19.io.UpdateAIConfigRequest\x1a\x1a.io.UpdateAIConfigResponse\x12G\n" +
+	"\x0eDeleteAIConfig\x12\x19.io.DeleteAIConfigRequest\x1a\x1a.io.DeleteAIConfigResponse\x12Y\n" +
+	"\x14UpdateAIConfigParams\x12\x1f.io.UpdateAIConfigParamsRequest\x1a .io.UpdateAIConfigParamsResponse\x12J\n" +
+	"\x0fAttachMCPServer\x12\x1a.io.AttachMCPServerRequest\x1a\x1b.io.AttachMCPServerResponse\x12J\n" +
+	"\x0fDetachMCPServer\x12\x1a.io.DetachMCPServerRequest\x1a\x1b.io.DetachMCPServerResponse\x12>\n" +
+	"\vSetToolRule\x12\x16.io.SetToolRuleRequest\x1a\x17.io.SetToolRuleResponse\x12G\n" +
+	"\x0eRemoveToolRule\x12\x19.io.RemoveToolRuleRequest\x1a\x1a.io.RemoveToolRuleResponse\x12M\n" +
+	"\x10ConnectMCPServer\x12\x1b.io.ConnectMCPServerRequest\x1a\x1c.io.ConnectMCPServerResponse\x12V\n" +
+	"\x13DisconnectMCPServer\x12\x1e.io.DisconnectMCPServerRequest\x1a\x1f.io.DisconnectMCPServerResponse\x12M\n" +
+	"\x10RestartMCPServer\x12\x1b.io.RestartMCPServerRequest\x1a\x1c.io.RestartMCPServerResponse\x12P\n" +
+	"\x11CreatePersonality\x12\x1c.io.CreatePersonalityRequest\x1a\x1d.io.CreatePersonalityResponse\x12P\n" +
+	"\x11UpdatePersonality\x12\x1c.io.UpdatePersonalityRequest\x1a\x1d.io.UpdatePersonalityResponse\x12P\n" +
+	"\x11DeletePersonality\x12\x1c.io.DeletePersonalityRequest\x1a\x1d.io.DeletePersonalityResponse\x12b\n" +
+	"\x17ListPersonalityVersions\x12\".io.ListPersonalityVersionsRequest\x1a#.io.ListPersonalityVersionsResponse\x12S\n" +
+	"\x12ListGlobalMemories\x12\x1d.io.ListGlobalMemoriesRequest\x1a\x1e.io.ListGlobalMemoriesResponse\x12S\n" +
+	"\x12CreateGlobalMemory\x12\x1d.io.CreateGlobalMemoryRequest\x1a\x1e.io.CreateGlobalMemoryResponse\x12S\n" +
+	"\x12UpdateGlobalMemory\x12\x1d.io.UpdateGlobalMemoryRequest\x1a\x1e.io.UpdateGlobalMemoryResponse\x12S\n" +
+	"\x12DeleteGlobalMemory\x12\x1d.io.DeleteGlobalMemoryRequest\x1a\x1e.io.DeleteGlobalMemoryResponseB/Z-github.com/curator4/io/backend/internal/protob\x06proto3"
 
 var (
 	file_io_proto_rawDescOnce sync.Once
@@ -1389,75 +7870,344 @@ func file_io_proto_rawDescGZIP() []byte {
 	return file_io_proto_rawDescData
 }
 
-var file_io_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_io_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
 var file_io_proto_goTypes = []any{
-	(*User)(nil),                       // 0: io.User
-	(*MediaItem)(nil),                  // 1: io.MediaItem
-	(*MessageContent)(nil),             // 2: io.MessageContent
-	(*Message)(nil),                    // 3: io.Message
-	(*Conversation)(nil),               // 4: io.Conversation
-	(*Provider)(nil),                   // 5: io.Provider
-	(*Model)(nil),                      // 6: io.Model
-	(*AIConfig)(nil),                   // 7: io.AIConfig
-	(*SendMessageRequest)(nil),         // 8: io.SendMessageRequest
-	(*SendMessageResponse)(nil),        // 9: io.SendMessageResponse
-	(*ListConversationsRequest)(nil),   // 10: io.ListConversationsRequest
-	(*ListConversationsResponse)(nil),  // 11: io.ListConversationsResponse
-	(*LoadConversationRequest)(nil),    // 12: io.LoadConversationRequest
-	(*LoadConversationResponse)(nil),   // 13: io.LoadConversationResponse
-	(*DeleteConversationRequest)(nil),  // 14: io.DeleteConversationRequest
-	(*DeleteConversationResponse)(nil), // 15: io.DeleteConversationResponse
-	(*ListAIConfigsRequest)(nil),       // 16: io.ListAIConfigsRequest
-	(*ListAIConfigsResponse)(nil),      // 17: io.ListAIConfigsResponse
-	(*SwitchAIConfigRequest)(nil),      // 18: io.SwitchAIConfigRequest
-	(*SwitchAIConfigResponse)(nil),     // 19: io.SwitchAIConfigResponse
-	(*ListProvidersRequest)(nil),       // 20: io.ListProvidersRequest
-	(*ListProvidersResponse)(nil),      // 21: io.ListProvidersResponse
-	(*timestamppb.Timestamp)(nil),      // 22: google.protobuf.Timestamp
+	(*User)(nil),                               // 0: io.User
+	(*MediaItem)(nil),                          // 1: io.MediaItem
+	(*ToolCall)(nil),                           // 2: io.ToolCall
+	(*ToolResult)(nil),                         // 3: io.ToolResult
+	(*MessageContent)(nil),                     // 4: io.MessageContent
+	(*Message)(nil),                            // 5: io.Message
+	(*Conversation)(nil),                       // 6: io.Conversation
+	(*Provider)(nil),                           // 7: io.Provider
+	(*Model)(nil),                              // 8: io.Model
+	(*GenerationParams)(nil),                   // 9: io.GenerationParams
+	(*AIConfig)(nil),                           // 10: io.AIConfig
+	(*Personality)(nil),                        // 11: io.Personality
+	(*PersonalityVersion)(nil),                 // 12: io.PersonalityVersion
+	(*ExampleDialogue)(nil),                    // 13: io.ExampleDialogue
+	(*Memory)(nil),                             // 14: io.Memory
+	(*Document)(nil),                           // 15: io.Document
+	(*MessageSearchResult)(nil),                // 16: io.MessageSearchResult
+	(*SendMessageRequest)(nil),                 // 17: io.SendMessageRequest
+	(*Usage)(nil),                              // 18: io.Usage
+	(*SendMessageResponse)(nil),                // 19: io.SendMessageResponse
+	(*ContextInfo)(nil),                        // 20: io.ContextInfo
+	(*TextDelta)(nil),                          // 21: io.TextDelta
+	(*ToolCallProgress)(nil),                   // 22: io.ToolCallProgress
+	(*ToolApprovalRequest)(nil),                // 23: io.ToolApprovalRequest
+	(*SendMessageStreamResponse)(nil),          // 24: io.SendMessageStreamResponse
+	(*ListConversationsRequest)(nil),           // 25: io.ListConversationsRequest
+	(*ListConversationsResponse)(nil),          // 26: io.ListConversationsResponse
+	(*LoadConversationRequest)(nil),            // 27: io.LoadConversationRequest
+	(*LoadConversationResponse)(nil),           // 28: io.LoadConversationResponse
+	(*ActiveState)(nil),                        // 29: io.ActiveState
+	(*GetActiveStateRequest)(nil),              // 30: io.GetActiveStateRequest
+	(*GetActiveStateResponse)(nil),             // 31: io.GetActiveStateResponse
+	(*ResumeConversationRequest)(nil),          // 32: io.ResumeConversationRequest
+	(*ResumeConversationResponse)(nil),         // 33: io.ResumeConversationResponse
+	(*ClearActiveConversationRequest)(nil),     // 34: io.ClearActiveConversationRequest
+	(*ClearActiveConversationResponse)(nil),    // 35: io.ClearActiveConversationResponse
+	(*ListPersonalitiesRequest)(nil),           // 36: io.ListPersonalitiesRequest
+	(*ListPersonalitiesResponse)(nil),          // 37: io.ListPersonalitiesResponse
+	(*SetConversationPersonalityRequest)(nil),  // 38: io.SetConversationPersonalityRequest
+	(*SetConversationPersonalityResponse)(nil), // 39: io.SetConversationPersonalityResponse
+	(*ListMemoriesRequest)(nil),                // 40: io.ListMemoriesRequest
+	(*ListMemoriesResponse)(nil),               // 41: io.ListMemoriesResponse
+	(*CreateMemoryRequest)(nil),                // 42: io.CreateMemoryRequest
+	(*CreateMemoryResponse)(nil),               // 43: io.CreateMemoryResponse
+	(*UpdateMemoryRequest)(nil),                // 44: io.UpdateMemoryRequest
+	(*UpdateMemoryResponse)(nil),               // 45: io.UpdateMemoryResponse
+	(*DeleteMemoryRequest)(nil),                // 46: io.DeleteMemoryRequest
+	(*DeleteMemoryResponse)(nil),               // 47: io.DeleteMemoryResponse
+	(*UploadDocumentRequest)(nil),              // 48: io.UploadDocumentRequest
+	(*UploadDocumentResponse)(nil),             // 49: io.UploadDocumentResponse
+	(*ListDocumentsRequest)(nil),               // 50: io.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),              // 51: io.ListDocumentsResponse
+	(*DeleteDocumentRequest)(nil),              // 52: io.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),             // 53: io.DeleteDocumentResponse
+	(*SearchMessagesRequest)(nil),              // 54: io.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),             // 55: io.SearchMessagesResponse
+	(*DeleteConversationRequest)(nil),          // 56: io.DeleteConversationRequest
+	(*DeleteConversationResponse)(nil),         // 57: io.DeleteConversationResponse
+	(*ListAIConfigsRequest)(nil),               // 58: io.ListAIConfigsRequest
+	(*ListAIConfigsResponse)(nil),              // 59: io.ListAIConfigsResponse
+	(*SwitchAIConfigRequest)(nil),              // 60: io.SwitchAIConfigRequest
+	(*SwitchAIConfigResponse)(nil),             // 61: io.SwitchAIConfigResponse
+	(*Tool)(nil),                               // 62: io.Tool
+	(*ToolRule)(nil),                           // 63: io.ToolRule
+	(*UpdateAIConfigParamsRequest)(nil),        // 64: io.UpdateAIConfigParamsRequest
+	(*UpdateAIConfigParamsResponse)(nil),       // 65: io.UpdateAIConfigParamsResponse
+	(*GetAIConfigToolsRequest)(nil),            // 66: io.GetAIConfigToolsRequest
+	(*GetAIConfigToolsResponse)(nil),           // 67: io.GetAIConfigToolsResponse
+	(*AttachMCPServerRequest)(nil),             // 68: io.AttachMCPServerRequest
+	(*AttachMCPServerResponse)(nil),            // 69: io.AttachMCPServerResponse
+	(*DetachMCPServerRequest)(nil),             // 70: io.DetachMCPServerRequest
+	(*DetachMCPServerResponse)(nil),            // 71: io.DetachMCPServerResponse
+	(*SetToolRuleRequest)(nil),                 // 72: io.SetToolRuleRequest
+	(*SetToolRuleResponse)(nil),                // 73: io.SetToolRuleResponse
+	(*RemoveToolRuleRequest)(nil),              // 74: io.RemoveToolRuleRequest
+	(*RemoveToolRuleResponse)(nil),             // 75: io.RemoveToolRuleResponse
+	(*ApproveToolCallRequest)(nil),             // 76: io.ApproveToolCallRequest
+	(*ApproveToolCallResponse)(nil),            // 77: io.ApproveToolCallResponse
+	(*RejectToolCallRequest)(nil),              // 78: io.RejectToolCallRequest
+	(*RejectToolCallResponse)(nil),             // 79: io.RejectToolCallResponse
+	(*MCPServerStatus)(nil),                    // 80: io.MCPServerStatus
+	(*ListMCPServersRequest)(nil),              // 81: io.ListMCPServersRequest
+	(*ListMCPServersResponse)(nil),             // 82: io.ListMCPServersResponse
+	(*ConnectMCPServerRequest)(nil),            // 83: io.ConnectMCPServerRequest
+	(*ConnectMCPServerResponse)(nil),           // 84: io.ConnectMCPServerResponse
+	(*DisconnectMCPServerRequest)(nil),         // 85: io.DisconnectMCPServerRequest
+	(*DisconnectMCPServerResponse)(nil),        // 86: io.DisconnectMCPServerResponse
+	(*RestartMCPServerRequest)(nil),            // 87: io.RestartMCPServerRequest
+	(*RestartMCPServerResponse)(nil),           // 88: io.RestartMCPServerResponse
+	(*ListProvidersRequest)(nil),               // 89: io.ListProvidersRequest
+	(*ListProvidersResponse)(nil),              // 90: io.ListProvidersResponse
+	(*ProviderSpec)(nil),                       // 91: io.ProviderSpec
+	(*CreateProviderRequest)(nil),              // 92: io.CreateProviderRequest
+	(*CreateProviderResponse)(nil),             // 93: io.CreateProviderResponse
+	(*UpdateProviderRequest)(nil),              // 94: io.UpdateProviderRequest
+	(*UpdateProviderResponse)(nil),             // 95: io.UpdateProviderResponse
+	(*DeleteProviderRequest)(nil),              // 96: io.DeleteProviderRequest
+	(*DeleteProviderResponse)(nil),             // 97: io.DeleteProviderResponse
+	(*ModelSpec)(nil),                          // 98: io.ModelSpec
+	(*ListModelsRequest)(nil),                  // 99: io.ListModelsRequest
+	(*ListModelsResponse)(nil),                 // 100: io.ListModelsResponse
+	(*CreateModelRequest)(nil),                 // 101: io.CreateModelRequest
+	(*CreateModelResponse)(nil),                // 102: io.CreateModelResponse
+	(*UpdateModelRequest)(nil),                 // 103: io.UpdateModelRequest
+	(*UpdateModelResponse)(nil),                // 104: io.UpdateModelResponse
+	(*DeleteModelRequest)(nil),                 // 105: io.DeleteModelRequest
+	(*DeleteModelResponse)(nil),                // 106: io.DeleteModelResponse
+	(*AIConfigSpec)(nil),                       // 107: io.AIConfigSpec
+	(*CreateAIConfigRequest)(nil),              // 108: io.CreateAIConfigRequest
+	(*CreateAIConfigResponse)(nil),             // 109: io.CreateAIConfigResponse
+	(*UpdateAIConfigRequest)(nil),              // 110: io.UpdateAIConfigRequest
+	(*UpdateAIConfigResponse)(nil),             // 111: io.UpdateAIConfigResponse
+	(*DeleteAIConfigRequest)(nil),              // 112: io.DeleteAIConfigRequest
+	(*DeleteAIConfigResponse)(nil),             // 113: io.DeleteAIConfigResponse
+	(*PersonalitySpec)(nil),                    // 114: io.PersonalitySpec
+	(*CreatePersonalityRequest)(nil),           // 115: io.CreatePersonalityRequest
+	(*CreatePersonalityResponse)(nil),          // 116: io.CreatePersonalityResponse
+	(*UpdatePersonalityRequest)(nil),           // 117: io.UpdatePersonalityRequest
+	(*UpdatePersonalityResponse)(nil),          // 118: io.UpdatePersonalityResponse
+	(*DeletePersonalityRequest)(nil),           // 119: io.DeletePersonalityRequest
+	(*DeletePersonalityResponse)(nil),          // 120: io.DeletePersonalityResponse
+	(*ListPersonalityVersionsRequest)(nil),     // 121: io.ListPersonalityVersionsRequest
+	(*ListPersonalityVersionsResponse)(nil),    // 122: io.ListPersonalityVersionsResponse
+	(*ListGlobalMemoriesRequest)(nil),          // 123: io.ListGlobalMemoriesRequest
+	(*ListGlobalMemoriesResponse)(nil),         // 124: io.ListGlobalMemoriesResponse
+	(*CreateGlobalMemoryRequest)(nil),          // 125: io.CreateGlobalMemoryRequest
+	(*CreateGlobalMemoryResponse)(nil),         // 126: io.CreateGlobalMemoryResponse
+	(*UpdateGlobalMemoryRequest)(nil),          // 127: io.UpdateGlobalMemoryRequest
+	(*UpdateGlobalMemoryResponse)(nil),         // 128: io.UpdateGlobalMemoryResponse
+	(*DeleteGlobalMemoryRequest)(nil),          // 129: io.DeleteGlobalMemoryRequest
+	(*DeleteGlobalMemoryResponse)(nil),         // 130: io.DeleteGlobalMemoryResponse
+	nil,                                        // 131: io.Provider.HeadersEntry
+	nil,                                        // 132: io.ProviderSpec.HeadersEntry
+	(*timestamppb.Timestamp)(nil),              // 133: google.protobuf.Timestamp
 }
 var file_io_proto_depIdxs = []int32{
-	22, // 0: io.User.created_at:type_name -> google.protobuf.Timestamp
-	22, // 1: io.User.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: io.MessageContent.media:type_name -> io.MediaItem
-	2,  // 3: io.Message.content:type_name -> io.MessageContent
-	22, // 4: io.Message.created_at:type_name -> google.protobuf.Timestamp
-	22, // 5: io.Conversation.created_at:type_name -> google.protobuf.Timestamp
-	22, // 6: io.Conversation.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 7: io.Provider.created_at:type_name -> google.protobuf.Timestamp
-	22, // 8: io.Provider.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 9: io.Model.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 10: io.AIConfig.model:type_name -> io.Model
-	22, // 11: io.AIConfig.created_at:type_name -> google.protobuf.Timestamp
-	22, // 12: io.AIConfig.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 13: io.AIConfig.last_used_at:type_name -> google.protobuf.Timestamp
-	2,  // 14: io.SendMessageRequest.content:type_name -> io.MessageContent
-	3,  // 15: io.SendMessageResponse.user_message:type_name -> io.Message
-	3,  // 16: io.SendMessageResponse.assistant_message:type_name -> io.Message
-	4,  // 17: io.ListConversationsResponse.conversations:type_name -> io.Conversation
-	4,  // 18: io.LoadConversationResponse.conversation:type_name -> io.Conversation
-	3,  // 19: io.LoadConversationResponse.messages:type_name -> io.Message
-	7,  // 20: io.ListAIConfigsResponse.configs:type_name -> io.AIConfig
-	7,  // 21: io.SwitchAIConfigResponse.config:type_name -> io.AIConfig
-	5,  // 22: io.ListProvidersResponse.providers:type_name -> io.Provider
-	8,  // 23: io.IOService.SendMessage:input_type -> io.SendMessageRequest
-	10, // 24: io.IOService.ListConversations:input_type -> io.ListConversationsRequest
-	12, // 25: io.IOService.LoadConversation:input_type -> io.LoadConversationRequest
-	14, // 26: io.IOService.DeleteConversation:input_type -> io.DeleteConversationRequest
-	16, // 27: io.IOService.ListAIConfigs:input_type -> io.ListAIConfigsRequest
-	18, // 28: io.IOService.SwitchAIConfig:input_type -> io.SwitchAIConfigRequest
-	20, // 29: io.IOService.ListProviders:input_type -> io.ListProvidersRequest
-	9,  // 30: io.IOService.SendMessage:output_type -> io.SendMessageResponse
-	11, // 31: io.IOService.ListConversations:output_type -> io.ListConversationsResponse
-	13, // 32: io.IOService.LoadConversation:output_type -> io.LoadConversationResponse
-	15, // 33: io.IOService.DeleteConversation:output_type -> io.DeleteConversationResponse
-	17, // 34: io.IOService.ListAIConfigs:output_type -> io.ListAIConfigsResponse
-	19, // 35: io.IOService.SwitchAIConfig:output_type -> io.SwitchAIConfigResponse
-	21, // 36: io.IOService.ListProviders:output_type -> io.ListProvidersResponse
-	30, // [30:37] is the sub-list for method output_type
-	23, // [23:30] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	133, // 0: io.User.created_at:type_name -> google.protobuf.Timestamp
+	133, // 1: io.User.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 2: io.MessageContent.media:type_name -> io.MediaItem
+	2,   // 3: io.MessageContent.tool_calls:type_name -> io.ToolCall
+	3,   // 4: io.MessageContent.tool_result:type_name -> io.ToolResult
+	4,   // 5: io.Message.content:type_name -> io.MessageContent
+	133, // 6: io.Message.created_at:type_name -> google.protobuf.Timestamp
+	133, // 7: io.Conversation.created_at:type_name -> google.protobuf.Timestamp
+	133, // 8: io.Conversation.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 9: io.Provider.created_at:type_name -> google.protobuf.Timestamp
+	133, // 10: io.Provider.updated_at:type_name -> google.protobuf.Timestamp
+	131, // 11: io.Provider.headers:type_name -> io.Provider.HeadersEntry
+	133, // 12: io.Model.created_at:type_name -> google.protobuf.Timestamp
+	133, // 13: io.Model.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 14: io.AIConfig.model:type_name -> io.Model
+	133, // 15: io.AIConfig.created_at:type_name -> google.protobuf.Timestamp
+	133, // 16: io.AIConfig.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 17: io.AIConfig.last_used_at:type_name -> google.protobuf.Timestamp
+	9,   // 18: io.AIConfig.params:type_name -> io.GenerationParams
+	12,  // 19: io.Personality.latest:type_name -> io.PersonalityVersion
+	133, // 20: io.Personality.created_at:type_name -> google.protobuf.Timestamp
+	133, // 21: io.Personality.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 22: io.PersonalityVersion.examples:type_name -> io.ExampleDialogue
+	9,   // 23: io.PersonalityVersion.default_params:type_name -> io.GenerationParams
+	133, // 24: io.PersonalityVersion.created_at:type_name -> google.protobuf.Timestamp
+	133, // 25: io.Memory.created_at:type_name -> google.protobuf.Timestamp
+	133, // 26: io.Memory.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 27: io.Document.created_at:type_name -> google.protobuf.Timestamp
+	133, // 28: io.MessageSearchResult.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 29: io.SendMessageRequest.content:type_name -> io.MessageContent
+	5,   // 30: io.SendMessageResponse.user_message:type_name -> io.Message
+	5,   // 31: io.SendMessageResponse.assistant_message:type_name -> io.Message
+	18,  // 32: io.SendMessageResponse.usage:type_name -> io.Usage
+	5,   // 33: io.SendMessageResponse.tool_messages:type_name -> io.Message
+	20,  // 34: io.SendMessageResponse.context:type_name -> io.ContextInfo
+	2,   // 35: io.ToolApprovalRequest.tool_call:type_name -> io.ToolCall
+	133, // 36: io.ToolApprovalRequest.expires_at:type_name -> google.protobuf.Timestamp
+	21,  // 37: io.SendMessageStreamResponse.text_delta:type_name -> io.TextDelta
+	22,  // 38: io.SendMessageStreamResponse.tool_call:type_name -> io.ToolCallProgress
+	19,  // 39: io.SendMessageStreamResponse.done:type_name -> io.SendMessageResponse
+	23,  // 40: io.SendMessageStreamResponse.tool_approval:type_name -> io.ToolApprovalRequest
+	6,   // 41: io.ListConversationsResponse.conversations:type_name -> io.Conversation
+	6,   // 42: io.LoadConversationResponse.conversation:type_name -> io.Conversation
+	5,   // 43: io.LoadConversationResponse.messages:type_name -> io.Message
+	10,  // 44: io.ActiveState.config:type_name -> io.AIConfig
+	6,   // 45: io.ActiveState.conversation:type_name -> io.Conversation
+	29,  // 46: io.GetActiveStateResponse.state:type_name -> io.ActiveState
+	6,   // 47: io.ResumeConversationResponse.conversation:type_name -> io.Conversation
+	11,  // 48: io.ListPersonalitiesResponse.personalities:type_name -> io.Personality
+	6,   // 49: io.SetConversationPersonalityResponse.conversation:type_name -> io.Conversation
+	14,  // 50: io.ListMemoriesResponse.memories:type_name -> io.Memory
+	14,  // 51: io.CreateMemoryResponse.memory:type_name -> io.Memory
+	14,  // 52: io.UpdateMemoryResponse.memory:type_name -> io.Memory
+	15,  // 53: io.UploadDocumentResponse.document:type_name -> io.Document
+	15,  // 54: io.ListDocumentsResponse.documents:type_name -> io.Document
+	133, // 55: io.SearchMessagesRequest.since:type_name -> google.protobuf.Timestamp
+	133, // 56: io.SearchMessagesRequest.until:type_name -> google.protobuf.Timestamp
+	16,  // 57: io.SearchMessagesResponse.results:type_name -> io.MessageSearchResult
+	10,  // 58: io.ListAIConfigsResponse.configs:type_name -> io.AIConfig
+	10,  // 59: io.SwitchAIConfigResponse.config:type_name -> io.AIConfig
+	9,   // 60: io.UpdateAIConfigParamsRequest.params:type_name -> io.GenerationParams
+	10,  // 61: io.UpdateAIConfigParamsResponse.config:type_name -> io.AIConfig
+	63,  // 62: io.GetAIConfigToolsResponse.rules:type_name -> io.ToolRule
+	62,  // 63: io.GetAIConfigToolsResponse.tools:type_name -> io.Tool
+	63,  // 64: io.SetToolRuleRequest.rule:type_name -> io.ToolRule
+	80,  // 65: io.ListMCPServersResponse.servers:type_name -> io.MCPServerStatus
+	80,  // 66: io.ConnectMCPServerResponse.server:type_name -> io.MCPServerStatus
+	80,  // 67: io.RestartMCPServerResponse.server:type_name -> io.MCPServerStatus
+	7,   // 68: io.ListProvidersResponse.providers:type_name -> io.Provider
+	132, // 69: io.ProviderSpec.headers:type_name -> io.ProviderSpec.HeadersEntry
+	91,  // 70: io.CreateProviderRequest.provider:type_name -> io.ProviderSpec
+	7,   // 71: io.CreateProviderResponse.provider:type_name -> io.Provider
+	91,  // 72: io.UpdateProviderRequest.provider:type_name -> io.ProviderSpec
+	7,   // 73: io.UpdateProviderResponse.provider:type_name -> io.Provider
+	8,   // 74: io.ListModelsResponse.models:type_name -> io.Model
+	98,  // 75: io.CreateModelRequest.model:type_name -> io.ModelSpec
+	8,   // 76: io.CreateModelResponse.model:type_name -> io.Model
+	98,  // 77: io.UpdateModelRequest.model:type_name -> io.ModelSpec
+	8,   // 78: io.UpdateModelResponse.model:type_name -> io.Model
+	107, // 79: io.CreateAIConfigRequest.config:type_name -> io.AIConfigSpec
+	10,  // 80: io.CreateAIConfigResponse.config:type_name -> io.AIConfig
+	107, // 81: io.UpdateAIConfigRequest.config:type_name -> io.AIConfigSpec
+	10,  // 82: io.UpdateAIConfigResponse.config:type_name -> io.AIConfig
+	13,  // 83: io.PersonalitySpec.examples:type_name -> io.ExampleDialogue
+	9,   // 84: io.PersonalitySpec.default_params:type_name -> io.GenerationParams
+	114, // 85: io.CreatePersonalityRequest.personality:type_name -> io.PersonalitySpec
+	11,  // 86: io.CreatePersonalityResponse.personality:type_name -> io.Personality
+	114, // 87: io.UpdatePersonalityRequest.personality:type_name -> io.PersonalitySpec
+	11,  // 88: io.UpdatePersonalityResponse.personality:type_name -> io.Personality
+	12,  // 89: io.ListPersonalityVersionsResponse.versions:type_name -> io.PersonalityVersion
+	14,  // 90: io.ListGlobalMemoriesResponse.memories:type_name -> io.Memory
+	14,  // 91: io.CreateGlobalMemoryResponse.memory:type_name -> io.Memory
+	14,  // 92: io.UpdateGlobalMemoryResponse.memory:type_name -> io.Memory
+	17,  // 93: io.IOService.SendMessage:input_type -> io.SendMessageRequest
+	17,  // 94: io.IOService.SendMessageStream:input_type -> io.SendMessageRequest
+	25,  // 95: io.IOService.ListConversations:input_type -> io.ListConversationsRequest
+	27,  // 96: io.IOService.LoadConversation:input_type -> io.LoadConversationRequest
+	56,  // 97: io.IOService.DeleteConversation:input_type -> io.DeleteConversationRequest
+	54,  // 98: io.IOService.SearchMessages:input_type -> io.SearchMessagesRequest
+	30,  // 99: io.IOService.GetActiveState:input_type -> io.GetActiveStateRequest
+	32,  // 100: io.IOService.ResumeConversation:input_type -> io.ResumeConversationRequest
+	34,  // 101: io.IOService.ClearActiveConversation:input_type -> io.ClearActiveConversationRequest
+	36,  // 102: io.IOService.ListPersonalities:input_type -> io.ListPersonalitiesRequest
+	38,  // 103: io.IOService.SetConversationPersonality:input_type -> io.SetConversationPersonalityRequest
+	40,  // 104: io.IOService.ListMemories:input_type -> io.ListMemoriesRequest
+	42,  // 105: io.IOService.CreateMemory:input_type -> io.CreateMemoryRequest
+	44,  // 106: io.IOService.UpdateMemory:input_type -> io.UpdateMemoryRequest
+	46,  // 107: io.IOService.DeleteMemory:input_type -> io.DeleteMemoryRequest
+	48,  // 108: io.IOService.UploadDocument:input_type -> io.UploadDocumentRequest
+	50,  // 109: io.IOService.ListDocuments:input_type -> io.ListDocumentsRequest
+	52,  // 110: io.IOService.DeleteDocument:input_type -> io.DeleteDocumentRequest
+	58,  // 111: io.IOService.ListAIConfigs:input_type -> io.ListAIConfigsRequest
+	60,  // 112: io.IOService.SwitchAIConfig:input_type -> io.SwitchAIConfigRequest
+	66,  // 113: io.IOService.GetAIConfigTools:input_type -> io.GetAIConfigToolsRequest
+	76,  // 114: io.IOService.ApproveToolCall:input_type -> io.ApproveToolCallRequest
+	78,  // 115: io.IOService.RejectToolCall:input_type -> io.RejectToolCallRequest
+	81,  // 116: io.IOService.ListMCPServers:input_type -> io.ListMCPServersRequest
+	89,  // 117: io.IOService.ListProviders:input_type -> io.ListProvidersRequest
+	92,  // 118: io.AdminService.CreateProvider:input_type -> io.CreateProviderRequest
+	94,  // 119: io.AdminService.UpdateProvider:input_type -> io.UpdateProviderRequest
+	96,  // 120: io.AdminService.DeleteProvider:input_type -> io.DeleteProviderRequest
+	99,  // 121: io.AdminService.ListModels:input_type -> io.ListModelsRequest
+	101, // 122: io.AdminService.CreateModel:input_type -> io.CreateModelRequest
+	103, // 123: io.AdminService.UpdateModel:input_type -> io.UpdateModelRequest
+	105, // 124: io.AdminService.DeleteModel:input_type -> io.DeleteModelRequest
+	108, // 125: io.AdminService.CreateAIConfig:input_type -> io.CreateAIConfigRequest
+	110, // 126: io.AdminService.UpdateAIConfig:input_type -> io.UpdateAIConfigRequest
+	112, // 127: io.AdminService.DeleteAIConfig:input_type -> io.DeleteAIConfigRequest
+	64,  // 128: io.AdminService.UpdateAIConfigParams:input_type -> io.UpdateAIConfigParamsRequest
+	68,  // 129: io.AdminService.AttachMCPServer:input_type -> io.AttachMCPServerRequest
+	70,  // 130: io.AdminService.DetachMCPServer:input_type -> io.DetachMCPServerRequest
+	72,  // 131: io.AdminService.SetToolRule:input_type -> io.SetToolRuleRequest
+	74,  // 132: io.AdminService.RemoveToolRule:input_type -> io.RemoveToolRuleRequest
+	83,  // 133: io.AdminService.ConnectMCPServer:input_type -> io.ConnectMCPServerRequest
+	85,  // 134: io.AdminService.DisconnectMCPServer:input_type -> io.DisconnectMCPServerRequest
+	87,  // 135: io.AdminService.RestartMCPServer:input_type -> io.RestartMCPServerRequest
+	115, // 136: io.AdminService.CreatePersonality:input_type -> io.CreatePersonalityRequest
+	117, // 137: io.AdminService.UpdatePersonality:input_type -> io.UpdatePersonalityRequest
+	119, // 138: io.AdminService.DeletePersonality:input_type -> io.DeletePersonalityRequest
+	121, // 139: io.AdminService.ListPersonalityVersions:input_type -> io.ListPersonalityVersionsRequest
+	123, // 140: io.AdminService.ListGlobalMemories:input_type -> io.ListGlobalMemoriesRequest
+	125, // 141: io.AdminService.CreateGlobalMemory:input_type -> io.CreateGlobalMemoryRequest
+	127, // 142: io.AdminService.UpdateGlobalMemory:input_type -> io.UpdateGlobalMemoryRequest
+	129, // 143: io.AdminService.DeleteGlobalMemory:input_type -> io.DeleteGlobalMemoryRequest
+	19,  // 144: io.IOService.SendMessage:output_type -> io.SendMessageResponse
+	24,  // 145: io.IOService.SendMessageStream:output_type -> io.SendMessageStreamResponse
+	26,  // 146: io.IOService.ListConversations:output_type -> io.ListConversationsResponse
+	28,  // 147: io.IOService.LoadConversation:output_type -> io.LoadConversationResponse
+	57,  // 148: io.IOService.DeleteConversation:output_type -> io.DeleteConversationResponse
+	55,  // 149: io.IOService.SearchMessages:output_type -> io.SearchMessagesResponse
+	31,  // 150: io.IOService.GetActiveState:output_type -> io.GetActiveStateResponse
+	33,  // 151: io.IOService.ResumeConversation:output_type -> io.ResumeConversationResponse
+	35,  // 152: io.IOService.ClearActiveConversation:output_type -> io.ClearActiveConversationResponse
+	37,  // 153: io.IOService.ListPersonalities:output_type -> io.ListPersonalitiesResponse
+	39,  // 154: io.IOService.SetConversationPersonality:output_type -> io.SetConversationPersonalityResponse
+	41,  // 155: io.IOService.ListMemories:output_type -> io.ListMemoriesResponse
+	43,  // 156: io.IOService.CreateMemory:output_type -> io.CreateMemoryResponse
+	45,  // 157: io.IOService.UpdateMemory:output_type -> io.UpdateMemoryResponse
+	47,  // 158: io.IOService.DeleteMemory:output_type -> io.DeleteMemoryResponse
+	49,  // 159: io.IOService.UploadDocument:output_type -> io.UploadDocumentResponse
+	51,  // 160: io.IOService.ListDocuments:output_type -> io.ListDocumentsResponse
+	53,  // 161: io.IOService.DeleteDocument:output_type -> io.DeleteDocumentResponse
+	59,  // 162: io.IOService.ListAIConfigs:output_type -> io.ListAIConfigsResponse
+	61,  // 163: io.IOService.SwitchAIConfig:output_type -> io.SwitchAIConfigResponse
+	67,  // 164: io.IOService.GetAIConfigTools:output_type -> io.GetAIConfigToolsResponse
+	77,  // 165: io.IOService.ApproveToolCall:output_type -> io.ApproveToolCallResponse
+	79,  // 166: io.IOService.RejectToolCall:output_type -> io.RejectToolCallResponse
+	82,  // 167: io.IOService.ListMCPServers:output_type -> io.ListMCPServersResponse
+	90,  // 168: io.IOService.ListProviders:output_type -> io.ListProvidersResponse
+	93,  // 169: io.AdminService.CreateProvider:output_type -> io.CreateProviderResponse
+	95,  // 170: io.AdminService.UpdateProvider:output_type -> io.UpdateProviderResponse
+	97,  // 171: io.AdminService.DeleteProvider:output_type -> io.DeleteProviderResponse
+	100, // 172: io.AdminService.ListModels:output_type -> io.ListModelsResponse
+	102, // 173: io.AdminService.CreateModel:output_type -> io.CreateModelResponse
+	104, // 174: io.AdminService.UpdateModel:output_type -> io.UpdateModelResponse
+	106, // 175: io.AdminService.DeleteModel:output_type -> io.DeleteModelResponse
+	109, // 176: io.AdminService.CreateAIConfig:output_type -> io.CreateAIConfigResponse
+	111, // 177: io.AdminService.UpdateAIConfig:output_type -> io.UpdateAIConfigResponse
+	113, // 178: io.AdminService.DeleteAIConfig:output_type -> io.DeleteAIConfigResponse
+	65,  // 179: io.AdminService.UpdateAIConfigParams:output_type -> io.UpdateAIConfigParamsResponse
+	69,  // 180: io.AdminService.AttachMCPServer:output_type -> io.AttachMCPServerResponse
+	71,  // 181: io.AdminService.DetachMCPServer:output_type -> io.DetachMCPServerResponse
+	73,  // 182: io.AdminService.SetToolRule:output_type -> io.SetToolRuleResponse
+	75,  // 183: io.AdminService.RemoveToolRule:output_type -> io.RemoveToolRuleResponse
+	84,  // 184: io.AdminService.ConnectMCPServer:output_type -> io.ConnectMCPServerResponse
+	86,  // 185: io.AdminService.DisconnectMCPServer:output_type -> io.DisconnectMCPServerResponse
+	88,  // 186: io.AdminService.RestartMCPServer:output_type -> io.RestartMCPServerResponse
+	116, // 187: io.AdminService.CreatePersonality:output_type -> io.CreatePersonalityResponse
+	118, // 188: io.AdminService.UpdatePersonality:output_type -> io.UpdatePersonalityResponse
+	120, // 189: io.AdminService.DeletePersonality:output_type -> io.DeletePersonalityResponse
+	122, // 190: io.AdminService.ListPersonalityVersions:output_type -> io.ListPersonalityVersionsResponse
+	124, // 191: io.AdminService.ListGlobalMemories:output_type -> io.ListGlobalMemoriesResponse
+	126, // 192: io.AdminService.CreateGlobalMemory:output_type -> io.CreateGlobalMemoryResponse
+	128, // 193: io.AdminService.UpdateGlobalMemory:output_type -> io.UpdateGlobalMemoryResponse
+	130, // 194: io.AdminService.DeleteGlobalMemory:output_type -> io.DeleteGlobalMemoryResponse
+	144, // [144:195] is the sub-list for method output_type
+	93,  // [93:144] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_io_proto_init() }
@@ -1465,15 +8215,22 @@ func file_io_proto_init() {
 	if File_io_proto != nil {
 		return
 	}
+	file_io_proto_msgTypes[9].OneofWrappers = []any{}
+	file_io_proto_msgTypes[24].OneofWrappers = []any{
+		(*SendMessageStreamResponse_TextDelta)(nil),
+		(*SendMessageStreamResponse_ToolCall)(nil),
+		(*SendMessageStreamResponse_Done)(nil),
+		(*SendMessageStreamResponse_ToolApproval)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_io_proto_rawDesc), len(file_io_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   133,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_io_proto_goTypes,
 		DependencyIndexes: file_io_proto_depIdxs,
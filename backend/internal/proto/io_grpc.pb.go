@@ -19,13 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IOService_SendMessage_FullMethodName        = "/io.IOService/SendMessage"
-	IOService_ListConversations_FullMethodName  = "/io.IOService/ListConversations"
-	IOService_LoadConversation_FullMethodName   = "/io.IOService/LoadConversation"
-	IOService_DeleteConversation_FullMethodName = "/io.IOService/DeleteConversation"
-	IOService_ListAIConfigs_FullMethodName      = "/io.IOService/ListAIConfigs"
-	IOService_SwitchAIConfig_FullMethodName     = "/io.IOService/SwitchAIConfig"
-	IOService_ListProviders_FullMethodName      = "/io.IOService/ListProviders"
+	IOService_SendMessage_FullMethodName                = "/io.IOService/SendMessage"
+	IOService_SendMessageStream_FullMethodName          = "/io.IOService/SendMessageStream"
+	IOService_ListConversations_FullMethodName          = "/io.IOService/ListConversations"
+	IOService_LoadConversation_FullMethodName           = "/io.IOService/LoadConversation"
+	IOService_DeleteConversation_FullMethodName         = "/io.IOService/DeleteConversation"
+	IOService_SearchMessages_FullMethodName             = "/io.IOService/SearchMessages"
+	IOService_GetActiveState_FullMethodName             = "/io.IOService/GetActiveState"
+	IOService_ResumeConversation_FullMethodName         = "/io.IOService/ResumeConversation"
+	IOService_ClearActiveConversation_FullMethodName    = "/io.IOService/ClearActiveConversation"
+	IOService_ListPersonalities_FullMethodName          = "/io.IOService/ListPersonalities"
+	IOService_SetConversationPersonality_FullMethodName = "/io.IOService/SetConversationPersonality"
+	IOService_ListMemories_FullMethodName               = "/io.IOService/ListMemories"
+	IOService_CreateMemory_FullMethodName               = "/io.IOService/CreateMemory"
+	IOService_UpdateMemory_FullMethodName               = "/io.IOService/UpdateMemory"
+	IOService_DeleteMemory_FullMethodName               = "/io.IOService/DeleteMemory"
+	IOService_UploadDocument_FullMethodName             = "/io.IOService/UploadDocument"
+	IOService_ListDocuments_FullMethodName              = "/io.IOService/ListDocuments"
+	IOService_DeleteDocument_FullMethodName             = "/io.IOService/DeleteDocument"
+	IOService_ListAIConfigs_FullMethodName              = "/io.IOService/ListAIConfigs"
+	IOService_SwitchAIConfig_FullMethodName             = "/io.IOService/SwitchAIConfig"
+	IOService_GetAIConfigTools_FullMethodName           = "/io.IOService/GetAIConfigTools"
+	IOService_ApproveToolCall_FullMethodName            = "/io.IOService/ApproveToolCall"
+	IOService_RejectToolCall_FullMethodName             = "/io.IOService/RejectToolCall"
+	IOService_ListMCPServers_FullMethodName             = "/io.IOService/ListMCPServers"
+	IOService_ListProviders_FullMethodName              = "/io.IOService/ListProviders"
 )
 
 // IOServiceClient is the client API for IOService service.
@@ -36,13 +54,42 @@ const (
 type IOServiceClient interface {
 	// Send a message and get AI response
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// Send a message and stream the AI response as it is generated
+	SendMessageStream(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SendMessageStreamResponse], error)
 	// Conversation management
 	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
 	LoadConversation(ctx context.Context, in *LoadConversationRequest, opts ...grpc.CallOption) (*LoadConversationResponse, error)
 	DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error)
+	// Full-text search over the messages of the user's conversations
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// Per-user state, what SendMessage uses when no conversation is given
+	GetActiveState(ctx context.Context, in *GetActiveStateRequest, opts ...grpc.CallOption) (*GetActiveStateResponse, error)
+	ResumeConversation(ctx context.Context, in *ResumeConversationRequest, opts ...grpc.CallOption) (*ResumeConversationResponse, error)
+	// The next message starts a new conversation, the current one is kept
+	ClearActiveConversation(ctx context.Context, in *ClearActiveConversationRequest, opts ...grpc.CallOption) (*ClearActiveConversationResponse, error)
+	// Personalities, see AdminService for editing them
+	ListPersonalities(ctx context.Context, in *ListPersonalitiesRequest, opts ...grpc.CallOption) (*ListPersonalitiesResponse, error)
+	SetConversationPersonality(ctx context.Context, in *SetConversationPersonalityRequest, opts ...grpc.CallOption) (*SetConversationPersonalityResponse, error)
+	// Long-term memory, users can only edit their own memories
+	ListMemories(ctx context.Context, in *ListMemoriesRequest, opts ...grpc.CallOption) (*ListMemoriesResponse, error)
+	CreateMemory(ctx context.Context, in *CreateMemoryRequest, opts ...grpc.CallOption) (*CreateMemoryResponse, error)
+	UpdateMemory(ctx context.Context, in *UpdateMemoryRequest, opts ...grpc.CallOption) (*UpdateMemoryResponse, error)
+	DeleteMemory(ctx context.Context, in *DeleteMemoryRequest, opts ...grpc.CallOption) (*DeleteMemoryResponse, error)
+	// Documents, retrieved into the context of the user's messages when they are related. needs semantic search on
+	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error)
+	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
+	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
 	// AI Config management
 	ListAIConfigs(ctx context.Context, in *ListAIConfigsRequest, opts ...grpc.CallOption) (*ListAIConfigsResponse, error)
 	SwitchAIConfig(ctx context.Context, in *SwitchAIConfigRequest, opts ...grpc.CallOption) (*SwitchAIConfigResponse, error)
+	// AI Config tool access, a config only sees the tools of its attached mcp servers, narrowed by its tool rules
+	// see AdminService for changing them
+	GetAIConfigTools(ctx context.Context, in *GetAIConfigToolsRequest, opts ...grpc.CallOption) (*GetAIConfigToolsResponse, error)
+	// Tool call approval, for tools behind an "approve" rule
+	ApproveToolCall(ctx context.Context, in *ApproveToolCallRequest, opts ...grpc.CallOption) (*ApproveToolCallResponse, error)
+	RejectToolCall(ctx context.Context, in *RejectToolCallRequest, opts ...grpc.CallOption) (*RejectToolCallResponse, error)
+	// MCP servers and their session state, AdminService connects, disconnects and restarts them
+	ListMCPServers(ctx context.Context, in *ListMCPServersRequest, opts ...grpc.CallOption) (*ListMCPServersResponse, error)
 	// Provider management
 	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 }
@@ -65,6 +112,25 @@ func (c *iOServiceClient) SendMessage(ctx context.Context, in *SendMessageReques
 	return out, nil
 }
 
+func (c *iOServiceClient) SendMessageStream(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SendMessageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IOService_ServiceDesc.Streams[0], IOService_SendMessageStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SendMessageRequest, SendMessageStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IOService_SendMessageStreamClient = grpc.ServerStreamingClient[SendMessageStreamResponse]
+
 func (c *iOServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListConversationsResponse)
@@ -95,6 +161,136 @@ func (c *iOServiceClient) DeleteConversation(ctx context.Context, in *DeleteConv
 	return out, nil
 }
 
+func (c *iOServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, IOService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) GetActiveState(ctx context.Context, in *GetActiveStateRequest, opts ...grpc.CallOption) (*GetActiveStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveStateResponse)
+	err := c.cc.Invoke(ctx, IOService_GetActiveState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ResumeConversation(ctx context.Context, in *ResumeConversationRequest, opts ...grpc.CallOption) (*ResumeConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeConversationResponse)
+	err := c.cc.Invoke(ctx, IOService_ResumeConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ClearActiveConversation(ctx context.Context, in *ClearActiveConversationRequest, opts ...grpc.CallOption) (*ClearActiveConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearActiveConversationResponse)
+	err := c.cc.Invoke(ctx, IOService_ClearActiveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ListPersonalities(ctx context.Context, in *ListPersonalitiesRequest, opts ...grpc.CallOption) (*ListPersonalitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPersonalitiesResponse)
+	err := c.cc.Invoke(ctx, IOService_ListPersonalities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) SetConversationPersonality(ctx context.Context, in *SetConversationPersonalityRequest, opts ...grpc.CallOption) (*SetConversationPersonalityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationPersonalityResponse)
+	err := c.cc.Invoke(ctx, IOService_SetConversationPersonality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ListMemories(ctx context.Context, in *ListMemoriesRequest, opts ...grpc.CallOption) (*ListMemoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoriesResponse)
+	err := c.cc.Invoke(ctx, IOService_ListMemories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) CreateMemory(ctx context.Context, in *CreateMemoryRequest, opts ...grpc.CallOption) (*CreateMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMemoryResponse)
+	err := c.cc.Invoke(ctx, IOService_CreateMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) UpdateMemory(ctx context.Context, in *UpdateMemoryRequest, opts ...grpc.CallOption) (*UpdateMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemoryResponse)
+	err := c.cc.Invoke(ctx, IOService_UpdateMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) DeleteMemory(ctx context.Context, in *DeleteMemoryRequest, opts ...grpc.CallOption) (*DeleteMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMemoryResponse)
+	err := c.cc.Invoke(ctx, IOService_DeleteMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadDocumentResponse)
+	err := c.cc.Invoke(ctx, IOService_UploadDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentsResponse)
+	err := c.cc.Invoke(ctx, IOService_ListDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, IOService_DeleteDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iOServiceClient) ListAIConfigs(ctx context.Context, in *ListAIConfigsRequest, opts ...grpc.CallOption) (*ListAIConfigsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAIConfigsResponse)
@@ -115,6 +311,46 @@ func (c *iOServiceClient) SwitchAIConfig(ctx context.Context, in *SwitchAIConfig
 	return out, nil
 }
 
+func (c *iOServiceClient) GetAIConfigTools(ctx context.Context, in *GetAIConfigToolsRequest, opts ...grpc.CallOption) (*GetAIConfigToolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAIConfigToolsResponse)
+	err := c.cc.Invoke(ctx, IOService_GetAIConfigTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ApproveToolCall(ctx context.Context, in *ApproveToolCallRequest, opts ...grpc.CallOption) (*ApproveToolCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveToolCallResponse)
+	err := c.cc.Invoke(ctx, IOService_ApproveToolCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) RejectToolCall(ctx context.Context, in *RejectToolCallRequest, opts ...grpc.CallOption) (*RejectToolCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectToolCallResponse)
+	err := c.cc.Invoke(ctx, IOService_RejectToolCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iOServiceClient) ListMCPServers(ctx context.Context, in *ListMCPServersRequest, opts ...grpc.CallOption) (*ListMCPServersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMCPServersResponse)
+	err := c.cc.Invoke(ctx, IOService_ListMCPServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iOServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProvidersResponse)
@@ -133,13 +369,42 @@ func (c *iOServiceClient) ListProviders(ctx context.Context, in *ListProvidersRe
 type IOServiceServer interface {
 	// Send a message and get AI response
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	// Send a message and stream the AI response as it is generated
+	SendMessageStream(*SendMessageRequest, grpc.ServerStreamingServer[SendMessageStreamResponse]) error
 	// Conversation management
 	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
 	LoadConversation(context.Context, *LoadConversationRequest) (*LoadConversationResponse, error)
 	DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error)
+	// Full-text search over the messages of the user's conversations
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// Per-user state, what SendMessage uses when no conversation is given
+	GetActiveState(context.Context, *GetActiveStateRequest) (*GetActiveStateResponse, error)
+	ResumeConversation(context.Context, *ResumeConversationRequest) (*ResumeConversationResponse, error)
+	// The next message starts a new conversation, the current one is kept
+	ClearActiveConversation(context.Context, *ClearActiveConversationRequest) (*ClearActiveConversationResponse, error)
+	// Personalities, see AdminService for editing them
+	ListPersonalities(context.Context, *ListPersonalitiesRequest) (*ListPersonalitiesResponse, error)
+	SetConversationPersonality(context.Context, *SetConversationPersonalityRequest) (*SetConversationPersonalityResponse, error)
+	// Long-term memory, users can only edit their own memories
+	ListMemories(context.Context, *ListMemoriesRequest) (*ListMemoriesResponse, error)
+	CreateMemory(context.Context, *CreateMemoryRequest) (*CreateMemoryResponse, error)
+	UpdateMemory(context.Context, *UpdateMemoryRequest) (*UpdateMemoryResponse, error)
+	DeleteMemory(context.Context, *DeleteMemoryRequest) (*DeleteMemoryResponse, error)
+	// Documents, retrieved into the context of the user's messages when they are related. needs semantic search on
+	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
+	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
 	// AI Config management
 	ListAIConfigs(context.Context, *ListAIConfigsRequest) (*ListAIConfigsResponse, error)
 	SwitchAIConfig(context.Context, *SwitchAIConfigRequest) (*SwitchAIConfigResponse, error)
+	// AI Config tool access, a config only sees the tools of its attached mcp servers, narrowed by its tool rules
+	// see AdminService for changing them
+	GetAIConfigTools(context.Context, *GetAIConfigToolsRequest) (*GetAIConfigToolsResponse, error)
+	// Tool call approval, for tools behind an "approve" rule
+	ApproveToolCall(context.Context, *ApproveToolCallRequest) (*ApproveToolCallResponse, error)
+	RejectToolCall(context.Context, *RejectToolCallRequest) (*RejectToolCallResponse, error)
+	// MCP servers and their session state, AdminService connects, disconnects and restarts them
+	ListMCPServers(context.Context, *ListMCPServersRequest) (*ListMCPServersResponse, error)
 	// Provider management
 	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	mustEmbedUnimplementedIOServiceServer()
@@ -155,6 +420,9 @@ type UnimplementedIOServiceServer struct{}
 func (UnimplementedIOServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedIOServiceServer) SendMessageStream(*SendMessageRequest, grpc.ServerStreamingServer[SendMessageStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method SendMessageStream not implemented")
+}
 func (UnimplementedIOServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListConversations not implemented")
 }
@@ -164,12 +432,63 @@ func (UnimplementedIOServiceServer) LoadConversation(context.Context, *LoadConve
 func (UnimplementedIOServiceServer) DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteConversation not implemented")
 }
+func (UnimplementedIOServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedIOServiceServer) GetActiveState(context.Context, *GetActiveStateRequest) (*GetActiveStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveState not implemented")
+}
+func (UnimplementedIOServiceServer) ResumeConversation(context.Context, *ResumeConversationRequest) (*ResumeConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeConversation not implemented")
+}
+func (UnimplementedIOServiceServer) ClearActiveConversation(context.Context, *ClearActiveConversationRequest) (*ClearActiveConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearActiveConversation not implemented")
+}
+func (UnimplementedIOServiceServer) ListPersonalities(context.Context, *ListPersonalitiesRequest) (*ListPersonalitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPersonalities not implemented")
+}
+func (UnimplementedIOServiceServer) SetConversationPersonality(context.Context, *SetConversationPersonalityRequest) (*SetConversationPersonalityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetConversationPersonality not implemented")
+}
+func (UnimplementedIOServiceServer) ListMemories(context.Context, *ListMemoriesRequest) (*ListMemoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemories not implemented")
+}
+func (UnimplementedIOServiceServer) CreateMemory(context.Context, *CreateMemoryRequest) (*CreateMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemory not implemented")
+}
+func (UnimplementedIOServiceServer) UpdateMemory(context.Context, *UpdateMemoryRequest) (*UpdateMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemory not implemented")
+}
+func (UnimplementedIOServiceServer) DeleteMemory(context.Context, *DeleteMemoryRequest) (*DeleteMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemory not implemented")
+}
+func (UnimplementedIOServiceServer) UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadDocument not implemented")
+}
+func (UnimplementedIOServiceServer) ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDocuments not implemented")
+}
+func (UnimplementedIOServiceServer) DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDocument not implemented")
+}
 func (UnimplementedIOServiceServer) ListAIConfigs(context.Context, *ListAIConfigsRequest) (*ListAIConfigsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAIConfigs not implemented")
 }
 func (UnimplementedIOServiceServer) SwitchAIConfig(context.Context, *SwitchAIConfigRequest) (*SwitchAIConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SwitchAIConfig not implemented")
 }
+func (UnimplementedIOServiceServer) GetAIConfigTools(context.Context, *GetAIConfigToolsRequest) (*GetAIConfigToolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAIConfigTools not implemented")
+}
+func (UnimplementedIOServiceServer) ApproveToolCall(context.Context, *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveToolCall not implemented")
+}
+func (UnimplementedIOServiceServer) RejectToolCall(context.Context, *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectToolCall not implemented")
+}
+func (UnimplementedIOServiceServer) ListMCPServers(context.Context, *ListMCPServersRequest) (*ListMCPServersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMCPServers not implemented")
+}
 func (UnimplementedIOServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProviders not implemented")
 }
@@ -212,6 +531,17 @@ func _IOService_SendMessage_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IOService_SendMessageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SendMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IOServiceServer).SendMessageStream(m, &grpc.GenericServerStream[SendMessageRequest, SendMessageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IOService_SendMessageStreamServer = grpc.ServerStreamingServer[SendMessageStreamResponse]
+
 func _IOService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListConversationsRequest)
 	if err := dec(in); err != nil {
@@ -266,94 +596,1540 @@ func _IOService_DeleteConversation_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IOService_ListAIConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAIConfigsRequest)
+func _IOService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IOServiceServer).ListAIConfigs(ctx, in)
+		return srv.(IOServiceServer).SearchMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IOService_ListAIConfigs_FullMethodName,
+		FullMethod: IOService_SearchMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IOServiceServer).ListAIConfigs(ctx, req.(*ListAIConfigsRequest))
+		return srv.(IOServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IOService_SwitchAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SwitchAIConfigRequest)
+func _IOService_GetActiveState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IOServiceServer).SwitchAIConfig(ctx, in)
+		return srv.(IOServiceServer).GetActiveState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IOService_SwitchAIConfig_FullMethodName,
+		FullMethod: IOService_GetActiveState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IOServiceServer).SwitchAIConfig(ctx, req.(*SwitchAIConfigRequest))
+		return srv.(IOServiceServer).GetActiveState(ctx, req.(*GetActiveStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IOService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProvidersRequest)
+func _IOService_ResumeConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IOServiceServer).ListProviders(ctx, in)
+		return srv.(IOServiceServer).ResumeConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IOService_ListProviders_FullMethodName,
+		FullMethod: IOService_ResumeConversation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IOServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+		return srv.(IOServiceServer).ResumeConversation(ctx, req.(*ResumeConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// IOService_ServiceDesc is the grpc.ServiceDesc for IOService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var IOService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "io.IOService",
-	HandlerType: (*IOServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendMessage",
-			Handler:    _IOService_SendMessage_Handler,
-		},
-		{
-			MethodName: "ListConversations",
-			Handler:    _IOService_ListConversations_Handler,
-		},
-		{
-			MethodName: "LoadConversation",
-			Handler:    _IOService_LoadConversation_Handler,
-		},
-		{
-			MethodName: "DeleteConversation",
-			Handler:    _IOService_DeleteConversation_Handler,
-		},
-		{
-			MethodName: "ListAIConfigs",
-			Handler:    _IOService_ListAIConfigs_Handler,
-		},
-		{
-			MethodName: "SwitchAIConfig",
-			Handler:    _IOService_SwitchAIConfig_Handler,
-		},
-		{
-			MethodName: "ListProviders",
-			Handler:    _IOService_ListProviders_Handler,
+func _IOService_ClearActiveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearActiveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ClearActiveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ClearActiveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ClearActiveConversation(ctx, req.(*ClearActiveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ListPersonalities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ListPersonalities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ListPersonalities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ListPersonalities(ctx, req.(*ListPersonalitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_SetConversationPersonality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationPersonalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).SetConversationPersonality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_SetConversationPersonality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).SetConversationPersonality(ctx, req.(*SetConversationPersonalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ListMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ListMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ListMemories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ListMemories(ctx, req.(*ListMemoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_CreateMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).CreateMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_CreateMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).CreateMemory(ctx, req.(*CreateMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_UpdateMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).UpdateMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_UpdateMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).UpdateMemory(ctx, req.(*UpdateMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_DeleteMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).DeleteMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_DeleteMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).DeleteMemory(ctx, req.(*DeleteMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_UploadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).UploadDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_UploadDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).UploadDocument(ctx, req.(*UploadDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ListDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ListDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_DeleteDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).DeleteDocument(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ListAIConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ListAIConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ListAIConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ListAIConfigs(ctx, req.(*ListAIConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_SwitchAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchAIConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).SwitchAIConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_SwitchAIConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).SwitchAIConfig(ctx, req.(*SwitchAIConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_GetAIConfigTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIConfigToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).GetAIConfigTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_GetAIConfigTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).GetAIConfigTools(ctx, req.(*GetAIConfigToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ApproveToolCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveToolCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ApproveToolCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ApproveToolCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ApproveToolCall(ctx, req.(*ApproveToolCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_RejectToolCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectToolCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).RejectToolCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_RejectToolCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).RejectToolCall(ctx, req.(*RejectToolCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ListMCPServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMCPServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ListMCPServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ListMCPServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ListMCPServers(ctx, req.(*ListMCPServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IOService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IOServiceServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IOService_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IOServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IOService_ServiceDesc is the grpc.ServiceDesc for IOService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IOService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "io.IOService",
+	HandlerType: (*IOServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendMessage",
+			Handler:    _IOService_SendMessage_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _IOService_ListConversations_Handler,
+		},
+		{
+			MethodName: "LoadConversation",
+			Handler:    _IOService_LoadConversation_Handler,
+		},
+		{
+			MethodName: "DeleteConversation",
+			Handler:    _IOService_DeleteConversation_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _IOService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "GetActiveState",
+			Handler:    _IOService_GetActiveState_Handler,
+		},
+		{
+			MethodName: "ResumeConversation",
+			Handler:    _IOService_ResumeConversation_Handler,
+		},
+		{
+			MethodName: "ClearActiveConversation",
+			Handler:    _IOService_ClearActiveConversation_Handler,
+		},
+		{
+			MethodName: "ListPersonalities",
+			Handler:    _IOService_ListPersonalities_Handler,
+		},
+		{
+			MethodName: "SetConversationPersonality",
+			Handler:    _IOService_SetConversationPersonality_Handler,
+		},
+		{
+			MethodName: "ListMemories",
+			Handler:    _IOService_ListMemories_Handler,
+		},
+		{
+			MethodName: "CreateMemory",
+			Handler:    _IOService_CreateMemory_Handler,
+		},
+		{
+			MethodName: "UpdateMemory",
+			Handler:    _IOService_UpdateMemory_Handler,
+		},
+		{
+			MethodName: "DeleteMemory",
+			Handler:    _IOService_DeleteMemory_Handler,
+		},
+		{
+			MethodName: "UploadDocument",
+			Handler:    _IOService_UploadDocument_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _IOService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _IOService_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "ListAIConfigs",
+			Handler:    _IOService_ListAIConfigs_Handler,
+		},
+		{
+			MethodName: "SwitchAIConfig",
+			Handler:    _IOService_SwitchAIConfig_Handler,
+		},
+		{
+			MethodName: "GetAIConfigTools",
+			Handler:    _IOService_GetAIConfigTools_Handler,
+		},
+		{
+			MethodName: "ApproveToolCall",
+			Handler:    _IOService_ApproveToolCall_Handler,
+		},
+		{
+			MethodName: "RejectToolCall",
+			Handler:    _IOService_RejectToolCall_Handler,
+		},
+		{
+			MethodName: "ListMCPServers",
+			Handler:    _IOService_ListMCPServers_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _IOService_ListProviders_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SendMessageStream",
+			Handler:       _IOService_SendMessageStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "io.proto",
+}
+
+const (
+	AdminService_CreateProvider_FullMethodName          = "/io.AdminService/CreateProvider"
+	AdminService_UpdateProvider_FullMethodName          = "/io.AdminService/UpdateProvider"
+	AdminService_DeleteProvider_FullMethodName          = "/io.AdminService/DeleteProvider"
+	AdminService_ListModels_FullMethodName              = "/io.AdminService/ListModels"
+	AdminService_CreateModel_FullMethodName             = "/io.AdminService/CreateModel"
+	AdminService_UpdateModel_FullMethodName             = "/io.AdminService/UpdateModel"
+	AdminService_DeleteModel_FullMethodName             = "/io.AdminService/DeleteModel"
+	AdminService_CreateAIConfig_FullMethodName          = "/io.AdminService/CreateAIConfig"
+	AdminService_UpdateAIConfig_FullMethodName          = "/io.AdminService/UpdateAIConfig"
+	AdminService_DeleteAIConfig_FullMethodName          = "/io.AdminService/DeleteAIConfig"
+	AdminService_UpdateAIConfigParams_FullMethodName    = "/io.AdminService/UpdateAIConfigParams"
+	AdminService_AttachMCPServer_FullMethodName         = "/io.AdminService/AttachMCPServer"
+	AdminService_DetachMCPServer_FullMethodName         = "/io.AdminService/DetachMCPServer"
+	AdminService_SetToolRule_FullMethodName             = "/io.AdminService/SetToolRule"
+	AdminService_RemoveToolRule_FullMethodName          = "/io.AdminService/RemoveToolRule"
+	AdminService_ConnectMCPServer_FullMethodName        = "/io.AdminService/ConnectMCPServer"
+	AdminService_DisconnectMCPServer_FullMethodName     = "/io.AdminService/DisconnectMCPServer"
+	AdminService_RestartMCPServer_FullMethodName        = "/io.AdminService/RestartMCPServer"
+	AdminService_CreatePersonality_FullMethodName       = "/io.AdminService/CreatePersonality"
+	AdminService_UpdatePersonality_FullMethodName       = "/io.AdminService/UpdatePersonality"
+	AdminService_DeletePersonality_FullMethodName       = "/io.AdminService/DeletePersonality"
+	AdminService_ListPersonalityVersions_FullMethodName = "/io.AdminService/ListPersonalityVersions"
+	AdminService_ListGlobalMemories_FullMethodName      = "/io.AdminService/ListGlobalMemories"
+	AdminService_CreateGlobalMemory_FullMethodName      = "/io.AdminService/CreateGlobalMemory"
+	AdminService_UpdateGlobalMemory_FullMethodName      = "/io.AdminService/UpdateGlobalMemory"
+	AdminService_DeleteGlobalMemory_FullMethodName      = "/io.AdminService/DeleteGlobalMemory"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Catalog management, for operators, the cli and the discord bot's "io admin" commands. served on its own listener
+// (IO_ADMIN_ADDR), never next to IOService. calls need "authorization: Bearer <IO_ADMIN_TOKEN>" metadata, without a
+// token set the listener stays on loopback. deletes refuse while anything still depends on the entity
+type AdminServiceClient interface {
+	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error)
+	UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*UpdateProviderResponse, error)
+	DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error)
+	ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error)
+	CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelResponse, error)
+	UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*UpdateModelResponse, error)
+	DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...grpc.CallOption) (*DeleteModelResponse, error)
+	CreateAIConfig(ctx context.Context, in *CreateAIConfigRequest, opts ...grpc.CallOption) (*CreateAIConfigResponse, error)
+	UpdateAIConfig(ctx context.Context, in *UpdateAIConfigRequest, opts ...grpc.CallOption) (*UpdateAIConfigResponse, error)
+	DeleteAIConfig(ctx context.Context, in *DeleteAIConfigRequest, opts ...grpc.CallOption) (*DeleteAIConfigResponse, error)
+	// Params are replaced as a whole and checked against what the config's model accepts
+	UpdateAIConfigParams(ctx context.Context, in *UpdateAIConfigParamsRequest, opts ...grpc.CallOption) (*UpdateAIConfigParamsResponse, error)
+	// AI Config tool access, what GetAIConfigTools reports
+	AttachMCPServer(ctx context.Context, in *AttachMCPServerRequest, opts ...grpc.CallOption) (*AttachMCPServerResponse, error)
+	DetachMCPServer(ctx context.Context, in *DetachMCPServerRequest, opts ...grpc.CallOption) (*DetachMCPServerResponse, error)
+	SetToolRule(ctx context.Context, in *SetToolRuleRequest, opts ...grpc.CallOption) (*SetToolRuleResponse, error)
+	RemoveToolRule(ctx context.Context, in *RemoveToolRuleRequest, opts ...grpc.CallOption) (*RemoveToolRuleResponse, error)
+	// MCP session management, changes last until the backend restarts, which connects the enabled servers again
+	ConnectMCPServer(ctx context.Context, in *ConnectMCPServerRequest, opts ...grpc.CallOption) (*ConnectMCPServerResponse, error)
+	DisconnectMCPServer(ctx context.Context, in *DisconnectMCPServerRequest, opts ...grpc.CallOption) (*DisconnectMCPServerResponse, error)
+	RestartMCPServer(ctx context.Context, in *RestartMCPServerRequest, opts ...grpc.CallOption) (*RestartMCPServerResponse, error)
+	CreatePersonality(ctx context.Context, in *CreatePersonalityRequest, opts ...grpc.CallOption) (*CreatePersonalityResponse, error)
+	UpdatePersonality(ctx context.Context, in *UpdatePersonalityRequest, opts ...grpc.CallOption) (*UpdatePersonalityResponse, error)
+	DeletePersonality(ctx context.Context, in *DeletePersonalityRequest, opts ...grpc.CallOption) (*DeletePersonalityResponse, error)
+	ListPersonalityVersions(ctx context.Context, in *ListPersonalityVersionsRequest, opts ...grpc.CallOption) (*ListPersonalityVersionsResponse, error)
+	ListGlobalMemories(ctx context.Context, in *ListGlobalMemoriesRequest, opts ...grpc.CallOption) (*ListGlobalMemoriesResponse, error)
+	CreateGlobalMemory(ctx context.Context, in *CreateGlobalMemoryRequest, opts ...grpc.CallOption) (*CreateGlobalMemoryResponse, error)
+	UpdateGlobalMemory(ctx context.Context, in *UpdateGlobalMemoryRequest, opts ...grpc.CallOption) (*UpdateGlobalMemoryResponse, error)
+	DeleteGlobalMemory(ctx context.Context, in *DeleteGlobalMemoryRequest, opts ...grpc.CallOption) (*DeleteGlobalMemoryResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProviderResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*UpdateProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProviderResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProviderResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModelsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateModelResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*UpdateModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateModelResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...grpc.CallOption) (*DeleteModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteModelResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateAIConfig(ctx context.Context, in *CreateAIConfigRequest, opts ...grpc.CallOption) (*CreateAIConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAIConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateAIConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateAIConfig(ctx context.Context, in *UpdateAIConfigRequest, opts ...grpc.CallOption) (*UpdateAIConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAIConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateAIConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteAIConfig(ctx context.Context, in *DeleteAIConfigRequest, opts ...grpc.CallOption) (*DeleteAIConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAIConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteAIConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateAIConfigParams(ctx context.Context, in *UpdateAIConfigParamsRequest, opts ...grpc.CallOption) (*UpdateAIConfigParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAIConfigParamsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateAIConfigParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AttachMCPServer(ctx context.Context, in *AttachMCPServerRequest, opts ...grpc.CallOption) (*AttachMCPServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachMCPServerResponse)
+	err := c.cc.Invoke(ctx, AdminService_AttachMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DetachMCPServer(ctx context.Context, in *DetachMCPServerRequest, opts ...grpc.CallOption) (*DetachMCPServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachMCPServerResponse)
+	err := c.cc.Invoke(ctx, AdminService_DetachMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetToolRule(ctx context.Context, in *SetToolRuleRequest, opts ...grpc.CallOption) (*SetToolRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetToolRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetToolRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveToolRule(ctx context.Context, in *RemoveToolRuleRequest, opts ...grpc.CallOption) (*RemoveToolRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveToolRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveToolRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ConnectMCPServer(ctx context.Context, in *ConnectMCPServerRequest, opts ...grpc.CallOption) (*ConnectMCPServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectMCPServerResponse)
+	err := c.cc.Invoke(ctx, AdminService_ConnectMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DisconnectMCPServer(ctx context.Context, in *DisconnectMCPServerRequest, opts ...grpc.CallOption) (*DisconnectMCPServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisconnectMCPServerResponse)
+	err := c.cc.Invoke(ctx, AdminService_DisconnectMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestartMCPServer(ctx context.Context, in *RestartMCPServerRequest, opts ...grpc.CallOption) (*RestartMCPServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestartMCPServerResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestartMCPServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreatePersonality(ctx context.Context, in *CreatePersonalityRequest, opts ...grpc.CallOption) (*CreatePersonalityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePersonalityResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreatePersonality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdatePersonality(ctx context.Context, in *UpdatePersonalityRequest, opts ...grpc.CallOption) (*UpdatePersonalityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePersonalityResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdatePersonality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePersonality(ctx context.Context, in *DeletePersonalityRequest, opts ...grpc.CallOption) (*DeletePersonalityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePersonalityResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeletePersonality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPersonalityVersions(ctx context.Context, in *ListPersonalityVersionsRequest, opts ...grpc.CallOption) (*ListPersonalityVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPersonalityVersionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPersonalityVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListGlobalMemories(ctx context.Context, in *ListGlobalMemoriesRequest, opts ...grpc.CallOption) (*ListGlobalMemoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGlobalMemoriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListGlobalMemories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateGlobalMemory(ctx context.Context, in *CreateGlobalMemoryRequest, opts ...grpc.CallOption) (*CreateGlobalMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGlobalMemoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateGlobalMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateGlobalMemory(ctx context.Context, in *UpdateGlobalMemoryRequest, opts ...grpc.CallOption) (*UpdateGlobalMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGlobalMemoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateGlobalMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteGlobalMemory(ctx context.Context, in *DeleteGlobalMemoryRequest, opts ...grpc.CallOption) (*DeleteGlobalMemoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGlobalMemoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteGlobalMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Catalog management, for operators, the cli and the discord bot's "io admin" commands. served on its own listener
+// (IO_ADMIN_ADDR), never next to IOService. calls need "authorization: Bearer <IO_ADMIN_TOKEN>" metadata, without a
+// token set the listener stays on loopback. deletes refuse while anything still depends on the entity
+type AdminServiceServer interface {
+	CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error)
+	UpdateProvider(context.Context, *UpdateProviderRequest) (*UpdateProviderResponse, error)
+	DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error)
+	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
+	CreateModel(context.Context, *CreateModelRequest) (*CreateModelResponse, error)
+	UpdateModel(context.Context, *UpdateModelRequest) (*UpdateModelResponse, error)
+	DeleteModel(context.Context, *DeleteModelRequest) (*DeleteModelResponse, error)
+	CreateAIConfig(context.Context, *CreateAIConfigRequest) (*CreateAIConfigResponse, error)
+	UpdateAIConfig(context.Context, *UpdateAIConfigRequest) (*UpdateAIConfigResponse, error)
+	DeleteAIConfig(context.Context, *DeleteAIConfigRequest) (*DeleteAIConfigResponse, error)
+	// Params are replaced as a whole and checked against what the config's model accepts
+	UpdateAIConfigParams(context.Context, *UpdateAIConfigParamsRequest) (*UpdateAIConfigParamsResponse, error)
+	// AI Config tool access, what GetAIConfigTools reports
+	AttachMCPServer(context.Context, *AttachMCPServerRequest) (*AttachMCPServerResponse, error)
+	DetachMCPServer(context.Context, *DetachMCPServerRequest) (*DetachMCPServerResponse, error)
+	SetToolRule(context.Context, *SetToolRuleRequest) (*SetToolRuleResponse, error)
+	RemoveToolRule(context.Context, *RemoveToolRuleRequest) (*RemoveToolRuleResponse, error)
+	// MCP session management, changes last until the backend restarts, which connects the enabled servers again
+	ConnectMCPServer(context.Context, *ConnectMCPServerRequest) (*ConnectMCPServerResponse, error)
+	DisconnectMCPServer(context.Context, *DisconnectMCPServerRequest) (*DisconnectMCPServerResponse, error)
+	RestartMCPServer(context.Context, *RestartMCPServerRequest) (*RestartMCPServerResponse, error)
+	CreatePersonality(context.Context, *CreatePersonalityRequest) (*CreatePersonalityResponse, error)
+	UpdatePersonality(context.Context, *UpdatePersonalityRequest) (*UpdatePersonalityResponse, error)
+	DeletePersonality(context.Context, *DeletePersonalityRequest) (*DeletePersonalityResponse, error)
+	ListPersonalityVersions(context.Context, *ListPersonalityVersionsRequest) (*ListPersonalityVersionsResponse, error)
+	ListGlobalMemories(context.Context, *ListGlobalMemoriesRequest) (*ListGlobalMemoriesResponse, error)
+	CreateGlobalMemory(context.Context, *CreateGlobalMemoryRequest) (*CreateGlobalMemoryResponse, error)
+	UpdateGlobalMemory(context.Context, *UpdateGlobalMemoryRequest) (*UpdateGlobalMemoryResponse, error)
+	DeleteGlobalMemory(context.Context, *DeleteGlobalMemoryRequest) (*DeleteGlobalMemoryResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProvider not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateProvider(context.Context, *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProvider not implemented")
+}
+func (UnimplementedAdminServiceServer) ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListModels not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateModel(context.Context, *CreateModelRequest) (*CreateModelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateModel not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateModel(context.Context, *UpdateModelRequest) (*UpdateModelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateModel not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteModel(context.Context, *DeleteModelRequest) (*DeleteModelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteModel not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateAIConfig(context.Context, *CreateAIConfigRequest) (*CreateAIConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAIConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateAIConfig(context.Context, *UpdateAIConfigRequest) (*UpdateAIConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAIConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteAIConfig(context.Context, *DeleteAIConfigRequest) (*DeleteAIConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAIConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateAIConfigParams(context.Context, *UpdateAIConfigParamsRequest) (*UpdateAIConfigParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAIConfigParams not implemented")
+}
+func (UnimplementedAdminServiceServer) AttachMCPServer(context.Context, *AttachMCPServerRequest) (*AttachMCPServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AttachMCPServer not implemented")
+}
+func (UnimplementedAdminServiceServer) DetachMCPServer(context.Context, *DetachMCPServerRequest) (*DetachMCPServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DetachMCPServer not implemented")
+}
+func (UnimplementedAdminServiceServer) SetToolRule(context.Context, *SetToolRuleRequest) (*SetToolRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetToolRule not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveToolRule(context.Context, *RemoveToolRuleRequest) (*RemoveToolRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveToolRule not implemented")
+}
+func (UnimplementedAdminServiceServer) ConnectMCPServer(context.Context, *ConnectMCPServerRequest) (*ConnectMCPServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConnectMCPServer not implemented")
+}
+func (UnimplementedAdminServiceServer) DisconnectMCPServer(context.Context, *DisconnectMCPServerRequest) (*DisconnectMCPServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisconnectMCPServer not implemented")
+}
+func (UnimplementedAdminServiceServer) RestartMCPServer(context.Context, *RestartMCPServerRequest) (*RestartMCPServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestartMCPServer not implemented")
+}
+func (UnimplementedAdminServiceServer) CreatePersonality(context.Context, *CreatePersonalityRequest) (*CreatePersonalityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePersonality not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdatePersonality(context.Context, *UpdatePersonalityRequest) (*UpdatePersonalityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePersonality not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePersonality(context.Context, *DeletePersonalityRequest) (*DeletePersonalityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePersonality not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPersonalityVersions(context.Context, *ListPersonalityVersionsRequest) (*ListPersonalityVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPersonalityVersions not implemented")
+}
+func (UnimplementedAdminServiceServer) ListGlobalMemories(context.Context, *ListGlobalMemoriesRequest) (*ListGlobalMemoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGlobalMemories not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateGlobalMemory(context.Context, *CreateGlobalMemoryRequest) (*CreateGlobalMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGlobalMemory not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateGlobalMemory(context.Context, *UpdateGlobalMemoryRequest) (*UpdateGlobalMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGlobalMemory not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteGlobalMemory(context.Context, *DeleteGlobalMemoryRequest) (*DeleteGlobalMemoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGlobalMemory not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_CreateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProvider(ctx, req.(*CreateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateProvider(ctx, req.(*UpdateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProvider(ctx, req.(*DeleteProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListModels(ctx, req.(*ListModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateModel(ctx, req.(*CreateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateModel(ctx, req.(*UpdateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteModel(ctx, req.(*DeleteModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAIConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateAIConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateAIConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateAIConfig(ctx, req.(*CreateAIConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAIConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateAIConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateAIConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateAIConfig(ctx, req.(*UpdateAIConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAIConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteAIConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteAIConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteAIConfig(ctx, req.(*DeleteAIConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateAIConfigParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAIConfigParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateAIConfigParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateAIConfigParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateAIConfigParams(ctx, req.(*UpdateAIConfigParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AttachMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AttachMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AttachMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AttachMCPServer(ctx, req.(*AttachMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DetachMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DetachMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DetachMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DetachMCPServer(ctx, req.(*DetachMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetToolRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetToolRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetToolRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetToolRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetToolRule(ctx, req.(*SetToolRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveToolRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveToolRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveToolRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveToolRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveToolRule(ctx, req.(*RemoveToolRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ConnectMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ConnectMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ConnectMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ConnectMCPServer(ctx, req.(*ConnectMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DisconnectMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DisconnectMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DisconnectMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DisconnectMCPServer(ctx, req.(*DisconnectMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestartMCPServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartMCPServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestartMCPServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestartMCPServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestartMCPServer(ctx, req.(*RestartMCPServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreatePersonality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreatePersonality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreatePersonality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreatePersonality(ctx, req.(*CreatePersonalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdatePersonality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePersonalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdatePersonality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdatePersonality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdatePersonality(ctx, req.(*UpdatePersonalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePersonality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePersonalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePersonality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePersonality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePersonality(ctx, req.(*DeletePersonalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPersonalityVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalityVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPersonalityVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPersonalityVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPersonalityVersions(ctx, req.(*ListPersonalityVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListGlobalMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGlobalMemoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListGlobalMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListGlobalMemories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListGlobalMemories(ctx, req.(*ListGlobalMemoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateGlobalMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGlobalMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateGlobalMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateGlobalMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateGlobalMemory(ctx, req.(*CreateGlobalMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateGlobalMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGlobalMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateGlobalMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateGlobalMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateGlobalMemory(ctx, req.(*UpdateGlobalMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteGlobalMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGlobalMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteGlobalMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteGlobalMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteGlobalMemory(ctx, req.(*DeleteGlobalMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "io.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProvider",
+			Handler:    _AdminService_CreateProvider_Handler,
+		},
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _AdminService_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "DeleteProvider",
+			Handler:    _AdminService_DeleteProvider_Handler,
+		},
+		{
+			MethodName: "ListModels",
+			Handler:    _AdminService_ListModels_Handler,
+		},
+		{
+			MethodName: "CreateModel",
+			Handler:    _AdminService_CreateModel_Handler,
+		},
+		{
+			MethodName: "UpdateModel",
+			Handler:    _AdminService_UpdateModel_Handler,
+		},
+		{
+			MethodName: "DeleteModel",
+			Handler:    _AdminService_DeleteModel_Handler,
+		},
+		{
+			MethodName: "CreateAIConfig",
+			Handler:    _AdminService_CreateAIConfig_Handler,
+		},
+		{
+			MethodName: "UpdateAIConfig",
+			Handler:    _AdminService_UpdateAIConfig_Handler,
+		},
+		{
+			MethodName: "DeleteAIConfig",
+			Handler:    _AdminService_DeleteAIConfig_Handler,
+		},
+		{
+			MethodName: "UpdateAIConfigParams",
+			Handler:    _AdminService_UpdateAIConfigParams_Handler,
+		},
+		{
+			MethodName: "AttachMCPServer",
+			Handler:    _AdminService_AttachMCPServer_Handler,
+		},
+		{
+			MethodName: "DetachMCPServer",
+			Handler:    _AdminService_DetachMCPServer_Handler,
+		},
+		{
+			MethodName: "SetToolRule",
+			Handler:    _AdminService_SetToolRule_Handler,
+		},
+		{
+			MethodName: "RemoveToolRule",
+			Handler:    _AdminService_RemoveToolRule_Handler,
+		},
+		{
+			MethodName: "ConnectMCPServer",
+			Handler:    _AdminService_ConnectMCPServer_Handler,
+		},
+		{
+			MethodName: "DisconnectMCPServer",
+			Handler:    _AdminService_DisconnectMCPServer_Handler,
+		},
+		{
+			MethodName: "RestartMCPServer",
+			Handler:    _AdminService_RestartMCPServer_Handler,
+		},
+		{
+			MethodName: "CreatePersonality",
+			Handler:    _AdminService_CreatePersonality_Handler,
+		},
+		{
+			MethodName: "UpdatePersonality",
+			Handler:    _AdminService_UpdatePersonality_Handler,
+		},
+		{
+			MethodName: "DeletePersonality",
+			Handler:    _AdminService_DeletePersonality_Handler,
+		},
+		{
+			MethodName: "ListPersonalityVersions",
+			Handler:    _AdminService_ListPersonalityVersions_Handler,
+		},
+		{
+			MethodName: "ListGlobalMemories",
+			Handler:    _AdminService_ListGlobalMemories_Handler,
+		},
+		{
+			MethodName: "CreateGlobalMemory",
+			Handler:    _AdminService_CreateGlobalMemory_Handler,
+		},
+		{
+			MethodName: "UpdateGlobalMemory",
+			Handler:    _AdminService_UpdateGlobalMemory_Handler,
+		},
+		{
+			MethodName: "DeleteGlobalMemory",
+			Handler:    _AdminService_DeleteGlobalMemory_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,285 @@
+package server
+
+import (
+	"context"
+
+	"github.com/curator4/io/backend/internal/domain"
+	pb "github.com/curator4/io/backend/internal/proto"
+	"github.com/curator4/io/backend/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminServer implements pb.AdminServiceServer, the catalog management api
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	svc *service.Service
+}
+
+// NewAdmin creates an AdminServer for the given service
+func NewAdmin(svc *service.Service) *AdminServer {
+	return &AdminServer{svc: svc}
+}
+
+// CreateProvider adds a provider
+func (s *AdminServer) CreateProvider(ctx context.Context, req *pb.CreateProviderRequest) (*pb.CreateProviderResponse, error) {
+	provider, err := s.svc.CreateProvider(ctx, domain.ProviderSpecFromPb(req.Provider))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateProviderResponse{Provider: domain.ProviderToPb(provider)}, nil
+}
+
+// UpdateProvider replaces a provider's settings
+func (s *AdminServer) UpdateProvider(ctx context.Context, req *pb.UpdateProviderRequest) (*pb.UpdateProviderResponse, error) {
+	provider, err := s.svc.UpdateProvider(ctx, req.Id, domain.ProviderSpecFromPb(req.Provider))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdateProviderResponse{Provider: domain.ProviderToPb(provider)}, nil
+}
+
+// DeleteProvider removes a provider without models
+func (s *AdminServer) DeleteProvider(ctx context.Context, req *pb.DeleteProviderRequest) (*pb.DeleteProviderResponse, error) {
+	if err := s.svc.DeleteProvider(ctx, req.Id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteProviderResponse{Success: true}, nil
+}
+
+// ListModels returns all models, or one provider's
+func (s *AdminServer) ListModels(ctx context.Context, req *pb.ListModelsRequest) (*pb.ListModelsResponse, error) {
+	models, err := s.svc.ListModels(ctx, req.ProviderId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListModelsResponse{
+		Models: make([]*pb.Model, len(models)),
+	}
+	for i, m := range models {
+		resp.Models[i] = domain.ModelToPb(m)
+	}
+	return resp, nil
+}
+
+// CreateModel adds a model to a provider
+func (s *AdminServer) CreateModel(ctx context.Context, req *pb.CreateModelRequest) (*pb.CreateModelResponse, error) {
+	model, err := s.svc.CreateModel(ctx, req.ProviderId, domain.ModelSpecFromPb(req.Model))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateModelResponse{Model: domain.ModelToPb(model)}, nil
+}
+
+// UpdateModel replaces a model's metadata
+func (s *AdminServer) UpdateModel(ctx context.Context, req *pb.UpdateModelRequest) (*pb.UpdateModelResponse, error) {
+	model, err := s.svc.UpdateModel(ctx, req.Id, domain.ModelSpecFromPb(req.Model))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdateModelResponse{Model: domain.ModelToPb(model)}, nil
+}
+
+// DeleteModel removes a model no ai config uses
+func (s *AdminServer) DeleteModel(ctx context.Context, req *pb.DeleteModelRequest) (*pb.DeleteModelResponse, error) {
+	if err := s.svc.DeleteModel(ctx, req.Id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteModelResponse{Success: true}, nil
+}
+
+// CreateAIConfig adds an ai config
+func (s *AdminServer) CreateAIConfig(ctx context.Context, req *pb.CreateAIConfigRequest) (*pb.CreateAIConfigResponse, error) {
+	config, err := s.svc.CreateAIConfig(ctx, aiConfigSpecFromPb(req.Config))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateAIConfigResponse{Config: domain.AIConfigToPb(config)}, nil
+}
+
+// UpdateAIConfig replaces an ai config's spec
+func (s *AdminServer) UpdateAIConfig(ctx context.Context, req *pb.UpdateAIConfigRequest) (*pb.UpdateAIConfigResponse, error) {
+	config, err := s.svc.UpdateAIConfig(ctx, req.Id, aiConfigSpecFromPb(req.Config))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdateAIConfigResponse{Config: domain.AIConfigToPb(config)}, nil
+}
+
+// DeleteAIConfig removes an ai config
+func (s *AdminServer) DeleteAIConfig(ctx context.Context, req *pb.DeleteAIConfigRequest) (*pb.DeleteAIConfigResponse, error) {
+	if err := s.svc.DeleteAIConfig(ctx, req.Id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteAIConfigResponse{Success: true}, nil
+}
+
+// UpdateAIConfigParams replaces an ai config's generation params
+func (s *AdminServer) UpdateAIConfigParams(ctx context.Context, req *pb.UpdateAIConfigParamsRequest) (*pb.UpdateAIConfigParamsResponse, error) {
+	params, err := domain.GenerationParamsFromPb(req.Params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	config, err := s.svc.UpdateAIConfigParams(ctx, req.ConfigId, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdateAIConfigParamsResponse{Config: domain.AIConfigToPb(config)}, nil
+}
+
+// AttachMCPServer gives an ai config access to an mcp server's tools
+func (s *AdminServer) AttachMCPServer(ctx context.Context, req *pb.AttachMCPServerRequest) (*pb.AttachMCPServerResponse, error) {
+	if err := s.svc.AttachMCPServer(ctx, req.ConfigId, req.ServerName); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.AttachMCPServerResponse{Success: true}, nil
+}
+
+// DetachMCPServer takes an mcp server's tools away from an ai config
+func (s *AdminServer) DetachMCPServer(ctx context.Context, req *pb.DetachMCPServerRequest) (*pb.DetachMCPServerResponse, error) {
+	if err := s.svc.DetachMCPServer(ctx, req.ConfigId, req.ServerName); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DetachMCPServerResponse{Success: true}, nil
+}
+
+// SetToolRule allows or denies a tool (or glob of tools) for an ai config
+func (s *AdminServer) SetToolRule(ctx context.Context, req *pb.SetToolRuleRequest) (*pb.SetToolRuleResponse, error) {
+	rule := req.GetRule()
+	if _, err := s.svc.SetToolRule(ctx, req.ConfigId, rule.GetToolName(), rule.GetRule()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.SetToolRuleResponse{Success: true}, nil
+}
+
+// RemoveToolRule deletes an ai config's rule for a tool
+func (s *AdminServer) RemoveToolRule(ctx context.Context, req *pb.RemoveToolRuleRequest) (*pb.RemoveToolRuleResponse, error) {
+	if err := s.svc.RemoveToolRule(ctx, req.ConfigId, req.ToolName); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RemoveToolRuleResponse{Success: true}, nil
+}
+
+// ConnectMCPServer starts a session to a configured mcp server
+func (s *AdminServer) ConnectMCPServer(ctx context.Context, req *pb.ConnectMCPServerRequest) (*pb.ConnectMCPServerResponse, error) {
+	server, err := s.svc.ConnectMCPServer(ctx, req.Name)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ConnectMCPServerResponse{Server: mcpServerStatusToPb(server)}, nil
+}
+
+// DisconnectMCPServer closes the session to an mcp server
+func (s *AdminServer) DisconnectMCPServer(ctx context.Context, req *pb.DisconnectMCPServerRequest) (*pb.DisconnectMCPServerResponse, error) {
+	if err := s.svc.DisconnectMCPServer(ctx, req.Name); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DisconnectMCPServerResponse{Success: true}, nil
+}
+
+// RestartMCPServer replaces the session to an mcp server with a fresh one
+func (s *AdminServer) RestartMCPServer(ctx context.Context, req *pb.RestartMCPServerRequest) (*pb.RestartMCPServerResponse, error) {
+	server, err := s.svc.RestartMCPServer(ctx, req.Name)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RestartMCPServerResponse{Server: mcpServerStatusToPb(server)}, nil
+}
+
+// CreatePersonality adds a personality at version 1
+func (s *AdminServer) CreatePersonality(ctx context.Context, req *pb.CreatePersonalityRequest) (*pb.CreatePersonalityResponse, error) {
+	spec, err := domain.PersonalitySpecFromPb(req.Personality)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	personality, err := s.svc.CreatePersonality(ctx, spec)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreatePersonalityResponse{Personality: domain.PersonalityToPb(personality)}, nil
+}
+
+// UpdatePersonality updates a personality, adding a version if its definition changed
+func (s *AdminServer) UpdatePersonality(ctx context.Context, req *pb.UpdatePersonalityRequest) (*pb.UpdatePersonalityResponse, error) {
+	spec, err := domain.PersonalitySpecFromPb(req.Personality)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	personality, err := s.svc.UpdatePersonality(ctx, req.Id, spec)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdatePersonalityResponse{Personality: domain.PersonalityToPb(personality)}, nil
+}
+
+// DeletePersonality removes a personality no ai config uses
+func (s *AdminServer) DeletePersonality(ctx context.Context, req *pb.DeletePersonalityRequest) (*pb.DeletePersonalityResponse, error) {
+	if err := s.svc.DeletePersonality(ctx, req.Id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeletePersonalityResponse{Success: true}, nil
+}
+
+// ListPersonalityVersions returns a personality's versions, newest first
+func (s *AdminServer) ListPersonalityVersions(ctx context.Context, req *pb.ListPersonalityVersionsRequest) (*pb.ListPersonalityVersionsResponse, error) {
+	versions, err := s.svc.ListPersonalityVersions(ctx, req.PersonalityId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListPersonalityVersionsResponse{
+		Versions: make([]*pb.PersonalityVersion, len(versions)),
+	}
+	for i, v := range versions {
+		resp.Versions[i] = domain.PersonalityVersionToPb(v)
+	}
+	return resp, nil
+}
+
+// ListGlobalMemories returns the memories every user's conversations recall
+func (s *AdminServer) ListGlobalMemories(ctx context.Context, req *pb.ListGlobalMemoriesRequest) (*pb.ListGlobalMemoriesResponse, error) {
+	memories, err := s.svc.ListGlobalMemories(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ListGlobalMemoriesResponse{Memories: memoriesToPb(memories)}, nil
+}
+
+// CreateGlobalMemory adds a memory every user's conversations recall
+func (s *AdminServer) CreateGlobalMemory(ctx context.Context, req *pb.CreateGlobalMemoryRequest) (*pb.CreateGlobalMemoryResponse, error) {
+	memory, err := s.svc.CreateGlobalMemory(ctx, req.Content)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateGlobalMemoryResponse{Memory: domain.MemoryToPb(memory)}, nil
+}
+
+// UpdateGlobalMemory replaces the content of a global memory
+func (s *AdminServer) UpdateGlobalMemory(ctx context.Context, req *pb.UpdateGlobalMemoryRequest) (*pb.UpdateGlobalMemoryResponse, error) {
+	memory, err := s.svc.UpdateGlobalMemory(ctx, req.Id, req.Content)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdateGlobalMemoryResponse{Memory: domain.MemoryToPb(memory)}, nil
+}
+
+// DeleteGlobalMemory removes a global memory
+func (s *AdminServer) DeleteGlobalMemory(ctx context.Context, req *pb.DeleteGlobalMemoryRequest) (*pb.DeleteGlobalMemoryResponse, error) {
+	if err := s.svc.DeleteGlobalMemory(ctx, req.Id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteGlobalMemoryResponse{Success: true}, nil
+}
+
+// aiConfigSpecFromPb converts a protobuf AIConfigSpec, the service's spec type has no domain counterpart
+func aiConfigSpecFromPb(spec *pb.AIConfigSpec) service.AIConfigSpec {
+	return service.AIConfigSpec{
+		Name:                 spec.GetName(),
+		ModelID:              spec.GetModelId(),
+		SystemPrompt:         spec.GetSystemPrompt(),
+		ContextStrategy:      domain.ContextStrategy(spec.GetContextStrategy()),
+		PersonalityVersionID: spec.GetPersonalityVersionId(),
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/curator4/io/backend/internal/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// stubAdmin answers ListModels without a service, enough to tell whether a call got through
+type stubAdmin struct {
+	pb.UnimplementedAdminServiceServer
+}
+
+func (stubAdmin) ListModels(context.Context, *pb.ListModelsRequest) (*pb.ListModelsResponse, error) {
+	return &pb.ListModelsResponse{}, nil
+}
+
+func TestRequireToken(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(RequireToken("s3cret")...)
+	pb.RegisterAdminServiceServer(srv, stubAdmin{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	client := pb.NewAdminServiceClient(conn)
+
+	tests := []struct {
+		name          string
+		authorization []string
+		want          codes.Code
+	}{
+		{"no token", nil, codes.Unauthenticated},
+		{"wrong token", []string{"Bearer guess"}, codes.Unauthenticated},
+		{"token without bearer", []string{"s3cret"}, codes.Unauthenticated},
+		{"valid token", []string{"Bearer s3cret"}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, value := range tt.authorization {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", value)
+			}
+			_, err := client.ListModels(ctx, &pb.ListModelsRequest{})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	pb "github.com/curator4/io/backend/internal/proto"
+	"github.com/curator4/io/backend/internal/service"
+)
+
+// SendMessage stores the user's message and returns it along with the ai's reply
+func (s *Server) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
+	result, err := s.svc.SendMessage(ctx, service.SendMessageInput{
+		UserID:         req.UserId,
+		ConversationID: req.ConversationId,
+		ChannelID:      req.ChannelId,
+		Frontend:       req.Frontend,
+		Role:           domain.Role(req.Role),
+		Content:        domain.MessageContentFromPb(req.Content),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return sendMessageResultToPb(result), nil
+}
+
+// SendMessageStream is like SendMessage, but streams text deltas and tool call progress before the final messages
+func (s *Server) SendMessageStream(req *pb.SendMessageRequest, stream pb.IOService_SendMessageStreamServer) error {
+	result, err := s.svc.SendMessageStream(stream.Context(), service.SendMessageInput{
+		UserID:         req.UserId,
+		ConversationID: req.ConversationId,
+		ChannelID:      req.ChannelId,
+		Frontend:       req.Frontend,
+		Role:           domain.Role(req.Role),
+		Content:        domain.MessageContentFromPb(req.Content),
+	}, func(event llm.StreamEvent) error {
+		return stream.Send(streamEventToPb(event))
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return stream.Send(&pb.SendMessageStreamResponse{
+		Event: &pb.SendMessageStreamResponse_Done{
+			Done: sendMessageResultToPb(result),
+		},
+	})
+}
+
+// sendMessageResultToPb converts a completed exchange to a protobuf SendMessageResponse
+func sendMessageResultToPb(result service.SendMessageResult) *pb.SendMessageResponse {
+	toolMessages := make([]*pb.Message, len(result.ToolMessages))
+	for i, msg := range result.ToolMessages {
+		toolMessages[i] = domain.MessageToPb(msg)
+	}
+
+	return &pb.SendMessageResponse{
+		UserMessage:      domain.MessageToPb(result.UserMessage),
+		AssistantMessage: domain.MessageToPb(result.AssistantMessage),
+		ConversationId:   result.ConversationID.String(),
+		Usage: &pb.Usage{
+			InputTokens:  result.Usage.InputTokens,
+			OutputTokens: result.Usage.OutputTokens,
+			TotalTokens:  result.Usage.TotalTokens,
+		},
+		FinishReason: string(result.FinishReason),
+		ToolMessages: toolMessages,
+		Context: &pb.ContextInfo{
+			BudgetTokens:       int32(result.Context.BudgetTokens),
+			HistoryTokens:      int32(result.Context.HistoryTokens),
+			DroppedMessages:    int32(result.Context.DroppedMessages),
+			TruncatedMessages:  int32(result.Context.TruncatedMessages),
+			Strategy:           string(result.Context.Strategy),
+			SummarizedMessages: int32(result.Context.SummarizedMessages),
+			RetrievedChunks:    int32(result.Context.RetrievedChunks),
+		},
+	}
+}
+
+// streamEventToPb converts a stream event to a protobuf stream response
+func streamEventToPb(event llm.StreamEvent) *pb.SendMessageStreamResponse {
+	if event.Type == llm.StreamEventToolApproval {
+		return &pb.SendMessageStreamResponse{
+			Event: &pb.SendMessageStreamResponse_ToolApproval{
+				ToolApproval: domain.ToolApprovalToPb(event.Approval),
+			},
+		}
+	}
+	if event.Type == llm.StreamEventToolCall {
+		return &pb.SendMessageStreamResponse{
+			Event: &pb.SendMessageStreamResponse_ToolCall{
+				ToolCall: &pb.ToolCallProgress{
+					Id:             event.ToolCall.ID,
+					Name:           event.ToolCall.Name,
+					Status:         string(event.ToolCall.Status),
+					ArgumentsDelta: event.ToolCall.ArgumentsDelta,
+				},
+			},
+		}
+	}
+	return &pb.SendMessageStreamResponse{
+		Event: &pb.SendMessageStreamResponse_TextDelta{
+			TextDelta: &pb.TextDelta{Text: event.Text},
+		},
+	}
+}
+
+// ListConversations returns the conversations the user participates in
+func (s *Server) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
+	conversations, err := s.svc.ListConversations(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListConversationsResponse{
+		Conversations: make([]*pb.Conversation, len(conversations)),
+	}
+	for i, c := range conversations {
+		resp.Conversations[i] = domain.ConversationToPb(c)
+	}
+	return resp, nil
+}
+
+// LoadConversation returns a conversation with its message history
+func (s *Server) LoadConversation(ctx context.Context, req *pb.LoadConversationRequest) (*pb.LoadConversationResponse, error) {
+	conversation, messages, err := s.svc.LoadConversation(ctx, req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.LoadConversationResponse{
+		Conversation: domain.ConversationToPb(conversation),
+		Messages:     make([]*pb.Message, len(messages)),
+	}
+	for i, m := range messages {
+		resp.Messages[i] = domain.MessageToPb(m)
+	}
+	return resp, nil
+}
+
+// DeleteConversation deletes a conversation the user participates in
+func (s *Server) DeleteConversation(ctx context.Context, req *pb.DeleteConversationRequest) (*pb.DeleteConversationResponse, error) {
+	if err := s.svc.DeleteConversation(ctx, req.ConversationId, req.UserId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteConversationResponse{Success: true}, nil
+}
+
+// SearchMessages runs a full-text search over the messages of the user's conversations
+func (s *Server) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	in := service.SearchMessagesInput{
+		UserID:         req.UserId,
+		Query:          req.Query,
+		ConversationID: req.ConversationId,
+		Role:           domain.Role(req.Role),
+		PageSize:       int(req.PageSize),
+		Cursor:         req.Cursor,
+	}
+	if req.Since != nil {
+		since := req.Since.AsTime()
+		in.Since = &since
+	}
+	if req.Until != nil {
+		until := req.Until.AsTime()
+		in.Until = &until
+	}
+
+	page, err := s.svc.SearchMessages(ctx, in)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.SearchMessagesResponse{
+		Results:    make([]*pb.MessageSearchResult, len(page.Results)),
+		NextCursor: page.NextCursor,
+	}
+	for i, r := range page.Results {
+		resp.Results[i] = domain.MessageSearchResultToPb(r)
+	}
+	return resp, nil
+}
+
+// GetActiveState returns the ai config and conversation the user's next message in a channel goes to
+func (s *Server) GetActiveState(ctx context.Context, req *pb.GetActiveStateRequest) (*pb.GetActiveStateResponse, error) {
+	state, err := s.svc.GetActiveState(ctx, req.UserId, req.ChannelId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetActiveStateResponse{
+		State: &pb.ActiveState{Config: domain.AIConfigToPb(state.AIConfig)},
+	}
+	if state.Conversation != nil {
+		resp.State.Conversation = domain.ConversationToPb(*state.Conversation)
+	}
+	return resp, nil
+}
+
+// ResumeConversation makes a conversation the active one in a channel
+func (s *Server) ResumeConversation(ctx context.Context, req *pb.ResumeConversationRequest) (*pb.ResumeConversationResponse, error) {
+	conversation, err := s.svc.ResumeConversation(ctx, req.ConversationId, req.UserId, req.ChannelId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ResumeConversationResponse{Conversation: domain.ConversationToPb(conversation)}, nil
+}
+
+// ClearActiveConversation makes the next message in a channel start a new conversation
+func (s *Server) ClearActiveConversation(ctx context.Context, req *pb.ClearActiveConversationRequest) (*pb.ClearActiveConversationResponse, error) {
+	if err := s.svc.ClearActiveConversation(ctx, req.UserId, req.ChannelId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ClearActiveConversationResponse{Success: true}, nil
+}
+
+// ListPersonalities returns all personalities with their latest version
+func (s *Server) ListPersonalities(ctx context.Context, req *pb.ListPersonalitiesRequest) (*pb.ListPersonalitiesResponse, error) {
+	personalities, err := s.svc.ListPersonalities(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListPersonalitiesResponse{
+		Personalities: make([]*pb.Personality, len(personalities)),
+	}
+	for i, p := range personalities {
+		resp.Personalities[i] = domain.PersonalityToPb(p)
+	}
+	return resp, nil
+}
+
+// SetConversationPersonality makes a conversation talk as a personality version
+func (s *Server) SetConversationPersonality(ctx context.Context, req *pb.SetConversationPersonalityRequest) (*pb.SetConversationPersonalityResponse, error) {
+	conversation, err := s.svc.SetConversationPersonality(ctx, req.ConversationId, req.UserId, req.PersonalityVersionId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.SetConversationPersonalityResponse{Conversation: domain.ConversationToPb(conversation)}, nil
+}
+
+// ListMemories returns a user's memories, followed by the global ones
+func (s *Server) ListMemories(ctx context.Context, req *pb.ListMemoriesRequest) (*pb.ListMemoriesResponse, error) {
+	memories, err := s.svc.ListMemories(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ListMemoriesResponse{Memories: memoriesToPb(memories)}, nil
+}
+
+// CreateMemory adds a memory for a user
+func (s *Server) CreateMemory(ctx context.Context, req *pb.CreateMemoryRequest) (*pb.CreateMemoryResponse, error) {
+	memory, err := s.svc.CreateMemory(ctx, req.UserId, req.Content)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateMemoryResponse{Memory: domain.MemoryToPb(memory)}, nil
+}
+
+// UpdateMemory replaces the content of one of a user's memories
+func (s *Server) UpdateMemory(ctx context.Context, req *pb.UpdateMemoryRequest) (*pb.UpdateMemoryResponse, error) {
+	memory, err := s.svc.UpdateMemory(ctx, req.Id, req.UserId, req.Content)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UpdateMemoryResponse{Memory: domain.MemoryToPb(memory)}, nil
+}
+
+// DeleteMemory removes one of a user's memories
+func (s *Server) DeleteMemory(ctx context.Context, req *pb.DeleteMemoryRequest) (*pb.DeleteMemoryResponse, error) {
+	if err := s.svc.DeleteMemory(ctx, req.Id, req.UserId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteMemoryResponse{Success: true}, nil
+}
+
+// UploadDocument stores and embeds a text document for a user
+func (s *Server) UploadDocument(ctx context.Context, req *pb.UploadDocumentRequest) (*pb.UploadDocumentResponse, error) {
+	document, err := s.svc.UploadDocument(ctx, req.UserId, req.Name, req.Content)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UploadDocumentResponse{Document: domain.DocumentToPb(document)}, nil
+}
+
+// ListDocuments returns a user's documents, newest first
+func (s *Server) ListDocuments(ctx context.Context, req *pb.ListDocumentsRequest) (*pb.ListDocumentsResponse, error) {
+	documents, err := s.svc.ListDocuments(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListDocumentsResponse{
+		Documents: make([]*pb.Document, len(documents)),
+	}
+	for i, d := range documents {
+		resp.Documents[i] = domain.DocumentToPb(d)
+	}
+	return resp, nil
+}
+
+// DeleteDocument removes one of a user's documents
+func (s *Server) DeleteDocument(ctx context.Context, req *pb.DeleteDocumentRequest) (*pb.DeleteDocumentResponse, error) {
+	if err := s.svc.DeleteDocument(ctx, req.Id, req.UserId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteDocumentResponse{Success: true}, nil
+}
+
+// memoriesToPb converts memories for a response
+func memoriesToPb(memories []domain.Memory) []*pb.Memory {
+	out := make([]*pb.Memory, len(memories))
+	for i, m := range memories {
+		out[i] = domain.MemoryToPb(m)
+	}
+	return out
+}
+
+// ListAIConfigs returns all ai configs
+func (s *Server) ListAIConfigs(ctx context.Context, req *pb.ListAIConfigsRequest) (*pb.ListAIConfigsResponse, error) {
+	configs, err := s.svc.ListAIConfigs(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListAIConfigsResponse{
+		Configs: make([]*pb.AIConfig, len(configs)),
+	}
+	for i, c := range configs {
+		resp.Configs[i] = domain.AIConfigToPb(c)
+	}
+	return resp, nil
+}
+
+// SwitchAIConfig makes the given config the active one
+func (s *Server) SwitchAIConfig(ctx context.Context, req *pb.SwitchAIConfigRequest) (*pb.SwitchAIConfigResponse, error) {
+	config, err := s.svc.SwitchAIConfig(ctx, req.ConfigId, req.UserId, req.ChannelId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.SwitchAIConfigResponse{
+		Success: true,
+		Config:  domain.AIConfigToPb(config),
+	}, nil
+}
+
+// ListProviders returns all providers
+func (s *Server) ListProviders(ctx context.Context, req *pb.ListProvidersRequest) (*pb.ListProvidersResponse, error) {
+	providers, err := s.svc.ListProviders(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListProvidersResponse{
+		Providers: make([]*pb.Provider, len(providers)),
+	}
+	for i, p := range providers {
+		resp.Providers[i] = domain.ProviderToPb(p)
+	}
+	return resp, nil
+}
+
+// GetAIConfigTools returns the mcp servers, tool rules and resulting tools of an ai config
+func (s *Server) GetAIConfigTools(ctx context.Context, req *pb.GetAIConfigToolsRequest) (*pb.GetAIConfigToolsResponse, error) {
+	access, err := s.svc.GetAIConfigTools(ctx, req.ConfigId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetAIConfigToolsResponse{
+		McpServers: access.MCPServers,
+		Rules:      make([]*pb.ToolRule, len(access.Rules)),
+		Tools:      make([]*pb.Tool, len(access.Tools)),
+	}
+	for i, r := range access.Rules {
+		resp.Rules[i] = domain.ToolRuleToPb(r)
+	}
+	for i, t := range access.Tools {
+		resp.Tools[i] = &pb.Tool{
+			Name:             t.Name,
+			Description:      t.Description,
+			RequiresApproval: slices.Contains(access.Approval, t.Name),
+		}
+	}
+	return resp, nil
+}
+
+// ApproveToolCall lets a tool call paused in a SendMessageStream run
+func (s *Server) ApproveToolCall(ctx context.Context, req *pb.ApproveToolCallRequest) (*pb.ApproveToolCallResponse, error) {
+	if err := s.svc.ApproveToolCall(ctx, req.ApprovalId, req.UserId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ApproveToolCallResponse{Success: true}, nil
+}
+
+// RejectToolCall answers a tool call paused in a SendMessageStream without running it
+func (s *Server) RejectToolCall(ctx context.Context, req *pb.RejectToolCallRequest) (*pb.RejectToolCallResponse, error) {
+	if err := s.svc.RejectToolCall(ctx, req.ApprovalId, req.UserId, req.Reason); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RejectToolCallResponse{Success: true}, nil
+}
+
+// ListMCPServers returns every configured mcp server with its session state
+func (s *Server) ListMCPServers(ctx context.Context, req *pb.ListMCPServersRequest) (*pb.ListMCPServersResponse, error) {
+	servers, err := s.svc.ListMCPServers(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListMCPServersResponse{
+		Servers: make([]*pb.MCPServerStatus, len(servers)),
+	}
+	for i, server := range servers {
+		resp.Servers[i] = mcpServerStatusToPb(server)
+	}
+	return resp, nil
+}
+
+// mcpServerStatusToPb converts a server's session state to a protobuf MCPServerStatus
+func mcpServerStatusToPb(status service.MCPServerStatus) *pb.MCPServerStatus {
+	return &pb.MCPServerStatus{
+		Name:      status.Server.Name,
+		Transport: status.Server.Transport,
+		Enabled:   status.Server.Enabled,
+		State:     status.State,
+		SessionId: status.SessionID,
+		Tools:     int32(status.Tools),
+		Resources: int32(status.Resources),
+		Prompts:   int32(status.Prompts),
+		LastError: status.LastError,
+	}
+}
@@ -0,0 +1,50 @@
+// server is the package that implements the IOService and AdminService grpc servers.
+// handlers only translate between protobuf and domain types, the actual work happens in internal/service
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/curator4/io/backend/internal/proto"
+	"github.com/curator4/io/backend/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements pb.IOServiceServer on top of a service.Service
+type Server struct {
+	pb.UnimplementedIOServiceServer
+	svc *service.Service
+}
+
+// New creates a Server for the given service
+func New(svc *service.Service) *Server {
+	return &Server{svc: svc}
+}
+
+// toStatus maps service errors to grpc status errors
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrFailedPrecondition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		log.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+// approvalTimeout is how long a tool call waits for a decision before it is treated as rejected
+const approvalTimeout = 5 * time.Minute
+
+// approval statuses, see tool_call_approvals
+const (
+	approvalApproved = "approved"
+	approvalRejected = "rejected"
+	approvalExpired  = "expired"
+)
+
+// approvalDecision is handed from ApproveToolCall or RejectToolCall to the paused tool loop
+type approvalDecision struct {
+	approved bool
+	reason   string
+}
+
+// ApproveToolCall lets a paused tool call run, the user must participate in the conversation it was made in
+func (s *Service) ApproveToolCall(ctx context.Context, approvalID, externalUserID string) error {
+	return s.decideToolCall(ctx, approvalID, externalUserID, approvalDecision{approved: true})
+}
+
+// RejectToolCall answers a paused tool call without running it, the reason is passed on to the model
+func (s *Service) RejectToolCall(ctx context.Context, approvalID, externalUserID, reason string) error {
+	return s.decideToolCall(ctx, approvalID, externalUserID, approvalDecision{reason: reason})
+}
+
+// ExpireToolApprovals expires approvals left pending past their deadline, nothing is waiting on them anymore
+// approvals that aren't due yet are left alone, they may belong to a tool loop in another process
+func (s *Service) ExpireToolApprovals(ctx context.Context) error {
+	n, err := s.queries.ExpireOverdueToolApprovals(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire tool approvals: %w", err)
+	}
+	if n > 0 {
+		log.Printf("expired %d stale tool approvals", n)
+	}
+	return nil
+}
+
+// decideToolCall persists a decision and wakes up the tool loop waiting on it
+// the database decides races, only the first decision on a pending approval sticks.
+// the loop is woken through an in-process channel, see Service.approvals
+func (s *Service) decideToolCall(ctx context.Context, approvalID, externalUserID string, decision approvalDecision) error {
+	id, err := uuid.Parse(approvalID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid approval_id", ErrInvalidArgument)
+	}
+	approval, err := s.queries.GetToolApproval(ctx, id)
+	if err != nil {
+		return notFound(err, "tool approval")
+	}
+	if _, err := s.authorizedConversation(ctx, approval.ConversationID.String(), externalUserID); err != nil {
+		return err
+	}
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return err
+	}
+
+	status := approvalRejected
+	if decision.approved {
+		status = approvalApproved
+	}
+	_, err = s.queries.DecideToolApproval(ctx, database.DecideToolApprovalParams{
+		ID:        id,
+		Status:    status,
+		DecidedBy: uuid.NullUUID{UUID: uid, Valid: true},
+		Reason:    sql.NullString{String: decision.reason, Valid: decision.reason != ""},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// the approval read above may predate the decision that beat this one
+		if current, err := s.queries.GetToolApproval(ctx, id); err == nil {
+			approval = current
+		}
+		return fmt.Errorf("%w: tool call is already %s", ErrFailedPrecondition, approval.Status)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decide tool call: %w", err)
+	}
+
+	s.approvalsMu.Lock()
+	waiter := s.approvals[id]
+	s.approvalsMu.Unlock()
+	if waiter != nil {
+		waiter <- decision
+	}
+	return nil
+}
+
+// awaitApproval pauses the tool loop until a user decides on a call, or approvalTimeout passes
+// it reports whether the call may run, and otherwise the result to answer the call with
+// only streaming clients get to see the request, so without events the call is refused right away
+func (s *Service) awaitApproval(ctx context.Context, message domain.Message, call domain.ToolCall, events llm.StreamHandler) (domain.ToolResult, bool, error) {
+	refused := domain.ToolResult{CallID: call.ID, Name: call.Name, IsError: true}
+	if events == nil {
+		refused.Content = "not executed, this tool needs a user's approval and the client can't ask for it"
+		return refused, false, nil
+	}
+
+	row, err := s.queries.CreateToolApproval(ctx, database.CreateToolApprovalParams{
+		MessageID: message.ID,
+		CallID:    call.ID,
+		ToolName:  call.Name,
+		Arguments: call.Arguments,
+		ExpiresAt: time.Now().Add(approvalTimeout),
+	})
+	if err != nil {
+		return domain.ToolResult{}, false, fmt.Errorf("failed to create tool approval: %w", err)
+	}
+
+	// buffered, so deciding never blocks on a loop that has already given up
+	decided := make(chan approvalDecision, 1)
+	s.approvalsMu.Lock()
+	s.approvals[row.ID] = decided
+	s.approvalsMu.Unlock()
+	defer func() {
+		s.approvalsMu.Lock()
+		delete(s.approvals, row.ID)
+		s.approvalsMu.Unlock()
+	}()
+
+	approval := domain.ToolApprovalFromDB(row, message.ConversationID)
+	if err := events(llm.StreamEvent{Type: llm.StreamEventToolApproval, Approval: approval}); err != nil {
+		s.expireApproval(row.ID)
+		return domain.ToolResult{}, false, err
+	}
+
+	timer := time.NewTimer(time.Until(row.ExpiresAt))
+	defer timer.Stop()
+
+	var decision approvalDecision
+	select {
+	case decision = <-decided:
+	case <-timer.C:
+		if s.expireApproval(row.ID) {
+			refused.Content = "not executed, nobody approved the call in time"
+			return refused, false, nil
+		}
+		// a decision got in just before the deadline, or the approval was expired elsewhere, like by another
+		// process starting up. the row says which, waiting on the channel could block forever
+		var ok bool
+		if decision, ok = s.decidedApproval(ctx, row.ID); !ok {
+			refused.Content = "not executed, nobody approved the call in time"
+			return refused, false, nil
+		}
+	case <-ctx.Done():
+		s.expireApproval(row.ID)
+		return domain.ToolResult{}, false, ctx.Err()
+	}
+
+	if decision.approved {
+		return domain.ToolResult{}, true, nil
+	}
+	refused.Content = "not executed, the user rejected the call"
+	if decision.reason != "" {
+		refused.Content += ": " + decision.reason
+	}
+	return refused, false, nil
+}
+
+// decidedApproval reads the decision on an approval from the database, false if it was never approved or rejected
+func (s *Service) decidedApproval(ctx context.Context, id uuid.UUID) (approvalDecision, bool) {
+	row, err := s.queries.GetToolApproval(ctx, id)
+	if err != nil {
+		log.Printf("failed to get tool approval %s: %v", id, err)
+		return approvalDecision{}, false
+	}
+	switch row.Status {
+	case approvalApproved:
+		return approvalDecision{approved: true}, true
+	case approvalRejected:
+		return approvalDecision{reason: row.Reason.String}, true
+	}
+	return approvalDecision{}, false
+}
+
+// expireApproval marks a still pending approval as expired, reporting whether it was still pending
+// it runs detached from the request, which is usually what just went away
+func (s *Service) expireApproval(id uuid.UUID) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := s.queries.DecideToolApproval(ctx, database.DecideToolApprovalParams{ID: id, Status: approvalExpired})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("failed to expire tool approval %s: %v", id, err)
+	}
+	return err == nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	approvalColumns     = strings.Fields(`id message_id call_id tool_name arguments status decided_by reason created_at expires_at decided_at`)
+	conversationColumns = strings.Fields(`id created_at updated_at last_used_at name personality_version_id`)
+)
+
+// approvalDB serves a single approval whose status GetToolApproval reads one after the other from statuses,
+// the last one sticking, and answers DecideToolApproval as the conditional update would given decided
+func approvalDB(t *testing.T, id uuid.UUID, decided bool, statuses ...string) (*fakeDB, *Service) {
+	db, s := newFakeDB(t)
+	now := time.Now()
+	conversationID := uuid.NewString()
+	approval := func(status string) []any {
+		return []any{id.String(), uuid.NewString(), "call-1", "docs__search", "{}", status, nil, nil, now, now.Add(time.Minute), nil}
+	}
+
+	reads := 0
+	db.on("GetToolApproval", func([]any) (*fakeRows, error) {
+		status := statuses[min(reads, len(statuses)-1)]
+		reads++
+		return rows(append(append([]string{}, approvalColumns...), "conversation_id"), append(approval(status), conversationID)), nil
+	})
+	db.on("GetConversation", func([]any) (*fakeRows, error) {
+		return rows(conversationColumns, []any{conversationID, now, now, nil, nil, nil}), nil
+	})
+	db.on("IsParticipant", func([]any) (*fakeRows, error) {
+		return rows([]string{"exists"}, []any{true}), nil
+	})
+	db.on("DecideToolApproval", func(args []any) (*fakeRows, error) {
+		if decided {
+			return rows(approvalColumns), nil
+		}
+		return rows(approvalColumns, approval(args[1].(string))), nil
+	})
+	return db, s
+}
+
+func TestRejectToolCallWakesTheLoop(t *testing.T) {
+	id := uuid.New()
+	_, s := approvalDB(t, id, false, "pending")
+	decided := make(chan approvalDecision, 1)
+	s.approvals[id] = decided
+
+	if err := s.RejectToolCall(context.Background(), id.String(), "alice", "not now"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case decision := <-decided:
+		if decision.approved || decision.reason != "not now" {
+			t.Errorf("got decision %+v", decision)
+		}
+	default:
+		t.Error("the waiting loop got no decision")
+	}
+}
+
+func TestDecideToolCallConflict(t *testing.T) {
+	id := uuid.New()
+	// pending when first read, approved by someone else before this decision's update
+	db, s := approvalDB(t, id, true, "pending", "approved")
+	decided := make(chan approvalDecision, 1)
+	s.approvals[id] = decided
+
+	err := s.RejectToolCall(context.Background(), id.String(), "alice", "")
+	if !errors.Is(err, ErrFailedPrecondition) {
+		t.Fatalf("got %v, want %v", err, ErrFailedPrecondition)
+	}
+	if !strings.Contains(err.Error(), "already approved") {
+		t.Errorf("got %q, want the status the other decision left", err)
+	}
+	if len(decided) != 0 {
+		t.Error("a losing decision reached the waiting loop")
+	}
+	if n := len(db.called("GetToolApproval")); n != 2 {
+		t.Errorf("approval read %d times, want twice", n)
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+// tool rule kinds, see ai_config_tool_rules
+const (
+	ruleAllow   = "allow"
+	ruleDeny    = "deny"
+	ruleApprove = "approve"
+)
+
+// AIConfigTools describes the tools an ai config has access to
+type AIConfigTools struct {
+	MCPServers []string // Names of the attached mcp servers
+	Rules      []domain.ToolRule
+	Tools      []llm.Tool // What the config currently advertises, only connected servers contribute tools
+	Approval   []string   // Names of the advertised tools whose calls need a user's approval
+}
+
+// GetAIConfigTools returns a config's attached mcp servers, tool rules and the resulting tools
+func (s *Service) GetAIConfigTools(ctx context.Context, configID string) (AIConfigTools, error) {
+	id, err := s.aiConfigID(ctx, configID)
+	if err != nil {
+		return AIConfigTools{}, err
+	}
+
+	servers, err := s.queries.ListAIConfigMCPServers(ctx, id)
+	if err != nil {
+		return AIConfigTools{}, fmt.Errorf("failed to list mcp servers: %w", err)
+	}
+	rules, err := s.queries.ListToolRules(ctx, id)
+	if err != nil {
+		return AIConfigTools{}, fmt.Errorf("failed to list tool rules: %w", err)
+	}
+	tools, err := s.toolsFor(ctx, id)
+	if err != nil {
+		return AIConfigTools{}, err
+	}
+
+	access := AIConfigTools{
+		MCPServers: make([]string, len(servers)),
+		Rules:      make([]domain.ToolRule, len(rules)),
+		Tools:      tools.tools,
+	}
+	for _, tool := range tools.tools {
+		if tools.approval[tool.Name] {
+			access.Approval = append(access.Approval, tool.Name)
+		}
+	}
+	for i, server := range servers {
+		access.MCPServers[i] = server.Name
+	}
+	for i, rule := range rules {
+		access.Rules[i] = domain.ToolRuleFromDB(rule)
+	}
+	return access, nil
+}
+
+// AttachMCPServer gives a config access to an mcp server's tools, attaching twice is a no-op
+func (s *Service) AttachMCPServer(ctx context.Context, configID, serverName string) error {
+	id, err := s.aiConfigID(ctx, configID)
+	if err != nil {
+		return err
+	}
+	server, err := s.queries.GetMCPServer(ctx, serverName)
+	if err != nil {
+		return notFound(err, "mcp server")
+	}
+
+	if err := s.queries.AttachMCPServer(ctx, database.AttachMCPServerParams{AiConfigID: id, McpServerID: server.ID}); err != nil {
+		return fmt.Errorf("failed to attach mcp server: %w", err)
+	}
+	return nil
+}
+
+// DetachMCPServer takes an mcp server's tools away from a config
+func (s *Service) DetachMCPServer(ctx context.Context, configID, serverName string) error {
+	id, err := s.aiConfigID(ctx, configID)
+	if err != nil {
+		return err
+	}
+	server, err := s.queries.GetMCPServer(ctx, serverName)
+	if err != nil {
+		return notFound(err, "mcp server")
+	}
+
+	n, err := s.queries.DetachMCPServer(ctx, database.DetachMCPServerParams{AiConfigID: id, McpServerID: server.ID})
+	if err != nil {
+		return fmt.Errorf("failed to detach mcp server: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("mcp server is not attached: %w", ErrNotFound)
+	}
+	return nil
+}
+
+// SetToolRule allows, denies or gates tools matching toolName (a tool name or glob) for a config, replacing any rule for it
+func (s *Service) SetToolRule(ctx context.Context, configID, toolName, rule string) (domain.ToolRule, error) {
+	if rule != ruleAllow && rule != ruleDeny && rule != ruleApprove {
+		return domain.ToolRule{}, fmt.Errorf("%w: rule must be %q, %q or %q", ErrInvalidArgument, ruleAllow, ruleDeny, ruleApprove)
+	}
+	if toolName == "" {
+		return domain.ToolRule{}, fmt.Errorf("%w: tool_name is required", ErrInvalidArgument)
+	}
+	if _, err := path.Match(toolName, ""); err != nil {
+		return domain.ToolRule{}, fmt.Errorf("%w: invalid tool_name pattern", ErrInvalidArgument)
+	}
+	id, err := s.aiConfigID(ctx, configID)
+	if err != nil {
+		return domain.ToolRule{}, err
+	}
+
+	row, err := s.queries.SetToolRule(ctx, database.SetToolRuleParams{AiConfigID: id, ToolName: toolName, Rule: rule})
+	if err != nil {
+		return domain.ToolRule{}, fmt.Errorf("failed to set tool rule: %w", err)
+	}
+	return domain.ToolRuleFromDB(row), nil
+}
+
+// RemoveToolRule deletes a config's rule for toolName
+func (s *Service) RemoveToolRule(ctx context.Context, configID, toolName string) error {
+	id, err := s.aiConfigID(ctx, configID)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.queries.DeleteToolRule(ctx, database.DeleteToolRuleParams{AiConfigID: id, ToolName: toolName})
+	if err != nil {
+		return fmt.Errorf("failed to remove tool rule: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("tool rule %w", ErrNotFound)
+	}
+	return nil
+}
+
+// aiConfigID parses a config id and makes sure the config exists
+func (s *Service) aiConfigID(ctx context.Context, configID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(configID)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%w: invalid config_id", ErrInvalidArgument)
+	}
+	if _, err := s.queries.GetAIConfigByID(ctx, id); err != nil {
+		return uuid.UUID{}, notFound(err, "ai config")
+	}
+	return id, nil
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ListAIConfigs returns all ai configs with their models, ordered by name
+func (s *Service) ListAIConfigs(ctx context.Context) ([]domain.AIConfig, error) {
+	rows, err := s.queries.ListAIConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ai configs: %w", err)
+	}
+
+	configs := make([]domain.AIConfig, len(rows))
+	for i, row := range rows {
+		configs[i] = domain.AIConfigFromDB(database.GetAIConfigByIDRow(row))
+	}
+	return configs, nil
+}
+
+// SwitchAIConfig makes the given config the user's active one, in a channel or overall when channelID is ""
+func (s *Service) SwitchAIConfig(ctx context.Context, configID, externalUserID, channelID string) (domain.AIConfig, error) {
+	id, err := uuid.Parse(configID)
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("%w: invalid config_id", ErrInvalidArgument)
+	}
+	user, err := s.ensureUser(ctx, externalUserID)
+	if err != nil {
+		return domain.AIConfig{}, err
+	}
+
+	row, err := s.queries.GetAIConfigByID(ctx, id)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+
+	err = s.queries.SetUserAIConfig(ctx, database.SetUserAIConfigParams{
+		UserID:     user.ID,
+		ChannelID:  channelID,
+		AiConfigID: uuid.NullUUID{UUID: id, Valid: true},
+	})
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("failed to switch ai config: %w", err)
+	}
+
+	return domain.AIConfigFromDB(row), nil
+}
+
+// UpdateAIConfigParams replaces a config's generation params, after checking that its model accepts them
+// along with the defaults of the config's personality
+func (s *Service) UpdateAIConfigParams(ctx context.Context, configID string, params domain.GenerationParams) (domain.AIConfig, error) {
+	id, err := uuid.Parse(configID)
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("%w: invalid config_id", ErrInvalidArgument)
+	}
+	row, err := s.queries.GetAIConfigByID(ctx, id)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+	config := domain.AIConfigFromDB(row)
+	config.Params = params
+
+	var personality *domain.PersonalityVersion
+	if config.PersonalityVersionID != nil {
+		p, err := s.personalityVersion(ctx, config.PersonalityVersionID.String())
+		if err != nil {
+			return domain.AIConfig{}, err
+		}
+		personality = &p
+	}
+	if err := s.checkParamsFit(ctx, config, personality); err != nil {
+		return domain.AIConfig{}, err
+	}
+
+	update := domain.GenerationParamsToDB(params)
+	update.ID = id
+	if err := s.queries.UpdateAIConfigParams(ctx, update); err != nil {
+		return domain.AIConfig{}, fmt.Errorf("failed to update ai config: %w", err)
+	}
+
+	row, err = s.queries.GetAIConfigByID(ctx, id)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+	return domain.AIConfigFromDB(row), nil
+}
+
+// aiConfigByName looks up an ai config the service uses for its own work, what names it in errors
+func (s *Service) aiConfigByName(ctx context.Context, name, what string) (domain.AIConfig, error) {
+	named, err := s.queries.GetAIConfigByName(ctx, name)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, what)
+	}
+	row, err := s.queries.GetAIConfigByID(ctx, named.ID)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, what)
+	}
+	return domain.AIConfigFromDB(row), nil
+}
+
+// AIConfigSpec is the part of an ai config that is set as a whole by CreateAIConfig and UpdateAIConfig
+type AIConfigSpec struct {
+	Name                 string
+	ModelID              string
+	SystemPrompt         string
+	ContextStrategy      domain.ContextStrategy // defaults to drop_oldest
+	PersonalityVersionID string                 // optional
+}
+
+// resolvedSpec is a validated AIConfigSpec with its references looked up
+type resolvedSpec struct {
+	AIConfigSpec
+	model       domain.Model
+	personality *domain.PersonalityVersion
+}
+
+// CreateAIConfig adds an ai config for a model, generation params start out unset
+func (s *Service) CreateAIConfig(ctx context.Context, spec AIConfigSpec) (domain.AIConfig, error) {
+	r, err := s.resolveAIConfigSpec(ctx, spec)
+	if err != nil {
+		return domain.AIConfig{}, err
+	}
+	if err := s.checkParamsFit(ctx, domain.AIConfig{Model: r.model}, r.personality); err != nil {
+		return domain.AIConfig{}, err
+	}
+
+	created, err := s.queries.CreateAIConfig(ctx, database.CreateAIConfigParams{
+		Name:                 r.Name,
+		ModelID:              r.model.ID,
+		SystemPrompt:         sql.NullString{String: r.SystemPrompt, Valid: r.SystemPrompt != ""},
+		ContextStrategy:      string(r.ContextStrategy),
+		PersonalityVersionID: r.personalityID(),
+	})
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("failed to create ai config: %w", alreadyExists(err, "ai config"))
+	}
+
+	row, err := s.queries.GetAIConfigByID(ctx, created.ID)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+	return domain.AIConfigFromDB(row), nil
+}
+
+// UpdateAIConfig replaces a config's spec
+// the config's generation params, with its personality's defaults, have to be valid for the new model,
+// so switching models or personalities can't leave it unusable
+func (s *Service) UpdateAIConfig(ctx context.Context, configID string, spec AIConfigSpec) (domain.AIConfig, error) {
+	id, err := uuid.Parse(configID)
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("%w: invalid config_id", ErrInvalidArgument)
+	}
+	r, err := s.resolveAIConfigSpec(ctx, spec)
+	if err != nil {
+		return domain.AIConfig{}, err
+	}
+	row, err := s.queries.GetAIConfigByID(ctx, id)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+
+	config := domain.AIConfigFromDB(row)
+	if config.Model.ID != r.model.ID || domain.PtrToNullUUID(config.PersonalityVersionID) != r.personalityID() {
+		config.Model = r.model
+		if err := s.checkParamsFit(ctx, config, r.personality); err != nil {
+			return domain.AIConfig{}, err
+		}
+	}
+
+	err = s.queries.UpdateAIConfig(ctx, database.UpdateAIConfigParams{
+		ID:                   id,
+		Name:                 r.Name,
+		ModelID:              r.model.ID,
+		SystemPrompt:         sql.NullString{String: r.SystemPrompt, Valid: r.SystemPrompt != ""},
+		ContextStrategy:      string(r.ContextStrategy),
+		PersonalityVersionID: r.personalityID(),
+	})
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("failed to update ai config: %w", alreadyExists(err, "ai config"))
+	}
+
+	row, err = s.queries.GetAIConfigByID(ctx, id)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+	return domain.AIConfigFromDB(row), nil
+}
+
+// DeleteAIConfig removes an ai config along with its mcp server attachments and tool rules
+func (s *Service) DeleteAIConfig(ctx context.Context, configID string) error {
+	id, err := uuid.Parse(configID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid config_id", ErrInvalidArgument)
+	}
+
+	n, err := s.queries.DeleteAIConfig(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete ai config: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("ai config %w", ErrNotFound)
+	}
+	return nil
+}
+
+// resolveAIConfigSpec checks a spec, filling in defaults, and looks up its model and personality
+func (s *Service) resolveAIConfigSpec(ctx context.Context, spec AIConfigSpec) (resolvedSpec, error) {
+	if strings.TrimSpace(spec.Name) == "" {
+		return resolvedSpec{}, fmt.Errorf("%w: name is required", ErrInvalidArgument)
+	}
+	switch spec.ContextStrategy {
+	case "":
+		spec.ContextStrategy = domain.ContextDropOldest
+	case domain.ContextDropOldest, domain.ContextTruncateOldest:
+	default:
+		return resolvedSpec{}, fmt.Errorf("%w: context_strategy must be %q or %q", ErrInvalidArgument, domain.ContextDropOldest, domain.ContextTruncateOldest)
+	}
+	if err := checkPromptTemplate(spec.SystemPrompt); err != nil {
+		return resolvedSpec{}, fmt.Errorf("%w: system_prompt: %v", ErrInvalidArgument, err)
+	}
+
+	id, err := uuid.Parse(spec.ModelID)
+	if err != nil {
+		return resolvedSpec{}, fmt.Errorf("%w: invalid model_id", ErrInvalidArgument)
+	}
+	model, err := s.queries.GetModelByID(ctx, id)
+	if err != nil {
+		return resolvedSpec{}, notFound(err, "model")
+	}
+
+	r := resolvedSpec{AIConfigSpec: spec, model: domain.ModelFromDB(model)}
+	if spec.PersonalityVersionID != "" {
+		personality, err := s.personalityVersion(ctx, spec.PersonalityVersionID)
+		if err != nil {
+			return resolvedSpec{}, err
+		}
+		r.personality = &personality
+	}
+	return r, nil
+}
+
+// personalityID is the spec's personality version as stored
+func (r resolvedSpec) personalityID() uuid.NullUUID {
+	if r.personality == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: r.personality.ID, Valid: true}
+}
+
+// checkParamsFit checks that a config's model takes its params, filled in with a personality's defaults
+func (s *Service) checkParamsFit(ctx context.Context, config domain.AIConfig, personality *domain.PersonalityVersion) error {
+	if personality != nil {
+		config.Params = withDefaults(config.Params, personality.Params)
+	}
+	provider, err := s.providerFor(ctx, config)
+	if err != nil {
+		return err
+	}
+	if err := provider.ValidateParams(config.Model, config.Params); err != nil {
+		return fmt.Errorf("%w: params don't fit %s: %v", ErrInvalidArgument, config.Model.Name, err)
+	}
+	return nil
+}
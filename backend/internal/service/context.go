@@ -0,0 +1,166 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+)
+
+const (
+	// truncatedTokens is about how much of a message truncate_oldest keeps
+	truncatedTokens = 64
+	// truncatedMarker ends a shortened message, so the model knows it's looking at an excerpt
+	truncatedMarker = " [truncated]"
+	// estimateMarginPercent of the context window is kept free, token counts are estimates and may come out low
+	estimateMarginPercent = 10
+)
+
+// ContextReport describes how a history was fit into the model's context window, token counts are estimates
+type ContextReport struct {
+	Strategy           domain.ContextStrategy
+	BudgetTokens       int // room for history, 0 when the model's context window is unknown and nothing is cut
+	HistoryTokens      int // of the history that was sent
+	DroppedMessages    int
+	TruncatedMessages  int
+	SummarizedMessages int // stood in for by the conversation's summary, see summarizedHistory
+	RetrievedChunks    int // related passages added to the system prompt, see retrieve
+}
+
+// fitContext cuts a history down to what fits the model's context window next to the system prompt,
+// the tool definitions and room for the reply. the oldest messages go first, the latest message and the
+// tool results that follow it always stay, a history that doesn't fit even then is an error
+// system messages leading the history, like a conversation's summary, set the scene and stay as well
+func fitContext(history []domain.Message, config domain.AIConfig, tok llm.TokenEstimator, tools []llm.Tool) ([]domain.Message, ContextReport, error) {
+	report := ContextReport{Strategy: config.ContextStrategy}
+
+	pinned := 0
+	for pinned < len(history)-1 && (history[pinned].Role == domain.RoleSystem || history[pinned].Role == domain.RoleDeveloper) {
+		pinned++
+	}
+
+	// tool results stay with the call they answer, providers reject results without one
+	units := historyUnits(history[pinned:])
+	costs := make([]int, len(units))
+	total := unitTokens(tok, history[:pinned])
+	for i, unit := range units {
+		costs[i] = unitTokens(tok, unit)
+		total += costs[i]
+	}
+	if config.Model.ContextWindow == 0 {
+		report.HistoryTokens = total
+		return history, report, nil
+	}
+	budget := contextBudget(config, tok, tools)
+	report.BudgetTokens = budget
+
+	last := len(units) - 1
+	if config.ContextStrategy == domain.ContextTruncateOldest {
+		for i := 0; i < last && total > budget; i++ {
+			var truncated int
+			units[i], truncated = truncateUnit(tok, units[i])
+			report.TruncatedMessages += truncated
+
+			cost := unitTokens(tok, units[i])
+			total -= costs[i] - cost
+			costs[i] = cost
+		}
+	}
+
+	// the history also can't open with a reply once the start is cut, anthropic and gemini insist on it
+	first := 0
+	for first < last && (total > budget || (first > 0 && isReply(units[first][0]))) {
+		total -= costs[first]
+		report.DroppedMessages += len(units[first])
+		first++
+	}
+	if total > budget {
+		return nil, ContextReport{}, fmt.Errorf("%w: the latest message takes about %d tokens, model %s has room for %d", ErrInvalidArgument, total, config.Model.Name, budget)
+	}
+	report.HistoryTokens = total
+
+	messages := append([]domain.Message(nil), history[:pinned]...)
+	for _, unit := range units[first:] {
+		messages = append(messages, unit...)
+	}
+	return messages, report, nil
+}
+
+// isReply reports whether a message answers an earlier one
+func isReply(m domain.Message) bool {
+	return m.Role == domain.RoleAssistant || m.Role == domain.RoleTool
+}
+
+// contextBudget is the room left for history in the model's context window, less the estimate margin
+// the reply gets the config's output limit, or the model's, but at most half the window, some models could
+// otherwise spend all of it on output
+func contextBudget(config domain.AIConfig, tok llm.TokenEstimator, tools []llm.Tool) int {
+	window := int(config.Model.ContextWindow)
+	output := int(config.Model.MaxOutputTokens)
+	if config.Params.MaxOutputTokens != nil {
+		output = int(*config.Params.MaxOutputTokens)
+	}
+	output = min(output, window/2)
+
+	margin := window * estimateMarginPercent / 100
+	return max(window-margin-output-tok.Estimate(config.SystemPrompt)-llm.EstimateTools(tok, tools), 0)
+}
+
+// historyUnits groups a history into messages that are kept or dropped together, a message and the tool results after it
+func historyUnits(history []domain.Message) [][]domain.Message {
+	var units [][]domain.Message
+	for _, m := range history {
+		if m.Role == domain.RoleTool && len(units) > 0 {
+			units[len(units)-1] = append(units[len(units)-1], m)
+			continue
+		}
+		units = append(units, []domain.Message{m})
+	}
+	return units
+}
+
+// unitTokens estimates the tokens of a group of messages
+func unitTokens(tok llm.TokenEstimator, unit []domain.Message) int {
+	var n int
+	for _, m := range unit {
+		n += llm.EstimateMessage(tok, m)
+	}
+	return n
+}
+
+// truncateUnit shortens the text and tool results of a group of messages, returning how many were cut
+// tool call arguments stay whole, they have to remain valid json
+func truncateUnit(tok llm.TokenEstimator, unit []domain.Message) ([]domain.Message, int) {
+	shortened := make([]domain.Message, len(unit))
+	var truncated int
+	for i, m := range unit {
+		cut := false
+		if text, ok := truncateText(tok, m.Content.Text); ok {
+			m.Content.Text = text
+			cut = true
+		}
+		if r := m.Content.ToolResult; r != nil {
+			if content, ok := truncateText(tok, r.Content); ok {
+				result := *r
+				result.Content = content
+				m.Content.ToolResult = &result
+				cut = true
+			}
+		}
+		if cut {
+			truncated++
+		}
+		shortened[i] = m
+	}
+	return shortened, truncated
+}
+
+// truncateText keeps about truncatedTokens of text, reporting whether it was cut
+func truncateText(tok llm.TokenEstimator, text string) (string, bool) {
+	tokens := tok.Estimate(text)
+	if tokens <= truncatedTokens {
+		return text, false
+	}
+	runes := []rune(text)
+	return string(runes[:len(runes)*truncatedTokens/tokens]) + truncatedMarker, true
+}
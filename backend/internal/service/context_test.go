@@ -0,0 +1,243 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+)
+
+// charEstimator counts a token per character, so a message of n characters costs n plus the overhead
+type charEstimator struct{}
+
+func (charEstimator) Estimate(text string) int { return len([]rune(text)) }
+
+// overhead is what every message costs on top of its content
+var overhead = llm.EstimateMessage(charEstimator{}, domain.Message{})
+
+// msg is a message whose text costs size tokens
+func msg(role domain.Role, text string, size int) domain.Message {
+	return domain.Message{Role: role, Content: domain.MessageContent{Text: text + strings.Repeat(".", size-len(text))}}
+}
+
+// texts lists the messages by the start of their text
+func texts(messages []domain.Message) string {
+	var names []string
+	for _, m := range messages {
+		names = append(names, strings.TrimRight(m.Content.Text, "."))
+	}
+	return strings.Join(names, " ")
+}
+
+// window is a config whose context window leaves room for exactly budget tokens of history
+func window(budget int) domain.AIConfig {
+	return domain.AIConfig{Model: domain.Model{Name: "test", ContextWindow: int32(windowFor(budget))}, ContextStrategy: domain.ContextDropOldest}
+}
+
+// windowFor is the smallest context window with budget tokens left once the estimate margin is taken off
+func windowFor(budget int) int {
+	w := budget
+	for w-w*estimateMarginPercent/100 < budget {
+		w++
+	}
+	return w
+}
+
+func TestHistoryUnits(t *testing.T) {
+	call := domain.Message{Role: domain.RoleAssistant, Content: domain.MessageContent{Text: "call", ToolCalls: []domain.ToolCall{{ID: "1"}, {ID: "2"}}}}
+	history := []domain.Message{
+		msg(domain.RoleTool, "orphan", 10),
+		msg(domain.RoleUser, "u1", 10),
+		call,
+		msg(domain.RoleTool, "r1", 10),
+		msg(domain.RoleTool, "r2", 10),
+		msg(domain.RoleUser, "u2", 10),
+	}
+	var got []string
+	for _, unit := range historyUnits(history) {
+		got = append(got, texts(unit))
+	}
+	// a result without its call in the history stands on its own
+	want := []string{"orphan", "u1", "call r1 r2", "u2"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got units %q, want %q", got, want)
+	}
+}
+
+func TestContextBudget(t *testing.T) {
+	tok := charEstimator{}
+	tools := []llm.Tool{{Name: "search", Description: "searches the web"}}
+	toolTokens := llm.EstimateTools(tok, tools)
+	limit := int32(100)
+
+	tests := []struct {
+		name   string
+		config domain.AIConfig
+		want   int
+	}{
+		// a tenth of the window is kept free in every case, the estimates may come out low
+		{"model's output limit", domain.AIConfig{Model: domain.Model{ContextWindow: 1000, MaxOutputTokens: 200}}, 700},
+		{"output at most half the window", domain.AIConfig{Model: domain.Model{ContextWindow: 1000, MaxOutputTokens: 900}}, 400},
+		{"config's output limit", domain.AIConfig{Model: domain.Model{ContextWindow: 1000, MaxOutputTokens: 200}, Params: domain.GenerationParams{MaxOutputTokens: &limit}}, 800},
+		{"system prompt", domain.AIConfig{Model: domain.Model{ContextWindow: 1000}, SystemPrompt: "you are io"}, 890},
+		{"nothing left", domain.AIConfig{Model: domain.Model{ContextWindow: 10}, SystemPrompt: "a long system prompt"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contextBudget(tt.config, tok, nil); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	config := domain.AIConfig{Model: domain.Model{ContextWindow: 1000}}
+	if got := contextBudget(config, tok, tools); got != 900-toolTokens {
+		t.Errorf("got %d with tools, want %d", got, 900-toolTokens)
+	}
+}
+
+func TestFitContext(t *testing.T) {
+	size := 20 - overhead // every message costs 20 tokens
+	history := []domain.Message{
+		msg(domain.RoleUser, "u1", size),
+		msg(domain.RoleAssistant, "a1", size),
+		msg(domain.RoleUser, "u2", size),
+		msg(domain.RoleAssistant, "a2", size),
+		msg(domain.RoleUser, "u3", size),
+	}
+
+	tests := []struct {
+		name    string
+		budget  int
+		want    string
+		dropped int
+	}{
+		{"fits", 100, "u1 a1 u2 a2 u3", 0},
+		{"drops the oldest", 60, "u2 a2 u3", 2},
+		// dropping u1 alone would fit, but the history would open with a1
+		{"doesn't open with a reply", 80, "u2 a2 u3", 2},
+		{"only the latest", 20, "u3", 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, report, err := fitContext(history, window(tt.budget), charEstimator{}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if texts(got) != tt.want {
+				t.Errorf("got %q, want %q", texts(got), tt.want)
+			}
+			if report.DroppedMessages != tt.dropped || report.BudgetTokens != tt.budget || report.HistoryTokens != 20*len(got) {
+				t.Errorf("got report %+v", report)
+			}
+		})
+	}
+}
+
+func TestFitContextUnknownWindow(t *testing.T) {
+	history := []domain.Message{msg(domain.RoleUser, "u1", 1000), msg(domain.RoleAssistant, "a1", 1000), msg(domain.RoleUser, "u2", 1000)}
+	got, report, err := fitContext(history, domain.AIConfig{}, charEstimator{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || report.BudgetTokens != 0 || report.HistoryTokens != 3*(1000+overhead) {
+		t.Errorf("got %q and report %+v, want everything", texts(got), report)
+	}
+}
+
+func TestFitContextPinsLeadingSystemMessages(t *testing.T) {
+	size := 20 - overhead
+	history := []domain.Message{
+		msg(domain.RoleSystem, "summary", size),
+		msg(domain.RoleDeveloper, "rules", size),
+		msg(domain.RoleUser, "u1", size),
+		msg(domain.RoleAssistant, "a1", size),
+		msg(domain.RoleUser, "u2", size),
+	}
+	got, report, err := fitContext(history, window(60), charEstimator{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if texts(got) != "summary rules u2" || report.DroppedMessages != 2 {
+		t.Errorf("got %q and report %+v", texts(got), report)
+	}
+
+	// a history of only a system message has nothing to pin, it is the latest message
+	got, _, err = fitContext(history[:1], window(20), charEstimator{}, nil)
+	if err != nil || texts(got) != "summary" {
+		t.Errorf("got %q, %v", texts(got), err)
+	}
+}
+
+func TestFitContextKeepsToolResultsWithTheirCall(t *testing.T) {
+	size := 20 - overhead
+	call := msg(domain.RoleAssistant, "call", size)
+	call.Content.ToolCalls = []domain.ToolCall{{ID: "1", Name: "search", Arguments: "{}"}}
+	callSize := llm.EstimateMessage(charEstimator{}, call)
+	history := []domain.Message{
+		msg(domain.RoleUser, "u1", size),
+		msg(domain.RoleAssistant, "a1", size),
+		msg(domain.RoleUser, "u2", size),
+		call,
+		msg(domain.RoleTool, "r1", size),
+		msg(domain.RoleTool, "r2", size),
+	}
+
+	// the call and its results are the latest message, they stay whole
+	got, _, err := fitContext(history, window(callSize+40), charEstimator{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if texts(got) != "call r1 r2" {
+		t.Errorf("got %q", texts(got))
+	}
+
+	// and don't fit in less
+	_, _, err = fitContext(history, window(callSize+39), charEstimator{}, nil)
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("got %v, want the latest message to be too long", err)
+	}
+}
+
+func TestFitContextTruncatesOldest(t *testing.T) {
+	long := 500
+	history := []domain.Message{
+		msg(domain.RoleUser, "u1", long),
+		msg(domain.RoleAssistant, "a1", long),
+		msg(domain.RoleUser, "u2", long),
+	}
+	truncated := overhead + truncatedTokens + len(truncatedMarker)
+
+	config := window(2*truncated + overhead + long)
+	config.ContextStrategy = domain.ContextTruncateOldest
+	got, report, err := fitContext(history, config, charEstimator{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || report.TruncatedMessages != 2 || report.DroppedMessages != 0 {
+		t.Fatalf("got %d messages and report %+v", len(got), report)
+	}
+	for _, m := range got[:2] {
+		if !strings.HasSuffix(m.Content.Text, truncatedMarker) || len(m.Content.Text) != truncatedTokens+len(truncatedMarker) {
+			t.Errorf("got %q, want it cut to %d characters", m.Content.Text, truncatedTokens)
+		}
+	}
+	// the latest message is never shortened, and the history it came from is left alone
+	if got[2].Content.Text != history[2].Content.Text || strings.HasSuffix(history[0].Content.Text, truncatedMarker) {
+		t.Error("truncated the latest message or the caller's history")
+	}
+
+	// what still doesn't fit once shortened is dropped
+	config = window(truncated + overhead + long)
+	config.ContextStrategy = domain.ContextTruncateOldest
+	got, report, err = fitContext(history, config, charEstimator{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// dropping u1 leaves a1 at the start, so it goes too
+	if texts(got) != "u2" || report.DroppedMessages != 2 {
+		t.Errorf("got %q and report %+v", texts(got), report)
+	}
+}
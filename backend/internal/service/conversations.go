@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ListConversations returns the conversations a user participates in, most recently updated first
+func (s *Service) ListConversations(ctx context.Context, externalUserID string) ([]domain.Conversation, error) {
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetUserConversations(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	conversations := make([]domain.Conversation, len(rows))
+	for i, row := range rows {
+		conversations[i] = domain.ConversationFromDB(row)
+	}
+	return conversations, nil
+}
+
+// LoadConversation returns a conversation and its full message history
+func (s *Service) LoadConversation(ctx context.Context, conversationID, externalUserID string) (domain.Conversation, []domain.Message, error) {
+	conversation, err := s.authorizedConversation(ctx, conversationID, externalUserID)
+	if err != nil {
+		return domain.Conversation{}, nil, err
+	}
+
+	messages, err := s.history(ctx, conversation.ID)
+	if err != nil {
+		return domain.Conversation{}, nil, err
+	}
+
+	return conversation, messages, nil
+}
+
+// DeleteConversation deletes a conversation along with its messages and participants
+func (s *Service) DeleteConversation(ctx context.Context, conversationID, externalUserID string) error {
+	conversation, err := s.authorizedConversation(ctx, conversationID, externalUserID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.queries.DeleteConversation(ctx, conversation.ID); err != nil {
+		return fmt.Errorf("failed to delete conversation: %w", err)
+	}
+	return nil
+}
+
+// authorizedConversation fetches a conversation and checks that the user participates in it
+func (s *Service) authorizedConversation(ctx context.Context, conversationID, externalUserID string) (domain.Conversation, error) {
+	cid, err := uuid.Parse(conversationID)
+	if err != nil {
+		return domain.Conversation{}, fmt.Errorf("%w: invalid conversation_id", ErrInvalidArgument)
+	}
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return domain.Conversation{}, err
+	}
+
+	conversation, err := s.queries.GetConversation(ctx, cid)
+	if err != nil {
+		return domain.Conversation{}, notFound(err, "conversation")
+	}
+
+	ok, err := s.queries.IsParticipant(ctx, database.IsParticipantParams{
+		ConversationID: cid,
+		UserID:         uid,
+	})
+	if err != nil {
+		return domain.Conversation{}, fmt.Errorf("failed to check participants: %w", err)
+	}
+	if !ok {
+		return domain.Conversation{}, fmt.Errorf("%w: not a participant of this conversation", ErrPermissionDenied)
+	}
+
+	return domain.ConversationFromDB(conversation), nil
+}
+
+// history loads all messages of a conversation as domain messages, oldest first
+func (s *Service) history(ctx context.Context, conversationID uuid.UUID) ([]domain.Message, error) {
+	rows, err := s.queries.GetMessagesByConversation(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load messages: %w", err)
+	}
+
+	messages := make([]domain.Message, len(rows))
+	for i, row := range rows {
+		messages[i] = domain.MessageFromDB(row)
+	}
+	return messages, nil
+}
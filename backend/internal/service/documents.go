@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// UploadDocument stores a text document for a user and embeds it before returning,
+// so it is retrieved from the next message on. it needs semantic search to be on, see EnableEmbeddings
+func (s *Service) UploadDocument(ctx context.Context, externalUserID, name, content string) (domain.Document, error) {
+	if s.embeddings == nil {
+		return domain.Document{}, fmt.Errorf("%w: semantic search is off, documents would never be retrieved", ErrFailedPrecondition)
+	}
+	if strings.TrimSpace(name) == "" {
+		return domain.Document{}, fmt.Errorf("%w: name is required", ErrInvalidArgument)
+	}
+	if strings.TrimSpace(content) == "" {
+		return domain.Document{}, fmt.Errorf("%w: content is required", ErrInvalidArgument)
+	}
+	user, err := s.ensureUser(ctx, externalUserID)
+	if err != nil {
+		return domain.Document{}, err
+	}
+
+	document, err := s.queries.CreateDocument(ctx, database.CreateDocumentParams{
+		UserID:  user.ID,
+		Name:    strings.TrimSpace(name),
+		Content: content,
+	})
+	if err != nil {
+		return domain.Document{}, fmt.Errorf("failed to store document: %w", err)
+	}
+	if err := s.embed(ctx, documentChunks(document)); err != nil {
+		// a half embedded document would be retrieved in parts, without it the upload can just be retried
+		if _, derr := s.queries.DeleteDocument(ctx, document.ID); derr != nil {
+			return domain.Document{}, fmt.Errorf("%v, and failed to remove the document: %w", err, derr)
+		}
+		return domain.Document{}, err
+	}
+	return domain.DocumentFromDB(document), nil
+}
+
+// ListDocuments returns a user's documents, newest first
+func (s *Service) ListDocuments(ctx context.Context, externalUserID string) ([]domain.Document, error) {
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.ListDocuments(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	documents := make([]domain.Document, len(rows))
+	for i, row := range rows {
+		documents[i] = domain.DocumentFromDB(row)
+	}
+	return documents, nil
+}
+
+// DeleteDocument removes one of a user's documents along with its embeddings
+func (s *Service) DeleteDocument(ctx context.Context, documentID, externalUserID string) error {
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid document_id", ErrInvalidArgument)
+	}
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return err
+	}
+
+	document, err := s.queries.GetDocument(ctx, id)
+	if err != nil {
+		return notFound(err, "document")
+	}
+	if document.UserID != uid {
+		return fmt.Errorf("%w: not your document", ErrPermissionDenied)
+	}
+	if _, err := s.queries.DeleteDocument(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete document: %w", err)
+	}
+	return nil
+}
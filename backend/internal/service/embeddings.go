@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/curator4/io/backend/internal/pgvector"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultRetrievalTopK is how many chunks at most are retrieved for a message
+	defaultRetrievalTopK = 5
+	// defaultRetrievalMaxDistance is the cosine distance beyond which a chunk isn't considered related
+	defaultRetrievalMaxDistance = 0.6
+	// chunkWords and chunkOverlap size the pieces text is embedded in, consecutive chunks share chunkOverlap words
+	chunkWords   = 200
+	chunkOverlap = 40
+	// embedBatch is how many chunks go to the embedder at once
+	embedBatch = 64
+	// embedTimeout bounds embedding an exchange in the background
+	embedTimeout = time.Minute
+
+	// retrievedIntro heads the retrieved chunks in the system prompt
+	retrievedIntro = "Related passages from earlier conversations, memories and documents, they may or may not matter here:\n"
+)
+
+// EmbeddingOptions turn on semantic search: messages, memories and documents are embedded as they are stored,
+// and the chunks nearest to each new message go along with the system prompt
+type EmbeddingOptions struct {
+	Embedder    llm.Embedder
+	TopK        int     // chunks retrieved per message, defaults to 5
+	MaxDistance float64 // cosine distance beyond which a chunk is left out, defaults to 0.6
+}
+
+// EnableEmbeddings turns on semantic search, see EmbeddingOptions
+// only what is written from then on gets embedded, BackfillEmbeddings catches up on the rest
+func (s *Service) EnableEmbeddings(opts EmbeddingOptions) {
+	if opts.TopK <= 0 {
+		opts.TopK = defaultRetrievalTopK
+	}
+	if opts.MaxDistance <= 0 {
+		opts.MaxDistance = defaultRetrievalMaxDistance
+	}
+	s.embeddings = &opts
+}
+
+// chunk is a piece of a message, memory or document with its embedding
+// exactly one of the source ids is set, like on the embeddings table
+type chunk struct {
+	messageID, memoryID, documentID uuid.NullUUID
+	index                           int32
+	content                         string
+}
+
+// chunkText splits text into overlapping chunks of about chunkWords words
+func chunkText(text string) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+	var chunks []string
+	for start := 0; ; start += chunkWords - chunkOverlap {
+		end := min(start+chunkWords, len(words))
+		chunks = append(chunks, strings.Join(words[start:end], " "))
+		if end == len(words) {
+			return chunks
+		}
+	}
+}
+
+// sourceChunks chunks the text of one source, set builds each chunk with the source's id
+func sourceChunks(text string, set func(*chunk)) []chunk {
+	pieces := chunkText(text)
+	chunks := make([]chunk, len(pieces))
+	for i, piece := range pieces {
+		chunks[i] = chunk{index: int32(i), content: piece}
+		set(&chunks[i])
+	}
+	return chunks
+}
+
+// messageChunks chunks the text of messages, tool calls, tool results and media aren't embedded
+func messageChunks(messages []domain.Message) []chunk {
+	var chunks []chunk
+	for _, m := range messages {
+		if m.Role != domain.RoleUser && m.Role != domain.RoleAssistant {
+			continue
+		}
+		chunks = append(chunks, sourceChunks(m.Content.Text, func(c *chunk) {
+			c.messageID = uuid.NullUUID{UUID: m.ID, Valid: true}
+		})...)
+	}
+	return chunks
+}
+
+// memoryChunks chunks a memory, which is mostly a single sentence
+func memoryChunks(m database.Memory) []chunk {
+	return sourceChunks(m.Content, func(c *chunk) {
+		c.memoryID = uuid.NullUUID{UUID: m.ID, Valid: true}
+	})
+}
+
+// documentChunks chunks a document
+func documentChunks(d database.Document) []chunk {
+	return sourceChunks(d.Content, func(c *chunk) {
+		c.documentID = uuid.NullUUID{UUID: d.ID, Valid: true}
+	})
+}
+
+// embed embeds chunks in batches and stores them
+func (s *Service) embed(ctx context.Context, chunks []chunk) error {
+	embedder := s.embeddings.Embedder
+	for start := 0; start < len(chunks); start += embedBatch {
+		batch := chunks[start:min(start+embedBatch, len(chunks))]
+		texts := make([]string, len(batch))
+		for i, c := range batch {
+			texts[i] = c.content
+		}
+
+		vectors, err := embedder.Embed(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("embedder error: %w", err)
+		}
+		if len(vectors) != len(batch) {
+			return fmt.Errorf("embedder returned %d vectors for %d texts", len(vectors), len(batch))
+		}
+		for i, c := range batch {
+			err := s.queries.CreateEmbedding(ctx, database.CreateEmbeddingParams{
+				MessageID:  c.messageID,
+				MemoryID:   c.memoryID,
+				DocumentID: c.documentID,
+				ChunkIndex: c.index,
+				Content:    c.content,
+				Embedder:   embedder.Name(),
+				Embedding:  pgvector.Vector(vectors[i]),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to store embedding: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// embedLater embeds chunks in the background, if semantic search is on
+func (s *Service) embedLater(chunks []chunk) {
+	if s.embeddings == nil || len(chunks) == 0 {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), embedTimeout)
+		defer cancel()
+		if err := s.embed(ctx, chunks); err != nil {
+			log.Printf("failed to embed: %v", err)
+		}
+	}()
+}
+
+// reembedMemoryLater replaces a memory's embeddings after its content changed
+func (s *Service) reembedMemoryLater(ctx context.Context, m database.Memory) error {
+	if s.embeddings == nil {
+		return nil
+	}
+	if err := s.queries.DeleteMemoryEmbeddings(ctx, uuid.NullUUID{UUID: m.ID, Valid: true}); err != nil {
+		return fmt.Errorf("failed to delete memory embeddings: %w", err)
+	}
+	s.embedLater(memoryChunks(m))
+	return nil
+}
+
+// BackfillEmbeddings embeds the messages, memories and documents the configured embedder hasn't seen,
+// like everything from before semantic search was turned on or the embedder changed
+func (s *Service) BackfillEmbeddings(ctx context.Context) error {
+	if s.embeddings == nil {
+		return nil
+	}
+	name := s.embeddings.Embedder.Name()
+
+	var total int
+	for {
+		messages, err := s.queries.ListUnembeddedMessages(ctx, database.ListUnembeddedMessagesParams{Embedder: name, Limit: embedBatch})
+		if err != nil {
+			return fmt.Errorf("failed to list messages: %w", err)
+		}
+		var chunks []chunk
+		for _, row := range messages {
+			var content domain.MessageContent
+			if err := json.Unmarshal(row.Content, &content); err != nil {
+				return fmt.Errorf("failed to unmarshal message %s: %w", row.ID, err)
+			}
+			chunks = append(chunks, messageChunks([]domain.Message{{ID: row.ID, Role: domain.Role(row.Role), Content: content}})...)
+		}
+
+		memories, err := s.queries.ListUnembeddedMemories(ctx, database.ListUnembeddedMemoriesParams{Embedder: name, Limit: embedBatch})
+		if err != nil {
+			return fmt.Errorf("failed to list memories: %w", err)
+		}
+		for _, m := range memories {
+			chunks = append(chunks, memoryChunks(m)...)
+		}
+
+		documents, err := s.queries.ListUnembeddedDocuments(ctx, database.ListUnembeddedDocumentsParams{Embedder: name, Limit: embedBatch})
+		if err != nil {
+			return fmt.Errorf("failed to list documents: %w", err)
+		}
+		for _, d := range documents {
+			chunks = append(chunks, documentChunks(d)...)
+		}
+
+		// sources that chunk to nothing stay unembedded, they'd come back every round
+		if len(chunks) == 0 {
+			if total > 0 {
+				log.Printf("embedded %d chunks with %s", total, name)
+			}
+			return nil
+		}
+		if err := s.embed(ctx, chunks); err != nil {
+			return err
+		}
+		total += len(chunks)
+	}
+}
+
+// retrieve finds the chunks nearest to a message that its user may see, leaving out the messages
+// of the history and the memories that are already in the prompt
+func (s *Service) retrieve(ctx context.Context, userID uuid.UUID, text string, history []domain.Message, recalled []database.Memory) ([]database.SearchEmbeddingsRow, error) {
+	if s.embeddings == nil || strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	vectors, err := s.embeddings.Embedder.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("embedder error: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embedder returned %d vectors for 1 text", len(vectors))
+	}
+
+	exclude := make([]uuid.UUID, 0, len(history)+len(recalled))
+	for _, m := range history {
+		exclude = append(exclude, m.ID)
+	}
+	for _, m := range recalled {
+		exclude = append(exclude, m.ID)
+	}
+
+	rows, err := s.queries.SearchEmbeddings(ctx, database.SearchEmbeddingsParams{
+		Query:      pgvector.Vector(vectors[0]),
+		Embedder:   s.embeddings.Embedder.Name(),
+		UserID:     userID,
+		Exclude:    exclude,
+		MaxResults: int32(s.embeddings.TopK),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search embeddings: %w", err)
+	}
+
+	related := rows[:0]
+	for _, row := range rows {
+		if row.Distance <= s.embeddings.MaxDistance {
+			related = append(related, row)
+		}
+	}
+	return related, nil
+}
+
+// withRetrieved adds retrieved chunks to a system prompt, each marked with where it came from
+func withRetrieved(prompt string, chunks []database.SearchEmbeddingsRow) string {
+	if len(chunks) == 0 {
+		return prompt
+	}
+	var b strings.Builder
+	if prompt != "" {
+		b.WriteString(prompt)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(retrievedIntro)
+	for _, c := range chunks {
+		source := "earlier message"
+		switch {
+		case c.MemoryID.Valid:
+			source = "memory"
+		case c.DocumentID.Valid:
+			source = "document " + c.DocumentName.String
+		}
+		fmt.Fprintf(&b, "- [%s] %s\n", source, c.Content)
+	}
+	return strings.TrimSpace(b.String())
+}
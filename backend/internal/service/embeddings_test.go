@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/curator4/io/backend/internal/pgvector"
+	"github.com/google/uuid"
+)
+
+// words makes text of n numbered words
+func words(n int) string {
+	w := make([]string, n)
+	for i := range w {
+		w[i] = fmt.Sprintf("w%d", i)
+	}
+	return strings.Join(w, " ")
+}
+
+func TestChunkText(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		sizes []int // words per chunk
+	}{
+		{"empty", "", nil},
+		{"only whitespace", " \t\n  ", nil},
+		{"short", "hello  there\nworld", []int{3}},
+		{"exactly one chunk", words(chunkWords), []int{chunkWords}},
+		{"one word over", words(chunkWords + 1), []int{chunkWords, chunkOverlap + 1}},
+		{"several", words(450), []int{200, 200, 130}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := chunkText(tt.text)
+			if len(chunks) != len(tt.sizes) {
+				t.Fatalf("got %d chunks, want %d", len(chunks), len(tt.sizes))
+			}
+			for i, c := range chunks {
+				if n := len(strings.Fields(c)); n != tt.sizes[i] {
+					t.Errorf("chunk %d has %d words, want %d", i, n, tt.sizes[i])
+				}
+			}
+		})
+	}
+}
+
+func TestChunkTextOverlaps(t *testing.T) {
+	chunks := chunkText(words(450))
+	for i := 1; i < len(chunks); i++ {
+		prev, next := strings.Fields(chunks[i-1]), strings.Fields(chunks[i])
+		tail := strings.Join(prev[len(prev)-chunkOverlap:], " ")
+		head := strings.Join(next[:chunkOverlap], " ")
+		if tail != head {
+			t.Errorf("chunk %d doesn't start with the last %d words of chunk %d", i, chunkOverlap, i-1)
+		}
+	}
+	if last := strings.Fields(chunks[len(chunks)-1]); last[len(last)-1] != "w449" {
+		t.Errorf("the last chunk ends in %q, want the last word", last[len(last)-1])
+	}
+}
+
+// searchColumns are the columns of SearchEmbeddings
+var searchColumns = []string{"id", "message_id", "memory_id", "document_id", "content", "document_name", "distance"}
+
+func TestRetrieve(t *testing.T) {
+	db, s := newFakeDB(t)
+	s.EnableEmbeddings(EmbeddingOptions{Embedder: llm.FakeEmbedder{}, TopK: 3, MaxDistance: 0.5})
+
+	user := uuid.New()
+	history := []domain.Message{{ID: uuid.New()}, {ID: uuid.New()}}
+	recalled := []database.Memory{{ID: uuid.New()}}
+	message, memory, document := uuid.New(), uuid.New(), uuid.New()
+	db.on("SearchEmbeddings", func([]any) (*fakeRows, error) {
+		return rows(searchColumns,
+			[]any{uuid.New().String(), message.String(), nil, nil, "we talked about boats", nil, 0.12},
+			[]any{uuid.New().String(), nil, memory.String(), nil, "the user sails", nil, 0.5},
+			[]any{uuid.New().String(), nil, nil, document.String(), "hull maintenance", "manual.pdf", 0.71},
+		), nil
+	})
+
+	related, err := s.retrieve(context.Background(), user, "how do i fix my boat", history, recalled)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the chunk beyond MaxDistance is dropped, the one right at it stays
+	if len(related) != 2 || related[0].MessageID.UUID != message || related[1].MemoryID.UUID != memory {
+		t.Errorf("got %+v", related)
+	}
+
+	calls := db.called("SearchEmbeddings")
+	if len(calls) != 1 {
+		t.Fatalf("got %d searches, want 1", len(calls))
+	}
+	args := calls[0].args
+	vectors, _ := llm.FakeEmbedder{}.Embed(context.Background(), []string{"how do i fix my boat"})
+	want, _ := pgvector.Vector(vectors[0]).Value()
+	if args[0] != want {
+		t.Error("the search didn't use the message's embedding")
+	}
+	if args[1] != "fake" || args[2] != user.String() || args[4] != int64(3) {
+		t.Errorf("got embedder %v, user %v and limit %v", args[1], args[2], args[4])
+	}
+	exclude := fmt.Sprint(args[3])
+	for _, id := range []uuid.UUID{history[0].ID, history[1].ID, recalled[0].ID} {
+		if !strings.Contains(exclude, id.String()) {
+			t.Errorf("%s isn't excluded from %s", id, exclude)
+		}
+	}
+}
+
+func TestRetrieveSkips(t *testing.T) {
+	// no handlers, any query fails the test
+	_, s := newFakeDB(t)
+	related, err := s.retrieve(context.Background(), uuid.New(), "anything", nil, nil)
+	if err != nil || related != nil {
+		t.Errorf("retrieved %v, %v with semantic search off", related, err)
+	}
+
+	s.EnableEmbeddings(EmbeddingOptions{Embedder: llm.FakeEmbedder{}})
+	related, err = s.retrieve(context.Background(), uuid.New(), " \n ", nil, nil)
+	if err != nil || related != nil {
+		t.Errorf("retrieved %v, %v for a message without text", related, err)
+	}
+}
+
+func TestWithRetrieved(t *testing.T) {
+	chunks := []database.SearchEmbeddingsRow{
+		{MessageID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, Content: "we talked about boats"},
+		{MemoryID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, Content: "the user sails"},
+		{DocumentID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, DocumentName: sql.NullString{String: "manual.pdf", Valid: true}, Content: "hull maintenance"},
+	}
+	list := "- [earlier message] we talked about boats\n" +
+		"- [memory] the user sails\n" +
+		"- [document manual.pdf] hull maintenance"
+
+	tests := []struct {
+		name   string
+		prompt string
+		chunks []database.SearchEmbeddingsRow
+		want   string
+	}{
+		{"nothing retrieved", "you are io", nil, "you are io"},
+		{"after the prompt", "you are io", chunks, "you are io\n\n" + retrievedIntro + list},
+		{"without a prompt", "", chunks, retrievedIntro + list},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withRetrieved(tt.prompt, tt.chunks); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDB stands in for postgres under the sqlc queries, answering each query by its sqlc name
+// queries without a handler fail the test, so a test states every query it expects the service to make
+type fakeDB struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	handlers map[string]fakeHandler
+	calls    []fakeCall
+}
+
+// fakeHandler answers a query, rows are column names and values for :one and :many queries, nil for :exec
+type fakeHandler func(args []any) (*fakeRows, error)
+
+// fakeCall is a query the service made, tx is the transaction it ran in, nil outside of one
+type fakeCall struct {
+	name string
+	args []any
+	tx   *fakeTx
+}
+
+type fakeTx struct {
+	committed, rolledBack bool
+}
+
+// newFakeDB returns the fake and a Service whose queries go to it
+func newFakeDB(t *testing.T) (*fakeDB, *Service) {
+	t.Helper()
+	db := &fakeDB{t: t, handlers: make(map[string]fakeHandler)}
+	sqlDB := sql.OpenDB(fakeConnector{db})
+	t.Cleanup(func() { sqlDB.Close() })
+	return db, New(sqlDB, nil, nil)
+}
+
+// on answers the named query with handler
+func (db *fakeDB) on(name string, handler fakeHandler) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.handlers[name] = handler
+}
+
+// called returns the calls made to the named query
+func (db *fakeDB) called(name string) []fakeCall {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var calls []fakeCall
+	for _, c := range db.calls {
+		if c.name == name {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// query runs a query through its handler
+func (db *fakeDB) query(query string, args []driver.NamedValue, tx *fakeTx) (*fakeRows, error) {
+	name := queryName(query)
+	values := make([]any, len(args))
+	for i, a := range args {
+		values[i] = a.Value
+	}
+
+	db.mu.Lock()
+	handler, ok := db.handlers[name]
+	db.calls = append(db.calls, fakeCall{name: name, args: values, tx: tx})
+	db.mu.Unlock()
+
+	if !ok {
+		db.t.Errorf("unexpected query %s", name)
+		return nil, fmt.Errorf("unexpected query %s", name)
+	}
+	rows, err := handler(values)
+	if rows == nil && err == nil {
+		rows = &fakeRows{}
+	}
+	return rows, err
+}
+
+// queryName reads the name out of sqlc's "-- name: X :kind" header
+func queryName(query string) string {
+	_, rest, ok := strings.Cut(query, "-- name: ")
+	if !ok {
+		return query
+	}
+	name, _, _ := strings.Cut(rest, " ")
+	return name
+}
+
+// fakeRows is a result set
+type fakeRows struct {
+	columns []string
+	values  [][]any
+	next    int
+}
+
+// rows builds a result set, each row has a value per column
+func rows(columns []string, values ...[]any) *fakeRows {
+	return &fakeRows{columns: columns, values: values}
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	for i, v := range r.values[r.next] {
+		dest[i] = v
+	}
+	r.next++
+	return nil
+}
+
+type fakeConnector struct{ db *fakeDB }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: c.db}, nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	db *fakeDB
+	tx *fakeTx
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements aren't supported")
+}
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.tx = &fakeTx{}
+	return fakeConnTx{c}, nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.db.query(query, args, c.tx)
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if _, err := c.db.query(query, args, c.tx); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+type fakeConnTx struct{ conn *fakeConn }
+
+func (t fakeConnTx) Commit() error {
+	t.conn.tx.committed = true
+	t.conn.tx = nil
+	return nil
+}
+
+func (t fakeConnTx) Rollback() error {
+	t.conn.tx.rolledBack = true
+	t.conn.tx = nil
+	return nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/mcphost"
+)
+
+// session states reported by MCPServerStatus
+const (
+	mcpConnected    = "connected"
+	mcpConnecting   = "connecting"   // the first connection attempt is still running
+	mcpReconnecting = "reconnecting" // the session failed and is retrying in the background
+	mcpDisconnected = "disconnected" // there is no session, the server is disabled or was disconnected
+)
+
+// MCPServerStatus is a configured mcp server along with the state of its session
+type MCPServerStatus struct {
+	Server    domain.MCPServer
+	State     string
+	SessionID string
+	Tools     int
+	Resources int
+	Prompts   int
+	LastError string
+}
+
+// ConnectMCPServers connects the mcp host to every enabled server
+// a server that fails to connect is only logged, its session keeps retrying in the background
+func (s *Service) ConnectMCPServers(ctx context.Context) error {
+	rows, err := s.queries.ListEnabledMCPServers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list mcp servers: %w", err)
+	}
+
+	for _, row := range rows {
+		// failures are logged by startMCPSession, and don't keep the other servers from connecting
+		_, _ = s.startMCPSession(ctx, domain.MCPServerFromDB(row))
+	}
+	return nil
+}
+
+// ListMCPServers returns every configured mcp server with its session state, ordered by name
+func (s *Service) ListMCPServers(ctx context.Context) ([]MCPServerStatus, error) {
+	rows, err := s.queries.ListMCPServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mcp servers: %w", err)
+	}
+
+	statuses := make([]MCPServerStatus, len(rows))
+	for i, row := range rows {
+		statuses[i] = s.mcpServerStatus(domain.MCPServerFromDB(row))
+	}
+	return statuses, nil
+}
+
+// ConnectMCPServer starts a session to a configured server, connecting an already connected server is a no-op
+// if the first attempt fails the session keeps retrying in the background, the failure is still reported as unavailable
+func (s *Service) ConnectMCPServer(ctx context.Context, name string) (MCPServerStatus, error) {
+	server, err := s.mcpServer(ctx, name)
+	if err != nil {
+		return MCPServerStatus{}, err
+	}
+	if _, ok := s.mcp.ServerStatus(name); ok {
+		return s.mcpServerStatus(server), nil
+	}
+	return s.startMCPSession(ctx, server)
+}
+
+// DisconnectMCPServer closes the session to a server, until it is connected again or the backend restarts
+func (s *Service) DisconnectMCPServer(ctx context.Context, name string) error {
+	if err := s.mcp.Disconnect(name); err != nil {
+		if errors.Is(err, mcphost.ErrUnknownServer) {
+			return fmt.Errorf("mcp server %s is not connected: %w", name, ErrNotFound)
+		}
+		return err
+	}
+	log.Printf("disconnected from mcp server %s", name)
+	return nil
+}
+
+// RestartMCPServer replaces a server's session with a fresh one, picking up changes to its configuration
+func (s *Service) RestartMCPServer(ctx context.Context, name string) (MCPServerStatus, error) {
+	server, err := s.mcpServer(ctx, name)
+	if err != nil {
+		return MCPServerStatus{}, err
+	}
+	return s.startMCPSession(ctx, server)
+}
+
+// startMCPSession connects the host to server and reports the resulting state
+func (s *Service) startMCPSession(ctx context.Context, server domain.MCPServer) (MCPServerStatus, error) {
+	if err := s.mcp.Connect(ctx, server); err != nil {
+		log.Printf("%v, retrying in the background", err)
+		return s.mcpServerStatus(server), fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	log.Printf("connected to mcp server %s", server.Name)
+	return s.mcpServerStatus(server), nil
+}
+
+// mcpServer looks up a configured server by name
+func (s *Service) mcpServer(ctx context.Context, name string) (domain.MCPServer, error) {
+	if name == "" {
+		return domain.MCPServer{}, fmt.Errorf("%w: name is required", ErrInvalidArgument)
+	}
+	row, err := s.queries.GetMCPServer(ctx, name)
+	if err != nil {
+		return domain.MCPServer{}, notFound(err, "mcp server")
+	}
+	return domain.MCPServerFromDB(row), nil
+}
+
+// mcpServerStatus combines a server's configuration with the host's view of its session
+func (s *Service) mcpServerStatus(server domain.MCPServer) MCPServerStatus {
+	session, ok := s.mcp.ServerStatus(server.Name)
+	if !ok {
+		return MCPServerStatus{Server: server, State: mcpDisconnected}
+	}
+
+	status := MCPServerStatus{
+		Server:    server,
+		SessionID: session.SessionID,
+		Tools:     session.Tools,
+		Resources: session.Resources,
+		Prompts:   session.Prompts,
+		LastError: session.LastError,
+	}
+	switch {
+	case session.Connected:
+		status.State = mcpConnected
+	case session.LastError != "":
+		status.State = mcpReconnecting
+	default:
+		status.State = mcpConnecting
+	}
+	return status
+}
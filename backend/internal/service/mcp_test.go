@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/mcphost"
+	"github.com/google/uuid"
+)
+
+var mcpServerColumns = strings.Fields(`id created_at updated_at name transport command args env enabled url headers`)
+
+// mcpDB serves a single configured server, "docs", over http at url
+func mcpDB(t *testing.T, url string) *Service {
+	db, s := newFakeDB(t)
+	s.mcp = mcphost.NewHost()
+	t.Cleanup(s.mcp.Close)
+	db.on("GetMCPServer", func(args []any) (*fakeRows, error) {
+		if args[0] != "docs" {
+			return rows(mcpServerColumns), nil
+		}
+		now := time.Now()
+		return rows(mcpServerColumns, []any{uuid.NewString(), now, now, "docs", "streamable_http", nil, []byte("{}"), []byte("{}"), true, url, []byte("{}")}), nil
+	})
+	return s
+}
+
+func TestMCPServerErrors(t *testing.T) {
+	s := mcpDB(t, "http://127.0.0.1:1/mcp")
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{"connect without a name", func() error { _, err := s.ConnectMCPServer(ctx, ""); return err }, ErrInvalidArgument},
+		{"connect an unknown server", func() error { _, err := s.ConnectMCPServer(ctx, "wiki"); return err }, ErrNotFound},
+		{"restart an unknown server", func() error { _, err := s.RestartMCPServer(ctx, "wiki"); return err }, ErrNotFound},
+		{"disconnect a server that isn't connected", func() error { return s.DisconnectMCPServer(ctx, "docs") }, ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectMCPServerUnreachable(t *testing.T) {
+	// a port nothing listens on
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	s := mcpDB(t, "http://"+addr+"/mcp")
+	ctx := context.Background()
+
+	// the failure is reported, but the session stays around retrying
+	status, err := s.ConnectMCPServer(ctx, "docs")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("got %v, want %v", err, ErrUnavailable)
+	}
+	if status.State != mcpReconnecting || status.LastError == "" {
+		t.Errorf("got state %q with error %q, want %q with an error", status.State, status.LastError, mcpReconnecting)
+	}
+
+	if err := s.DisconnectMCPServer(ctx, "docs"); err != nil {
+		t.Fatal(err)
+	}
+	if status := s.mcpServerStatus(status.Server); status.State != mcpDisconnected {
+		t.Errorf("got state %q after disconnecting, want %q", status.State, mcpDisconnected)
+	}
+}
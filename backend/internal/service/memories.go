@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// recallLimit is how many memories at most go into the system prompt for a message
+	recallLimit = 8
+	// recallTerms is how many distinct words of a message are searched for
+	recallTerms = 32
+	// memoryTimeout bounds a background extraction run
+	memoryTimeout = time.Minute
+
+	memoryPrompt = `You pick out facts worth remembering long term from a chat exchange: things about the user, like ` +
+		`their name, preferences, plans and the people and things in their life, and lasting facts they teach you that ` +
+		`matter beyond this chat. Skip small talk, one-off requests, what is already known, and anything the assistant ` +
+		`said that the user didn't confirm. Reply with JSON only, {"facts": [{"content": "..."}]}, ` +
+		`each content one short standalone sentence that calls the user "the user". Reply {"facts": []} when there is nothing.`
+	// memoryIntro heads the recalled memories in the system prompt
+	memoryIntro = "Things you remember, they may or may not matter here:\n"
+)
+
+// MemoryOptions turn on extracting memories from conversations
+type MemoryOptions struct {
+	AIConfig string // name of the ai config that picks out the facts, a cheap fast model does fine
+}
+
+// EnableMemories turns on memory extraction, every exchange is looked at in the background
+// recall works either way, memories can also be added through CreateMemory
+func (s *Service) EnableMemories(opts MemoryOptions) {
+	s.memories = &opts
+}
+
+// ListMemories returns a user's memories, followed by the global ones
+func (s *Service) ListMemories(ctx context.Context, externalUserID string) ([]domain.Memory, error) {
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.listMemories(ctx, uuid.NullUUID{UUID: uid, Valid: true})
+}
+
+// CreateMemory adds a memory for a user, content it already has returns the existing memory
+func (s *Service) CreateMemory(ctx context.Context, externalUserID, content string) (domain.Memory, error) {
+	user, err := s.ensureUser(ctx, externalUserID)
+	if err != nil {
+		return domain.Memory{}, err
+	}
+	m, err := s.storeMemory(ctx, uuid.NullUUID{UUID: user.ID, Valid: true}, content, nil)
+	if err != nil {
+		return domain.Memory{}, err
+	}
+	return s.withSources(ctx, m)
+}
+
+// UpdateMemory replaces the content of one of a user's memories
+func (s *Service) UpdateMemory(ctx context.Context, memoryID, externalUserID, content string) (domain.Memory, error) {
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return domain.Memory{}, err
+	}
+	return s.editMemory(ctx, memoryID, uuid.NullUUID{UUID: uid, Valid: true}, content)
+}
+
+// DeleteMemory removes one of a user's memories
+func (s *Service) DeleteMemory(ctx context.Context, memoryID, externalUserID string) error {
+	uid, err := userID(externalUserID)
+	if err != nil {
+		return err
+	}
+	return s.removeMemory(ctx, memoryID, uuid.NullUUID{UUID: uid, Valid: true})
+}
+
+// ListGlobalMemories returns the memories every user's conversations recall
+func (s *Service) ListGlobalMemories(ctx context.Context) ([]domain.Memory, error) {
+	return s.listMemories(ctx, uuid.NullUUID{})
+}
+
+// CreateGlobalMemory adds a memory every user's conversations recall
+func (s *Service) CreateGlobalMemory(ctx context.Context, content string) (domain.Memory, error) {
+	m, err := s.storeMemory(ctx, uuid.NullUUID{}, content, nil)
+	if err != nil {
+		return domain.Memory{}, err
+	}
+	return s.withSources(ctx, m)
+}
+
+// UpdateGlobalMemory replaces the content of a global memory
+func (s *Service) UpdateGlobalMemory(ctx context.Context, memoryID, content string) (domain.Memory, error) {
+	return s.editMemory(ctx, memoryID, uuid.NullUUID{}, content)
+}
+
+// DeleteGlobalMemory removes a global memory
+func (s *Service) DeleteGlobalMemory(ctx context.Context, memoryID string) error {
+	return s.removeMemory(ctx, memoryID, uuid.NullUUID{})
+}
+
+// listMemories returns an owner's memories, and the global ones, with their sources
+// an invalid owner stands for global throughout, it only lists the global memories
+func (s *Service) listMemories(ctx context.Context, owner uuid.NullUUID) ([]domain.Memory, error) {
+	rows, err := s.queries.ListMemories(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memories: %w", err)
+	}
+	ids := make([]uuid.UUID, len(rows))
+	for i, m := range rows {
+		ids[i] = m.ID
+	}
+	sources, err := s.queries.ListMemorySources(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memory sources: %w", err)
+	}
+	byMemory := make(map[uuid.UUID][]uuid.UUID)
+	for _, source := range sources {
+		byMemory[source.MemoryID] = append(byMemory[source.MemoryID], source.MessageID)
+	}
+
+	memories := make([]domain.Memory, len(rows))
+	for i, m := range rows {
+		memories[i] = domain.MemoryFromDB(m, byMemory[m.ID])
+	}
+	return memories, nil
+}
+
+// withSources converts a memory, looking up the messages it was learned from
+func (s *Service) withSources(ctx context.Context, m database.Memory) (domain.Memory, error) {
+	sources, err := s.queries.ListMemorySources(ctx, []uuid.UUID{m.ID})
+	if err != nil {
+		return domain.Memory{}, fmt.Errorf("failed to list memory sources: %w", err)
+	}
+	ids := make([]uuid.UUID, len(sources))
+	for i, source := range sources {
+		ids[i] = source.MessageID
+	}
+	return domain.MemoryFromDB(m, ids), nil
+}
+
+// ownedMemory looks up a memory that has to belong to owner
+func (s *Service) ownedMemory(ctx context.Context, memoryID string, owner uuid.NullUUID) (database.Memory, error) {
+	id, err := uuid.Parse(memoryID)
+	if err != nil {
+		return database.Memory{}, fmt.Errorf("%w: invalid memory_id", ErrInvalidArgument)
+	}
+	m, err := s.queries.GetMemory(ctx, id)
+	if err != nil {
+		return database.Memory{}, notFound(err, "memory")
+	}
+
+	switch {
+	case m.UserID == owner:
+		return m, nil
+	case !owner.Valid:
+		return database.Memory{}, fmt.Errorf("global memory %w", ErrNotFound)
+	case !m.UserID.Valid:
+		return database.Memory{}, fmt.Errorf("%w: global memories are edited through the admin service", ErrPermissionDenied)
+	default:
+		return database.Memory{}, fmt.Errorf("%w: not your memory", ErrPermissionDenied)
+	}
+}
+
+// storeMemory saves a fact for owner, a fact it already has only gets the new sources linked
+func (s *Service) storeMemory(ctx context.Context, owner uuid.NullUUID, content string, sources []uuid.UUID) (database.Memory, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return database.Memory{}, fmt.Errorf("%w: content is required", ErrInvalidArgument)
+	}
+	normalized := normalizeMemory(content)
+
+	find := database.FindMemoryParams{UserID: owner, Normalized: normalized}
+	m, err := s.queries.FindMemory(ctx, find)
+	if errors.Is(err, sql.ErrNoRows) {
+		m, err = s.queries.CreateMemory(ctx, database.CreateMemoryParams{
+			UserID:     owner,
+			Content:    content,
+			Normalized: normalized,
+		})
+		// the same fact stored concurrently, by another extraction run
+		if errors.Is(alreadyExists(err, "memory"), ErrAlreadyExists) {
+			m, err = s.queries.FindMemory(ctx, find)
+		} else if err == nil {
+			s.embedLater(memoryChunks(m))
+		}
+	}
+	if err != nil {
+		return database.Memory{}, fmt.Errorf("failed to store memory: %w", err)
+	}
+
+	for _, messageID := range sources {
+		err := s.queries.AddMemorySource(ctx, database.AddMemorySourceParams{MemoryID: m.ID, MessageID: messageID})
+		if err != nil {
+			return database.Memory{}, fmt.Errorf("failed to link memory source: %w", err)
+		}
+	}
+	return m, nil
+}
+
+// editMemory replaces the content of a memory that has to belong to owner
+func (s *Service) editMemory(ctx context.Context, memoryID string, owner uuid.NullUUID, content string) (domain.Memory, error) {
+	m, err := s.ownedMemory(ctx, memoryID, owner)
+	if err != nil {
+		return domain.Memory{}, err
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return domain.Memory{}, fmt.Errorf("%w: content is required", ErrInvalidArgument)
+	}
+
+	m, err = s.queries.UpdateMemory(ctx, database.UpdateMemoryParams{
+		ID:         m.ID,
+		Content:    content,
+		Normalized: normalizeMemory(content),
+	})
+	if err != nil {
+		return domain.Memory{}, fmt.Errorf("failed to update memory: %w", alreadyExists(err, "memory"))
+	}
+	if err := s.reembedMemoryLater(ctx, m); err != nil {
+		return domain.Memory{}, err
+	}
+	return s.withSources(ctx, m)
+}
+
+// removeMemory deletes a memory that has to belong to owner
+func (s *Service) removeMemory(ctx context.Context, memoryID string, owner uuid.NullUUID) error {
+	m, err := s.ownedMemory(ctx, memoryID, owner)
+	if err != nil {
+		return err
+	}
+	if _, err := s.queries.DeleteMemory(ctx, m.ID); err != nil {
+		return fmt.Errorf("failed to delete memory: %w", err)
+	}
+	return nil
+}
+
+// normalizeMemory folds a memory's content so the same fact worded the same is found again
+// despite case, spacing and a closing full stop
+func normalizeMemory(content string) string {
+	return strings.TrimRight(strings.Join(strings.Fields(strings.ToLower(content)), " "), ".!")
+}
+
+// recall finds the memories a message is about, a user's own and the global ones
+func (s *Service) recall(ctx context.Context, userID uuid.UUID, text string) ([]database.Memory, error) {
+	query := recallQuery(text)
+	if query == "" {
+		return nil, nil
+	}
+	memories, err := s.queries.RecallMemories(ctx, database.RecallMemoriesParams{
+		UserID:     uuid.NullUUID{UUID: userID, Valid: true},
+		Query:      query,
+		MaxResults: recallLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to recall memories: %w", err)
+	}
+	return memories, nil
+}
+
+// recallStopwords are left out of recall queries, they would match nearly every memory.
+// "user" is in there since extracted memories all call the user "the user"
+var recallStopwords = func() map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.Fields(`a about after again all also am an and any are as at be because been before
+		being but by can could did do does doing don for from had has have having he her here hers him his how i if in
+		into is it its just me more most my no not now of off on once only or other our ours out over own same she
+		should so some such than that the their theirs them then there these they this those through to too under
+		until up very was we were what when where which while who whom why will with would you your yours user users`) {
+		words[word] = true
+	}
+	return words
+}()
+
+// recallQuery turns text into a text search query matching any of its words but the stopwords
+// only letters and digits make it in, so the query always parses
+func recallQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool)
+	var terms []string
+	for _, word := range words {
+		if len([]rune(word)) < 2 || seen[word] || recallStopwords[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+		if len(terms) == recallTerms {
+			break
+		}
+	}
+	return strings.Join(terms, " | ")
+}
+
+// withMemories adds recalled memories to a system prompt
+func withMemories(prompt string, memories []database.Memory) string {
+	if len(memories) == 0 {
+		return prompt
+	}
+	var b strings.Builder
+	if prompt != "" {
+		b.WriteString(prompt)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(memoryIntro)
+	for _, m := range memories {
+		fmt.Fprintf(&b, "- %s\n", m.Content)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// rememberLater extracts memories from an exchange in the background, if memory extraction is on
+func (s *Service) rememberLater(userID uuid.UUID, exchange []domain.Message) {
+	if s.memories == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), memoryTimeout)
+		defer cancel()
+		if err := s.remember(ctx, userID, exchange); err != nil {
+			log.Printf("failed to extract memories for user %s: %v", userID, err)
+		}
+	}()
+}
+
+// memoryFact is one fact the extraction model picked out, it always becomes the speaker's own memory,
+// global memories are only ever made through the admin service
+type memoryFact struct {
+	Content string `json:"content"`
+}
+
+// remember has the memory model pick out the facts worth keeping from an exchange and stores them,
+// linked to the exchange's messages. what is already known about the exchange is shown to the model,
+// so it doesn't repeat it in other words
+func (s *Service) remember(ctx context.Context, userID uuid.UUID, exchange []domain.Message) error {
+	config, err := s.aiConfigByName(ctx, s.memories.AIConfig, "memory ai config")
+	if err != nil {
+		return err
+	}
+	config.SystemPrompt = memoryPrompt
+	provider, err := s.providerFor(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	text := transcript(exchange)
+	known, err := s.recall(ctx, userID, text)
+	if err != nil {
+		return err
+	}
+	var input strings.Builder
+	if len(known) > 0 {
+		input.WriteString("Already known:\n")
+		for _, m := range known {
+			fmt.Fprintf(&input, "- %s\n", m.Content)
+		}
+		input.WriteString("\n")
+	}
+	input.WriteString("Exchange:\n")
+	input.WriteString(text)
+
+	reply, err := provider.SendMessage(ctx, []domain.Message{{
+		ConversationID: exchange[0].ConversationID,
+		Role:           domain.RoleUser,
+		Content:        domain.MessageContent{Text: input.String()},
+	}}, config, nil)
+	if err != nil {
+		return fmt.Errorf("provider error: %w", err)
+	}
+	facts, err := parseFacts(reply.Message.Content.Text)
+	if err != nil {
+		return err
+	}
+
+	sources := make([]uuid.UUID, len(exchange))
+	for i, m := range exchange {
+		sources[i] = m.ID
+	}
+	owner := uuid.NullUUID{UUID: userID, Valid: true}
+	for _, fact := range facts {
+		if _, err := s.storeMemory(ctx, owner, fact.Content, sources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseFacts reads the extraction model's reply, tolerating code fences or prose around the json
+func parseFacts(reply string) ([]memoryFact, error) {
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("memory reply has no json: %q", reply)
+	}
+	var parsed struct {
+		Facts []memoryFact `json:"facts"`
+	}
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid memory reply: %w", err)
+	}
+
+	facts := parsed.Facts[:0]
+	for _, fact := range parsed.Facts {
+		if strings.TrimSpace(fact.Content) != "" {
+			facts = append(facts, fact)
+		}
+	}
+	return facts, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var memoryColumns = strings.Fields(`id user_id content normalized created_at updated_at`)
+
+func TestRecallQuery(t *testing.T) {
+	tests := []struct {
+		name, text, want string
+	}{
+		{"empty", "", ""},
+		{"words are or'd", "Hiking plans", "hiking | plans"},
+		{"punctuation splits words", "coffee,tea;don't", "coffee | tea"},
+		{"repeats and single letters are dropped", "a b cat Cat c", "cat"},
+		{"stopwords are dropped", "what do you know about my dog", "know | dog"},
+		// every memory says "the user", searching for it would recall them all
+		{"user is a stopword", "the user likes the users of Rust", "likes | rust"},
+		{"only stopwords", "what is it about the user", ""},
+		{"numbers stay", "flight at 1730", "flight | 1730"},
+		{"other scripts stay", "über straße", "über | straße"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := recallQuery(tt.text); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecallQueryCapsTerms(t *testing.T) {
+	words := make([]string, recallTerms+10)
+	for i := range words {
+		words[i] = "w" + strings.Repeat("x", i)
+	}
+	if got := strings.Count(recallQuery(strings.Join(words, " ")), "|") + 1; got != recallTerms {
+		t.Errorf("got %d terms, want %d", got, recallTerms)
+	}
+}
+
+func TestRecall(t *testing.T) {
+	db, s := newFakeDB(t)
+	uid := uuid.New()
+	db.on("RecallMemories", func(args []any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(memoryColumns,
+			[]any{uuid.NewString(), uid.String(), "The user has a dog named Rex.", "the user has a dog named rex", now, now},
+			[]any{uuid.NewString(), nil, "The office closes at 6.", "the office closes at 6", now, now},
+		), nil
+	})
+
+	memories, err := s.recall(context.Background(), uid, "What is my dog called?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(memories) != 2 || memories[1].UserID.Valid {
+		t.Errorf("got memories %+v", memories)
+	}
+	calls := db.called("RecallMemories")
+	if len(calls) != 1 {
+		t.Fatalf("recalled %d times, want once", len(calls))
+	}
+	if args := calls[0].args; args[1] != "dog | called" || args[2] != int64(recallLimit) {
+		t.Errorf("got query %v limited to %v, want %q limited to %d", args[1], args[2], "dog | called", recallLimit)
+	}
+
+	// nothing worth searching for, the database isn't asked
+	if memories, err := s.recall(context.Background(), uid, "what about the user?"); err != nil || memories != nil {
+		t.Errorf("got %v, %v", memories, err)
+	}
+	if n := len(db.called("RecallMemories")); n != 1 {
+		t.Errorf("recalled %d times, want once", n)
+	}
+}
+
+func TestParseFacts(t *testing.T) {
+	tests := []struct {
+		name, reply string
+		want        []string
+		wantErr     bool
+	}{
+		{"plain json", `{"facts": [{"content": "The user likes tea."}]}`, []string{"The user likes tea."}, false},
+		{"no facts", `{"facts": []}`, nil, false},
+		{"code fence", "```json\n{\"facts\": [{\"content\": \"The user lives in Oslo.\"}]}\n```", []string{"The user lives in Oslo."}, false},
+		{"prose around", `Sure! {"facts": [{"content": "a"}, {"content": "b"}]} Hope that helps.`, []string{"a", "b"}, false},
+		{"blank facts are dropped", `{"facts": [{"content": "  "}, {"content": "kept"}, {}]}`, []string{"kept"}, false},
+		{"no json", "nothing to remember", nil, true},
+		{"broken json", `{"facts": [{"content": }`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			facts, err := parseFacts(tt.reply)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, fact := range facts {
+				got = append(got, fact.Content)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
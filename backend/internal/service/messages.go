@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+// conversationNameLength is how many characters of the first message are used to name a new conversation
+const conversationNameLength = 50
+
+// SendMessageInput is a new message coming in from a frontend
+type SendMessageInput struct {
+	UserID         string // frontend user id, see userID
+	ConversationID string // optional, defaults to the user's active conversation in the channel
+	ChannelID      string // optional frontend channel, see ActiveState
+	Frontend       string // optional frontend name, like discord, system prompts can refer to it
+	Role           domain.Role
+	Content        domain.MessageContent
+}
+
+// SendMessageResult holds both sides of an exchange, as persisted
+type SendMessageResult struct {
+	UserMessage      domain.Message
+	AssistantMessage domain.Message   // The final reply
+	ToolMessages     []domain.Message // Assistant tool call turns and their results, in order
+	ConversationID   uuid.UUID
+	Usage            llm.Usage // Summed over every step
+	FinishReason     llm.FinishReason
+	Context          ContextReport // How the history of the last step was fit into the model's context window
+}
+
+// generateFunc produces the assistant's reply to a conversation history
+type generateFunc func(ctx context.Context, provider llm.Provider, history []domain.Message, config domain.AIConfig, tools []llm.Tool) (*llm.Response, error)
+
+// SendMessage stores an incoming message, asks the active ai config for a reply and stores that too
+func (s *Service) SendMessage(ctx context.Context, in SendMessageInput) (SendMessageResult, error) {
+	return s.send(ctx, in, func(ctx context.Context, provider llm.Provider, history []domain.Message, config domain.AIConfig, tools []llm.Tool) (*llm.Response, error) {
+		return provider.SendMessage(ctx, history, config, tools)
+	}, nil)
+}
+
+// SendMessageStream is like SendMessage, but forwards the provider's progress to handler while the reply is generated
+// calls to tools behind an approve rule are only made over streams, handler is where their approval is asked for
+// models that can't stream still work, their whole reply arrives as a single delta
+func (s *Service) SendMessageStream(ctx context.Context, in SendMessageInput, handler llm.StreamHandler) (SendMessageResult, error) {
+	return s.send(ctx, in, func(ctx context.Context, provider llm.Provider, history []domain.Message, config domain.AIConfig, tools []llm.Tool) (*llm.Response, error) {
+		if config.Model.Capabilities.Streaming {
+			return provider.StreamMessage(ctx, history, config, tools, handler)
+		}
+
+		reply, err := provider.SendMessage(ctx, history, config, tools)
+		if err != nil || reply.Message.Content.Text == "" {
+			return reply, err
+		}
+		if err := handler(llm.StreamEvent{Type: llm.StreamEventTextDelta, Text: reply.Message.Content.Text}); err != nil {
+			return nil, err
+		}
+		return reply, nil
+	}, handler)
+}
+
+// send is the message pipeline shared by SendMessageStream and SendMessage, which has no events handler
+func (s *Service) send(ctx context.Context, in SendMessageInput, generate generateFunc, events llm.StreamHandler) (SendMessageResult, error) {
+	if in.Role == "" {
+		in.Role = domain.RoleUser
+	}
+	if err := validateMessage(in.Role, in.Content); err != nil {
+		return SendMessageResult{}, err
+	}
+
+	user, err := s.ensureUser(ctx, in.UserID)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	config, err := s.activeAIConfig(ctx, user.ID, in.ChannelID)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	provider, err := s.providerFor(ctx, config)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	if len(in.Content.Media) > 0 && !config.Model.Capabilities.Vision {
+		return SendMessageResult{}, fmt.Errorf("%w: model %s can't read media", ErrInvalidArgument, config.Model.Name)
+	}
+	conversation, err := s.conversationFor(ctx, user, in.ChannelID, in.ConversationID, in.Content.Text)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	tools, err := s.toolsFor(ctx, config.ID)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	if !config.Model.Capabilities.Tools {
+		tools = toolset{}
+	}
+	data, err := s.promptDataFor(ctx, conversation, in, tools.tools)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	if config, err = s.applySystemPrompt(ctx, config, conversation, data); err != nil {
+		return SendMessageResult{}, err
+	}
+	// memories about what the message mentions go along with the system prompt
+	memories, err := s.recall(ctx, user.ID, in.Content.Text)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	config.SystemPrompt = withMemories(config.SystemPrompt, memories)
+	// params are checked when saved, but the model or the conversation's personality may not have been the same
+	if err := provider.ValidateParams(config.Model, config.Params); err != nil {
+		return SendMessageResult{}, fmt.Errorf("%w: ai config %s: %v", ErrFailedPrecondition, config.Name, err)
+	}
+
+	userMessage, err := s.storeMessage(ctx, conversation.ID, &user, in.Role, in.Content)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+
+	// long conversations start with a summary instead of their oldest messages
+	history, summarized, err := s.summarizedHistory(ctx, conversation.ID)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	// media from before a switch to a model without vision is left out rather than failing every message
+	if !config.Model.Capabilities.Vision {
+		history = withoutMedia(history)
+	}
+
+	// passages from past conversations, memories and documents the message is about, see EnableEmbeddings
+	retrieved, err := s.retrieve(ctx, user.ID, in.Content.Text, history, memories)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	config.SystemPrompt = withRetrieved(config.SystemPrompt, retrieved)
+
+	result, err := s.respond(ctx, conversation.ID, provider, history, config, tools, generate, events)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+
+	if err := s.queries.UpdateConversationLastUsed(ctx, conversation.ID); err != nil {
+		return SendMessageResult{}, fmt.Errorf("failed to update conversation: %w", err)
+	}
+	if err := s.queries.UpdateAIConfigLastUsed(ctx, config.ID); err != nil {
+		return SendMessageResult{}, fmt.Errorf("failed to update ai config: %w", err)
+	}
+
+	s.summarizeLater(conversation.ID)
+	exchange := []domain.Message{userMessage, result.AssistantMessage}
+	s.rememberLater(user.ID, exchange)
+	s.embedLater(messageChunks(exchange))
+
+	result.UserMessage = userMessage
+	result.ConversationID = conversation.ID
+	result.Context.SummarizedMessages = summarized
+	result.Context.RetrievedChunks = len(retrieved)
+	return result, nil
+}
+
+// respond runs the tool loop: the model is asked for a reply, any tools it calls are executed and their results
+// fed back, until it answers without calling tools or maxToolSteps is used up
+// every assistant turn and tool result is stored as it happens, so the history can be replayed later
+// calls that need approval pause the loop until they are decided on, see awaitApproval
+func (s *Service) respond(ctx context.Context, conversationID uuid.UUID, provider llm.Provider, history []domain.Message, config domain.AIConfig, tools toolset, generate generateFunc, events llm.StreamHandler) (SendMessageResult, error) {
+	var result SendMessageResult
+	estimator := provider.TokenEstimator(config.Model)
+	for step := 1; ; step++ {
+		window, report, err := fitContext(history, config, estimator, tools.tools)
+		if err != nil {
+			return SendMessageResult{}, err
+		}
+		result.Context = report
+
+		reply, err := generate(ctx, provider, window, config, tools.tools)
+		if err != nil {
+			return SendMessageResult{}, fmt.Errorf("provider error: %w", err)
+		}
+		result.Usage = addUsage(result.Usage, reply.Usage)
+		result.FinishReason = reply.FinishReason
+
+		assistantMessage, err := s.storeMessage(ctx, conversationID, nil, domain.RoleAssistant, reply.Message.Content)
+		if err != nil {
+			return SendMessageResult{}, err
+		}
+		history = append(history, assistantMessage)
+		result.AssistantMessage = assistantMessage
+
+		calls := assistantMessage.Content.ToolCalls
+		if len(calls) == 0 {
+			return result, nil
+		}
+		result.ToolMessages = append(result.ToolMessages, assistantMessage)
+
+		// out of steps, the calls are answered without running them so the history stays valid for the next message
+		exhausted := step >= maxToolSteps
+		for _, call := range calls {
+			var toolResult domain.ToolResult
+			switch {
+			case exhausted:
+				toolResult = domain.ToolResult{CallID: call.ID, Name: call.Name, Content: "not executed, tool step budget exhausted", IsError: true}
+			case tools.approval[call.Name]:
+				refused, approved, err := s.awaitApproval(ctx, assistantMessage, call, events)
+				if err != nil {
+					return SendMessageResult{}, err
+				}
+				toolResult = refused
+				if approved {
+					toolResult = s.runTool(ctx, call, tools)
+				}
+			default:
+				toolResult = s.runTool(ctx, call, tools)
+			}
+
+			toolMessage, err := s.storeMessage(ctx, conversationID, nil, domain.RoleTool, domain.MessageContent{ToolResult: &toolResult})
+			if err != nil {
+				return SendMessageResult{}, err
+			}
+			history = append(history, toolMessage)
+			result.ToolMessages = append(result.ToolMessages, toolMessage)
+		}
+		if exhausted {
+			result.FinishReason = llm.FinishToolCalls
+			return result, nil
+		}
+	}
+}
+
+// withoutMedia returns the messages with their media dropped, messages left without content are skipped
+func withoutMedia(messages []domain.Message) []domain.Message {
+	stripped := make([]domain.Message, 0, len(messages))
+	for _, msg := range messages {
+		if len(msg.Content.Media) > 0 {
+			msg.Content.Media = nil
+			if msg.Content.Text == "" {
+				continue
+			}
+		}
+		stripped = append(stripped, msg)
+	}
+	return stripped
+}
+
+// addUsage sums the token usage of two steps
+func addUsage(a, b llm.Usage) llm.Usage {
+	return llm.Usage{
+		InputTokens:  a.InputTokens + b.InputTokens,
+		OutputTokens: a.OutputTokens + b.OutputTokens,
+		TotalTokens:  a.TotalTokens + b.TotalTokens,
+	}
+}
+
+// validateMessage checks that an incoming message has a sendable role and some content
+func validateMessage(role domain.Role, content domain.MessageContent) error {
+	switch role {
+	case domain.RoleUser, domain.RoleSystem, domain.RoleDeveloper:
+	default:
+		return fmt.Errorf("%w: unsupported role %q", ErrInvalidArgument, role)
+	}
+	if content.Text == "" && len(content.Media) == 0 {
+		return fmt.Errorf("%w: message content is empty", ErrInvalidArgument)
+	}
+	// tool calls and results are only ever produced by the tool loop
+	if len(content.ToolCalls) > 0 || content.ToolResult != nil {
+		return fmt.Errorf("%w: messages can't carry tool calls or results", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// conversationFor resolves the conversation a message goes into, the user has to participate in it already
+// without an explicit id the user's active conversation in the channel is used, or a new one is started
+// with the user as its participant, either way it becomes the active one
+func (s *Service) conversationFor(ctx context.Context, user domain.User, channelID, conversationID, text string) (domain.Conversation, error) {
+	var conversation domain.Conversation
+
+	if conversationID != "" {
+		var err error
+		conversation, err = s.authorizedConversation(ctx, conversationID, user.ID.String())
+		if err != nil {
+			return domain.Conversation{}, err
+		}
+	} else {
+		active, err := s.activeConversation(ctx, user.ID, channelID)
+		if err != nil {
+			return domain.Conversation{}, err
+		}
+		if active != nil {
+			conversation = domain.ConversationFromDB(*active)
+		} else {
+			created, err := s.queries.CreateConversation(ctx, conversationName(text))
+			if err != nil {
+				return domain.Conversation{}, fmt.Errorf("failed to create conversation: %w", err)
+			}
+			err = s.queries.AddParticipant(ctx, database.AddParticipantParams{
+				ConversationID: created.ID,
+				UserID:         user.ID,
+			})
+			if err != nil {
+				return domain.Conversation{}, fmt.Errorf("failed to add participant: %w", err)
+			}
+			conversation = domain.ConversationFromDB(created)
+		}
+	}
+
+	if err := s.setActiveConversation(ctx, user.ID, channelID, conversation.ID); err != nil {
+		return domain.Conversation{}, err
+	}
+	return conversation, nil
+}
+
+// conversationName derives a conversation name from its first message
+func conversationName(text string) sql.NullString {
+	runes := []rune(text)
+	if len(runes) > conversationNameLength {
+		runes = runes[:conversationNameLength]
+	}
+	return sql.NullString{String: string(runes), Valid: len(runes) > 0}
+}
+
+// storeMessage persists a message and returns it as a domain message
+func (s *Service) storeMessage(ctx context.Context, conversationID uuid.UUID, user *domain.User, role domain.Role, content domain.MessageContent) (domain.Message, error) {
+	contentJSON, err := json.Marshal(content)
+	if err != nil {
+		return domain.Message{}, fmt.Errorf("failed to marshal message content: %w", err)
+	}
+
+	var uid uuid.NullUUID
+	if user != nil {
+		uid = uuid.NullUUID{UUID: user.ID, Valid: true}
+	}
+
+	row, err := s.queries.CreateMessage(ctx, database.CreateMessageParams{
+		ConversationID: conversationID,
+		UserID:         uid,
+		Role:           string(role),
+		Content:        contentJSON,
+	})
+	if err != nil {
+		return domain.Message{}, fmt.Errorf("failed to store message: %w", err)
+	}
+
+	return domain.Message{
+		ID:             row.ID,
+		ConversationID: row.ConversationID,
+		User:           user,
+		Role:           role,
+		Content:        content,
+		CreatedAt:      row.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/curator4/io/backend/internal/mcphost"
+	"github.com/google/uuid"
+)
+
+var (
+	userColumns          = strings.Fields(`id created_at updated_at name`)
+	storedMessageColumns = strings.Fields(`id created_at updated_at conversation_id user_id role content`)
+)
+
+// scriptedProvider answers with its replies in order, the last one over and over,
+// and records the histories it got and the configs they came under
+type scriptedProvider struct {
+	mu        sync.Mutex
+	replies   []domain.MessageContent
+	histories [][]domain.Message
+	configs   []string
+	streamed  int // calls that went through StreamMessage
+}
+
+func (p *scriptedProvider) SendMessage(_ context.Context, messages []domain.Message, config domain.AIConfig, _ []llm.Tool) (*llm.Response, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.histories = append(p.histories, messages)
+	p.configs = append(p.configs, config.Name)
+	content := p.replies[min(len(p.histories), len(p.replies))-1]
+
+	finish := llm.FinishStop
+	if len(content.ToolCalls) > 0 {
+		finish = llm.FinishToolCalls
+	}
+	return &llm.Response{
+		Message:      domain.Message{Role: domain.RoleAssistant, Content: content},
+		Usage:        llm.Usage{InputTokens: 10, OutputTokens: 5, TotalTokens: 15},
+		FinishReason: finish,
+	}, nil
+}
+
+// StreamMessage sends the reply's text a word at a time
+func (p *scriptedProvider) StreamMessage(ctx context.Context, messages []domain.Message, config domain.AIConfig, tools []llm.Tool, handler llm.StreamHandler) (*llm.Response, error) {
+	reply, err := p.SendMessage(ctx, messages, config, tools)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.streamed++
+	p.mu.Unlock()
+	for _, word := range strings.SplitAfter(reply.Message.Content.Text, " ") {
+		if err := handler(llm.StreamEvent{Type: llm.StreamEventTextDelta, Text: word}); err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
+
+func (p *scriptedProvider) ValidateParams(domain.Model, domain.GenerationParams) error { return nil }
+func (p *scriptedProvider) TokenEstimator(domain.Model) llm.TokenEstimator             { return charEstimator{} }
+
+// pipeline is a Service whose database holds one user, the default ai config "default" on the scripted provider,
+// and whatever messages the pipeline stores. fields set before sending shape what the database answers
+type pipeline struct {
+	db       *fakeDB
+	s        *Service
+	provider *scriptedProvider
+	user     uuid.UUID
+
+	participants bool   // whether the user participates in conversations they didn't start
+	streaming    bool   // whether the model supports streaming
+	selected     string // name of the ai config the user picked, "" for none
+	channel      string // channel the user sends in
+
+	mu       sync.Mutex
+	messages [][]any // stored messages, in storedMessageColumns
+}
+
+func newPipeline(t *testing.T, replies ...domain.MessageContent) *pipeline {
+	db, s := newFakeDB(t)
+	p := &pipeline{db: db, s: s, provider: &scriptedProvider{replies: replies}, user: uuid.New()}
+	s.providers = llm.NewRegistry()
+	s.providers.Register("fake", p.provider)
+	s.mcp = mcphost.NewHost()
+	t.Cleanup(s.mcp.Close)
+
+	now := time.Now()
+	providerID := uuid.NewString()
+	config := func(name string) []any {
+		model := []any{uuid.NewString(), now, providerID, "small", nil, now, "small-1", nil, nil, false, true, p.streaming, false}
+		config := []any{uuid.NewString(), now, now, nil, name, model[0], nil, nil, nil, nil, nil, nil, []byte("{}"), "drop_oldest", nil}
+		return append(config, model...)
+	}
+	configColumns := append(append([]string{}, aiConfigColumns...), modelColumns...)
+	user := []any{p.user.String(), now, now, "alice"}
+
+	db.on("GetUserByID", func([]any) (*fakeRows, error) { return rows(userColumns, user), nil })
+	db.on("GetUserAIConfig", func([]any) (*fakeRows, error) {
+		if p.selected == "" {
+			return rows(configColumns), nil
+		}
+		return rows(configColumns, config(p.selected)), nil
+	})
+	db.on("GetDefaultAIConfig", func([]any) (*fakeRows, error) { return rows(configColumns, config("default")), nil })
+	db.on("GetProviderByID", func([]any) (*fakeRows, error) {
+		return rows(providerColumns, []any{providerID, now, now, "fake", nil, nil, []byte("{}"), ""}), nil
+	})
+	db.on("GetUserState", func([]any) (*fakeRows, error) {
+		return rows(strings.Fields(`user_id channel_id ai_config_id conversation_id updated_at`)), nil
+	})
+	db.on("GetUserConversations", func([]any) (*fakeRows, error) { return rows(conversationColumns), nil })
+	db.on("GetConversation", func(args []any) (*fakeRows, error) {
+		return rows(conversationColumns, []any{args[0], now, now, nil, "earlier", nil}), nil
+	})
+	db.on("IsParticipant", func([]any) (*fakeRows, error) { return rows([]string{"exists"}, []any{p.participants}), nil })
+	db.on("CreateConversation", func(args []any) (*fakeRows, error) {
+		return rows(conversationColumns, []any{uuid.NewString(), now, now, nil, args[0], nil}), nil
+	})
+	db.on("AddParticipant", func([]any) (*fakeRows, error) { return nil, nil })
+	db.on("SetUserConversation", func([]any) (*fakeRows, error) { return nil, nil })
+	db.on("ListAIConfigMCPServers", func([]any) (*fakeRows, error) { return rows(mcpServerColumns), nil })
+	db.on("ListToolRules", func([]any) (*fakeRows, error) {
+		return rows(toolRuleColumns), nil
+	})
+	db.on("GetConversationParticipants", func([]any) (*fakeRows, error) { return rows(userColumns, user), nil })
+	db.on("RecallMemories", func([]any) (*fakeRows, error) { return rows(memoryColumns), nil })
+	db.on("CreateMessage", func(args []any) (*fakeRows, error) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		message := []any{uuid.NewString(), now, now, args[0], args[1], args[2], args[3]}
+		p.messages = append(p.messages, message)
+		return rows(storedMessageColumns, message), nil
+	})
+	db.on("GetMessagesByConversation", func([]any) (*fakeRows, error) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		history := rows(messageColumns)
+		for _, m := range p.messages {
+			var name any
+			if m[4] != nil {
+				name = "alice"
+			}
+			history.values = append(history.values, append(append([]any{}, m...), name, nil, nil))
+		}
+		return history, nil
+	})
+	db.on("GetLatestConversationSummary", func([]any) (*fakeRows, error) { return rows(summaryColumns), nil })
+	db.on("UpdateConversationLastUsed", func([]any) (*fakeRows, error) { return nil, nil })
+	db.on("UpdateAIConfigLastUsed", func([]any) (*fakeRows, error) { return nil, nil })
+	return p
+}
+
+// stored describes the stored messages, "role: text" each, tool calls and results by their name
+func (p *pipeline) stored() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var described []string
+	for _, m := range p.messages {
+		var content domain.MessageContent
+		json.Unmarshal(m[6].([]byte), &content)
+		text := content.Text
+		for _, call := range content.ToolCalls {
+			text += "call " + call.Name
+		}
+		if r := content.ToolResult; r != nil {
+			text = "result " + r.Name
+		}
+		described = append(described, m[5].(string)+": "+text)
+	}
+	return strings.Join(described, "|")
+}
+
+// send sends text as the pipeline's user
+func (p *pipeline) send(conversationID, text string) (SendMessageResult, error) {
+	return p.s.SendMessage(context.Background(), SendMessageInput{
+		UserID:         p.user.String(),
+		ConversationID: conversationID,
+		ChannelID:      p.channel,
+		Content:        domain.MessageContent{Text: text},
+	})
+}
+
+func TestSendMessage(t *testing.T) {
+	p := newPipeline(t, domain.MessageContent{Text: "hi alice"})
+
+	result, err := p.send("", "hello io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.stored(); got != "user: hello io|assistant: hi alice" {
+		t.Errorf("got stored messages %q", got)
+	}
+	if result.AssistantMessage.Content.Text != "hi alice" || result.UserMessage.Content.Text != "hello io" || result.FinishReason != llm.FinishStop {
+		t.Errorf("got result %+v", result)
+	}
+	// the model sees the message it answers
+	if len(p.provider.histories) != 1 || texts(p.provider.histories[0]) != "hello io" {
+		t.Errorf("got histories %v", p.provider.histories)
+	}
+
+	// a new conversation is started, named after the message, and made the active one
+	created := p.db.called("CreateConversation")
+	if len(created) != 1 || created[0].args[0] != "hello io" {
+		t.Fatalf("got conversations created %v", created)
+	}
+	active := p.db.called("SetUserConversation")
+	if len(active) != 1 || active[0].args[2] != result.ConversationID.String() {
+		t.Errorf("got active conversations %v, want %s", active, result.ConversationID)
+	}
+	// the user's message is theirs, the reply nobody's
+	if p.messages[0][4] != p.user.String() || p.messages[1][4] != nil {
+		t.Errorf("got authors %v and %v", p.messages[0][4], p.messages[1][4])
+	}
+	if len(p.db.called("UpdateConversationLastUsed")) != 1 || len(p.db.called("UpdateAIConfigLastUsed")) != 1 {
+		t.Error("last used times weren't updated")
+	}
+}
+
+func TestSendMessageToConversation(t *testing.T) {
+	p := newPipeline(t, domain.MessageContent{Text: "welcome back"})
+	p.participants = true
+	conversationID := uuid.NewString()
+
+	result, err := p.send(conversationID, "me again")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ConversationID.String() != conversationID || p.messages[0][3] != conversationID {
+		t.Errorf("got conversation %s, stored into %v, want %s", result.ConversationID, p.messages[0][3], conversationID)
+	}
+	if len(p.db.called("CreateConversation")) != 0 {
+		t.Error("started a new conversation")
+	}
+}
+
+func TestSendMessageToSomeoneElsesConversation(t *testing.T) {
+	p := newPipeline(t, domain.MessageContent{Text: "hi"})
+
+	_, err := p.send(uuid.NewString(), "let me in")
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("got %v, want %v", err, ErrPermissionDenied)
+	}
+	if p.stored() != "" || len(p.provider.histories) != 0 {
+		t.Errorf("stored %q and asked the model %d times", p.stored(), len(p.provider.histories))
+	}
+}
+
+// stream sends text as the pipeline's user over SendMessageStream, and returns the text deltas it got
+func (p *pipeline) stream(text string, handler llm.StreamHandler) ([]string, SendMessageResult, error) {
+	var deltas []string
+	result, err := p.s.SendMessageStream(context.Background(), SendMessageInput{
+		UserID:  p.user.String(),
+		Content: domain.MessageContent{Text: text},
+	}, func(event llm.StreamEvent) error {
+		if event.Type == llm.StreamEventTextDelta {
+			deltas = append(deltas, event.Text)
+		}
+		if handler != nil {
+			return handler(event)
+		}
+		return nil
+	})
+	return deltas, result, err
+}
+
+func TestSendMessageStream(t *testing.T) {
+	tests := []struct {
+		name      string
+		streaming bool
+		want      []string
+	}{
+		{"streaming model", true, []string{"hi ", "there ", "alice"}},
+		// models that can't stream still work, the whole reply is a single delta
+		{"model without streaming", false, []string{"hi there alice"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPipeline(t, domain.MessageContent{Text: "hi there alice"})
+			p.streaming = tt.streaming
+
+			deltas, result, err := p.stream("hello io", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(deltas, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("got deltas %q, want %q", deltas, tt.want)
+			}
+			if result.AssistantMessage.Content.Text != "hi there alice" || p.stored() != "user: hello io|assistant: hi there alice" {
+				t.Errorf("got reply %q, stored %q", result.AssistantMessage.Content.Text, p.stored())
+			}
+			if streamed := p.provider.streamed > 0; streamed != tt.streaming {
+				t.Errorf("provider streamed %d times", p.provider.streamed)
+			}
+		})
+	}
+}
+
+func TestSendMessageStreamClientGone(t *testing.T) {
+	p := newPipeline(t, domain.MessageContent{Text: "hi there alice"})
+	p.streaming = true
+	gone := errors.New("client went away")
+
+	_, _, err := p.stream("hello io", func(llm.StreamEvent) error { return gone })
+	if !errors.Is(err, gone) {
+		t.Fatalf("got %v, want %v", err, gone)
+	}
+	// the reply was never finished, only the user's message is kept
+	if got := p.stored(); got != "user: hello io" {
+		t.Errorf("got stored messages %q", got)
+	}
+}
+
+func TestSendMessageInvalid(t *testing.T) {
+	p := newPipeline(t, domain.MessageContent{Text: "hi"})
+
+	tests := []struct {
+		name string
+		in   SendMessageInput
+	}{
+		{"no user", SendMessageInput{Content: domain.MessageContent{Text: "hello"}}},
+		{"no content", SendMessageInput{UserID: "alice"}},
+		{"assistant role", SendMessageInput{UserID: "alice", Role: domain.RoleAssistant, Content: domain.MessageContent{Text: "hello"}}},
+		{"tool calls", SendMessageInput{UserID: "alice", Content: domain.MessageContent{Text: "hello", ToolCalls: []domain.ToolCall{{ID: "1", Name: "current_time"}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := p.s.SendMessage(context.Background(), tt.in); !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("got %v, want %v", err, ErrInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestSendMessageRunsTools(t *testing.T) {
+	call := domain.MessageContent{ToolCalls: []domain.ToolCall{{ID: "call-1", Name: "current_time", Arguments: `{"timezone": "UTC"}`}}}
+	p := newPipeline(t, call, domain.MessageContent{Text: "it's late"})
+
+	result, err := p.send("", "what time is it?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every step is stored as it happens
+	if got := p.stored(); got != "user: what time is it?|assistant: call current_time|tool: result current_time|assistant: it's late" {
+		t.Errorf("got stored messages %q", got)
+	}
+	if result.AssistantMessage.Content.Text != "it's late" || len(result.ToolMessages) != 2 || result.FinishReason != llm.FinishStop {
+		t.Errorf("got result %+v", result)
+	}
+	if result.Usage.TotalTokens != 30 {
+		t.Errorf("got usage %+v, want both steps summed", result.Usage)
+	}
+
+	// the second step sees the call and its result
+	if len(p.provider.histories) != 2 {
+		t.Fatalf("asked the model %d times, want twice", len(p.provider.histories))
+	}
+	history := p.provider.histories[1]
+	r := history[len(history)-1].Content.ToolResult
+	if r == nil || r.CallID != "call-1" || r.IsError || !strings.Contains(r.Content, "UTC") {
+		t.Errorf("got last message %+v", history[len(history)-1])
+	}
+}
+
+func TestSendMessageToolErrors(t *testing.T) {
+	calls := domain.MessageContent{ToolCalls: []domain.ToolCall{
+		{ID: "call-1", Name: "current_time", Arguments: `{"timezone": "Mars/Olympus"}`},
+		{ID: "call-2", Name: "shell__run", Arguments: `{}`},
+	}}
+	p := newPipeline(t, calls, domain.MessageContent{Text: "sorry"})
+
+	result, err := p.send("", "what time is it on mars?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// failures go back to the model as error results instead of failing the message
+	want := []string{`unknown time zone "Mars/Olympus"`, "tool shell__run is not available"}
+	for i, m := range result.ToolMessages[1:] {
+		if r := m.Content.ToolResult; r == nil || !r.IsError || r.Content != want[i] {
+			t.Errorf("got result %+v, want error %q", r, want[i])
+		}
+	}
+	if result.AssistantMessage.Content.Text != "sorry" {
+		t.Errorf("got reply %q", result.AssistantMessage.Content.Text)
+	}
+}
+
+func TestSendMessageToolStepBudget(t *testing.T) {
+	// a model that never stops calling tools
+	p := newPipeline(t, domain.MessageContent{ToolCalls: []domain.ToolCall{{ID: "call", Name: "current_time"}}})
+
+	result, err := p.send("", "loop forever")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.provider.histories) != maxToolSteps || result.FinishReason != llm.FinishToolCalls {
+		t.Errorf("asked the model %d times, finished with %q", len(p.provider.histories), result.FinishReason)
+	}
+	// the last calls are answered without running, so the history stays valid for the next message
+	last := result.ToolMessages[len(result.ToolMessages)-1].Content.ToolResult
+	if last == nil || !last.IsError || !strings.Contains(last.Content, "budget exhausted") {
+		t.Errorf("got last result %+v", last)
+	}
+}
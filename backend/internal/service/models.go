@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ListModels returns all models, or only a provider's when providerID is set
+func (s *Service) ListModels(ctx context.Context, providerID string) ([]domain.Model, error) {
+	var rows []database.Model
+	var err error
+	if providerID == "" {
+		rows, err = s.queries.ListModels(ctx)
+	} else {
+		id, perr := uuid.Parse(providerID)
+		if perr != nil {
+			return nil, fmt.Errorf("%w: invalid provider id", ErrInvalidArgument)
+		}
+		rows, err = s.queries.ListModelsByProvider(ctx, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", err)
+	}
+
+	models := make([]domain.Model, len(rows))
+	for i, row := range rows {
+		models[i] = domain.ModelFromDB(row)
+	}
+	return models, nil
+}
+
+// CreateModel adds a model to a provider
+func (s *Service) CreateModel(ctx context.Context, providerID string, m domain.Model) (domain.Model, error) {
+	id, err := uuid.Parse(providerID)
+	if err != nil {
+		return domain.Model{}, fmt.Errorf("%w: invalid provider id", ErrInvalidArgument)
+	}
+	if m, err = validateModel(m); err != nil {
+		return domain.Model{}, err
+	}
+	if _, err := s.queries.GetProviderByID(ctx, id); err != nil {
+		return domain.Model{}, notFound(err, "provider")
+	}
+
+	params := domain.ModelToDB(m)
+	row, err := s.queries.CreateModel(ctx, database.CreateModelParams{
+		ProviderID:        id,
+		Name:              params.Name,
+		Description:       params.Description,
+		ProviderModelID:   params.ProviderModelID,
+		ContextWindow:     params.ContextWindow,
+		MaxOutputTokens:   params.MaxOutputTokens,
+		SupportsVision:    params.SupportsVision,
+		SupportsTools:     params.SupportsTools,
+		SupportsStreaming: params.SupportsStreaming,
+		SupportsReasoning: params.SupportsReasoning,
+	})
+	if err != nil {
+		return domain.Model{}, fmt.Errorf("failed to create model: %w", alreadyExists(err, "model"))
+	}
+	return domain.ModelFromDB(row), nil
+}
+
+// UpdateModel replaces a model's metadata, the provider it belongs to stays the same
+func (s *Service) UpdateModel(ctx context.Context, modelID string, m domain.Model) (domain.Model, error) {
+	id, err := uuid.Parse(modelID)
+	if err != nil {
+		return domain.Model{}, fmt.Errorf("%w: invalid model id", ErrInvalidArgument)
+	}
+	if m, err = validateModel(m); err != nil {
+		return domain.Model{}, err
+	}
+
+	params := domain.ModelToDB(m)
+	row, err := s.queries.UpdateModel(ctx, database.UpdateModelParams{
+		ID:                id,
+		Name:              params.Name,
+		Description:       params.Description,
+		ProviderModelID:   params.ProviderModelID,
+		ContextWindow:     params.ContextWindow,
+		MaxOutputTokens:   params.MaxOutputTokens,
+		SupportsVision:    params.SupportsVision,
+		SupportsTools:     params.SupportsTools,
+		SupportsStreaming: params.SupportsStreaming,
+		SupportsReasoning: params.SupportsReasoning,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Model{}, notFound(err, "model")
+		}
+		return domain.Model{}, fmt.Errorf("failed to update model: %w", alreadyExists(err, "model"))
+	}
+	return domain.ModelFromDB(row), nil
+}
+
+// DeleteModel removes a model that no ai config uses
+func (s *Service) DeleteModel(ctx context.Context, modelID string) error {
+	id, err := uuid.Parse(modelID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid model id", ErrInvalidArgument)
+	}
+
+	configs, err := s.queries.CountAIConfigsByModel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count ai configs: %w", err)
+	}
+	if configs > 0 {
+		return fmt.Errorf("%w: model is used by %d ai configs", ErrFailedPrecondition, configs)
+	}
+
+	n, err := s.queries.DeleteModel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete model: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("model %w", ErrNotFound)
+	}
+	return nil
+}
+
+// validateModel checks a model's metadata, the provider's model id defaults to the name
+func validateModel(m domain.Model) (domain.Model, error) {
+	if strings.TrimSpace(m.Name) == "" {
+		return domain.Model{}, fmt.Errorf("%w: name is required", ErrInvalidArgument)
+	}
+	if m.ProviderModelID == "" {
+		m.ProviderModelID = m.Name
+	}
+	if m.ContextWindow < 0 || m.MaxOutputTokens < 0 {
+		return domain.Model{}, fmt.Errorf("%w: token limits can't be negative", ErrInvalidArgument)
+	}
+	if m.ContextWindow > 0 && m.MaxOutputTokens > m.ContextWindow {
+		return domain.Model{}, fmt.Errorf("%w: max_output_tokens exceeds context_window", ErrInvalidArgument)
+	}
+	return m, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+func TestCreateModel(t *testing.T) {
+	db, s := newFakeDB(t)
+	providerID := uuid.NewString()
+	db.on("GetProviderByID", func(args []any) (*fakeRows, error) {
+		if args[0] != providerID {
+			return rows(providerColumns), nil
+		}
+		now := time.Now()
+		return rows(providerColumns, []any{providerID, now, now, "openai", nil, nil, []byte("{}"), "responses"}), nil
+	})
+	db.on("CreateModel", func(args []any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(modelColumns, []any{uuid.NewString(), now, args[0], args[1], args[2], now, args[3], args[4], args[5], args[6], args[7], args[8], args[9]}), nil
+	})
+	ctx := context.Background()
+
+	// the provider's model id defaults to the name
+	model, err := s.CreateModel(ctx, providerID, domain.Model{Name: "gpt-5", ContextWindow: 400000, MaxOutputTokens: 128000, Capabilities: domain.ModelCapabilities{Tools: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.ProviderModelID != "gpt-5" || model.ContextWindow != 400000 || !model.Capabilities.Tools || model.Capabilities.Vision {
+		t.Errorf("got model %+v", model)
+	}
+
+	tests := []struct {
+		name       string
+		providerID string
+		model      domain.Model
+		want       error
+	}{
+		{"invalid provider id", "openai", domain.Model{Name: "gpt-5"}, ErrInvalidArgument},
+		{"unknown provider", uuid.NewString(), domain.Model{Name: "gpt-5"}, ErrNotFound},
+		{"no name", providerID, domain.Model{Name: " "}, ErrInvalidArgument},
+		{"negative limits", providerID, domain.Model{Name: "gpt-5", ContextWindow: -1}, ErrInvalidArgument},
+		{"output beyond the window", providerID, domain.Model{Name: "gpt-5", ContextWindow: 1000, MaxOutputTokens: 2000}, ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.CreateModel(ctx, tt.providerID, tt.model); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+	if n := len(db.called("CreateModel")); n != 1 {
+		t.Errorf("created %d models, want only the valid one", n)
+	}
+}
+
+func TestDeleteModelInUse(t *testing.T) {
+	db, s := newFakeDB(t)
+	db.on("CountAIConfigsByModel", func([]any) (*fakeRows, error) {
+		return rows([]string{"count"}, []any{int64(2)}), nil
+	})
+
+	err := s.DeleteModel(context.Background(), uuid.NewString())
+	if !errors.Is(err, ErrFailedPrecondition) {
+		t.Errorf("got %v, want %v", err, ErrFailedPrecondition)
+	}
+	// DeleteModel isn't registered, the fake database fails the test if it is reached
+}
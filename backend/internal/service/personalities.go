@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+// personalityParams is what personality defaults may set, whether the model takes them is checked once it is known
+var personalityParams = llm.Capabilities{MaxTemperature: 2, TopP: true, Reasoning: true}
+
+// ListPersonalities returns all personalities with their latest version, ordered by name
+func (s *Service) ListPersonalities(ctx context.Context) ([]domain.Personality, error) {
+	rows, err := s.queries.ListPersonalities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list personalities: %w", err)
+	}
+	versions, err := s.queries.ListLatestPersonalityVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list personality versions: %w", err)
+	}
+	latest := make(map[uuid.UUID]database.GetPersonalityVersionRow, len(versions))
+	for _, v := range versions {
+		latest[v.PersonalityID] = database.GetPersonalityVersionRow(v)
+	}
+
+	personalities := make([]domain.Personality, 0, len(rows))
+	for _, row := range rows {
+		// a personality deleted in between the two queries has no version left
+		version, ok := latest[row.ID]
+		if !ok {
+			continue
+		}
+		personalities = append(personalities, domain.PersonalityFromDB(row, version))
+	}
+	return personalities, nil
+}
+
+// ListPersonalityVersions returns every version of a personality, newest first
+func (s *Service) ListPersonalityVersions(ctx context.Context, personalityID string) ([]domain.PersonalityVersion, error) {
+	id, err := uuid.Parse(personalityID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid personality id", ErrInvalidArgument)
+	}
+	rows, err := s.queries.ListPersonalityVersions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list personality versions: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("personality %w", ErrNotFound)
+	}
+
+	versions := make([]domain.PersonalityVersion, len(rows))
+	for i, row := range rows {
+		versions[i] = domain.PersonalityVersionFromDB(database.GetPersonalityVersionRow(row))
+	}
+	return versions, nil
+}
+
+// CreatePersonality adds a personality, its definition in p.Latest becomes version 1
+func (s *Service) CreatePersonality(ctx context.Context, p domain.Personality) (domain.Personality, error) {
+	if err := validatePersonality(p); err != nil {
+		return domain.Personality{}, err
+	}
+
+	// a personality without a version can't be used, so both are stored or neither
+	var row database.Personality
+	var latest database.GetPersonalityVersionRow
+	err := s.inTx(ctx, func(q *database.Queries) error {
+		var err error
+		row, err = q.CreatePersonality(ctx, database.CreatePersonalityParams{
+			Name:        p.Name,
+			Description: p.Description,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create personality: %w", alreadyExists(err, "personality"))
+		}
+
+		p.Latest.PersonalityID = row.ID
+		latest, err = addPersonalityVersion(ctx, q, p.Latest)
+		return err
+	})
+	if err != nil {
+		return domain.Personality{}, err
+	}
+	return domain.PersonalityFromDB(row, latest), nil
+}
+
+// UpdatePersonality renames and redescribes a personality in place, a changed definition is added as a new version
+func (s *Service) UpdatePersonality(ctx context.Context, personalityID string, p domain.Personality) (domain.Personality, error) {
+	id, err := uuid.Parse(personalityID)
+	if err != nil {
+		return domain.Personality{}, fmt.Errorf("%w: invalid personality id", ErrInvalidArgument)
+	}
+	if err := validatePersonality(p); err != nil {
+		return domain.Personality{}, err
+	}
+
+	// the rename doesn't stick if the new version can't be stored
+	var row database.Personality
+	var current database.GetPersonalityVersionRow
+	err = s.inTx(ctx, func(q *database.Queries) error {
+		var err error
+		row, err = q.UpdatePersonality(ctx, database.UpdatePersonalityParams{
+			ID:          id,
+			Name:        p.Name,
+			Description: p.Description,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return notFound(err, "personality")
+			}
+			return fmt.Errorf("failed to update personality: %w", alreadyExists(err, "personality"))
+		}
+
+		latest, err := q.GetLatestPersonalityVersion(ctx, id)
+		if err != nil {
+			return notFound(err, "personality version")
+		}
+		current = database.GetPersonalityVersionRow(latest)
+		if !sameDefinition(domain.PersonalityVersionFromDB(current), p.Latest) {
+			p.Latest.PersonalityID = id
+			current, err = addPersonalityVersion(ctx, q, p.Latest)
+		}
+		return err
+	})
+	if err != nil {
+		return domain.Personality{}, err
+	}
+	return domain.PersonalityFromDB(row, current), nil
+}
+
+// DeletePersonality removes a personality and its versions, as long as no ai config uses it
+// conversations that use it go back to their ai config's personality
+func (s *Service) DeletePersonality(ctx context.Context, personalityID string) error {
+	id, err := uuid.Parse(personalityID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid personality id", ErrInvalidArgument)
+	}
+
+	configs, err := s.queries.CountAIConfigsByPersonality(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count ai configs: %w", err)
+	}
+	if configs > 0 {
+		return fmt.Errorf("%w: personality is used by %d ai configs", ErrFailedPrecondition, configs)
+	}
+
+	n, err := s.queries.DeletePersonality(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete personality: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("personality %w", ErrNotFound)
+	}
+	return nil
+}
+
+// SetConversationPersonality makes a conversation talk as a personality version, "" goes back to the ai config's
+func (s *Service) SetConversationPersonality(ctx context.Context, conversationID, externalUserID, versionID string) (domain.Conversation, error) {
+	conversation, err := s.authorizedConversation(ctx, conversationID, externalUserID)
+	if err != nil {
+		return domain.Conversation{}, err
+	}
+
+	var version uuid.NullUUID
+	if versionID != "" {
+		v, err := s.personalityVersion(ctx, versionID)
+		if err != nil {
+			return domain.Conversation{}, err
+		}
+		version = uuid.NullUUID{UUID: v.ID, Valid: true}
+	}
+
+	row, err := s.queries.UpdateConversationPersonality(ctx, database.UpdateConversationPersonalityParams{
+		ID:                   conversation.ID,
+		PersonalityVersionID: version,
+	})
+	if err != nil {
+		return domain.Conversation{}, notFound(err, "conversation")
+	}
+	return domain.ConversationFromDB(row), nil
+}
+
+// personalityVersion looks up a personality version by id
+func (s *Service) personalityVersion(ctx context.Context, versionID string) (domain.PersonalityVersion, error) {
+	id, err := uuid.Parse(versionID)
+	if err != nil {
+		return domain.PersonalityVersion{}, fmt.Errorf("%w: invalid personality_version_id", ErrInvalidArgument)
+	}
+	row, err := s.queries.GetPersonalityVersion(ctx, id)
+	if err != nil {
+		return domain.PersonalityVersion{}, notFound(err, "personality version")
+	}
+	return domain.PersonalityVersionFromDB(row), nil
+}
+
+// addPersonalityVersion stores a definition as its personality's next version, q may be bound to a transaction
+func addPersonalityVersion(ctx context.Context, q *database.Queries, v domain.PersonalityVersion) (database.GetPersonalityVersionRow, error) {
+	created, err := q.CreatePersonalityVersion(ctx, domain.PersonalityVersionToDB(v))
+	if err != nil {
+		return database.GetPersonalityVersionRow{}, fmt.Errorf("failed to create personality version: %w", alreadyExists(err, "personality version"))
+	}
+	row, err := q.GetPersonalityVersion(ctx, created.ID)
+	if err != nil {
+		return database.GetPersonalityVersionRow{}, notFound(err, "personality version")
+	}
+	return row, nil
+}
+
+// validatePersonality checks a personality and the definition in its Latest
+func validatePersonality(p domain.Personality) error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidArgument)
+	}
+	v := p.Latest
+	if strings.TrimSpace(v.PromptTemplate) == "" {
+		return fmt.Errorf("%w: prompt_template is required", ErrInvalidArgument)
+	}
+	if err := checkPromptTemplate(v.PromptTemplate); err != nil {
+		return fmt.Errorf("%w: prompt_template: %v", ErrInvalidArgument, err)
+	}
+	for _, e := range v.Examples {
+		if strings.TrimSpace(e.User) == "" || strings.TrimSpace(e.Assistant) == "" {
+			return fmt.Errorf("%w: examples need both a user and an assistant line", ErrInvalidArgument)
+		}
+	}
+
+	if len(v.Params.StopSequences) > 0 || len(v.Params.ProviderOptions) > 0 {
+		return fmt.Errorf("%w: personalities can't set stop sequences or provider options", ErrInvalidArgument)
+	}
+	if err := personalityParams.Validate(v.Params); err != nil {
+		return fmt.Errorf("%w: default_params: %v", ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// sameDefinition reports whether two versions define a personality the same way
+func sameDefinition(a, b domain.PersonalityVersion) bool {
+	return a.PromptTemplate == b.PromptTemplate &&
+		slices.Equal(a.StyleRules, b.StyleRules) &&
+		slices.Equal(a.Examples, b.Examples) &&
+		equalPtr(a.Params.Temperature, b.Params.Temperature) &&
+		equalPtr(a.Params.TopP, b.Params.TopP) &&
+		equalPtr(a.Params.MaxOutputTokens, b.Params.MaxOutputTokens) &&
+		a.Params.ReasoningEffort == b.Params.ReasoningEffort
+}
+
+// equalPtr compares two optional values
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
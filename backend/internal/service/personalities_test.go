@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	personalityColumns = []string{"id", "name", "description", "created_at", "updated_at"}
+	versionColumns     = []string{"id", "personality_id", "version", "prompt_template", "style_rules", "examples",
+		"temperature", "top_p", "max_output_tokens", "reasoning_effort", "created_at"}
+	versionRowColumns = append(append([]string{}, versionColumns...), "personality_name")
+)
+
+// versionRow is a personality version as GetPersonalityVersion returns it
+func versionRow(id, personalityID uuid.UUID, version int64, name string) []any {
+	return []any{id.String(), personalityID.String(), version, "you are {{.Name}}", []byte("{}"), []byte("[]"), nil, nil, nil, nil, time.Now(), name}
+}
+
+var pirate = domain.Personality{Name: "pirate", Latest: domain.PersonalityVersion{PromptTemplate: "talk like a pirate"}}
+
+func TestCreatePersonalityInOneTransaction(t *testing.T) {
+	db, s := newFakeDB(t)
+	personalityID, versionID := uuid.New(), uuid.New()
+	db.on("CreatePersonality", func([]any) (*fakeRows, error) {
+		return rows(personalityColumns, []any{personalityID.String(), "pirate", "", time.Now(), time.Now()}), nil
+	})
+	db.on("CreatePersonalityVersion", func(args []any) (*fakeRows, error) {
+		if args[0] != personalityID.String() {
+			t.Errorf("version created for %v, want %s", args[0], personalityID)
+		}
+		return rows(versionColumns, versionRow(versionID, personalityID, 1, "")[:11]), nil
+	})
+	db.on("GetPersonalityVersion", func([]any) (*fakeRows, error) {
+		return rows(versionRowColumns, versionRow(versionID, personalityID, 1, "pirate")), nil
+	})
+
+	p, err := s.CreatePersonality(context.Background(), pirate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != personalityID || p.Latest.ID != versionID || p.Latest.Version != 1 {
+		t.Errorf("got %+v", p)
+	}
+
+	personality, version := db.called("CreatePersonality"), db.called("CreatePersonalityVersion")
+	if len(personality) != 1 || len(version) != 1 {
+		t.Fatalf("got %d personalities and %d versions created", len(personality), len(version))
+	}
+	tx := personality[0].tx
+	if tx == nil || version[0].tx != tx || !tx.committed {
+		t.Error("the personality and its version weren't committed in one transaction")
+	}
+}
+
+func TestCreatePersonalityRollsBack(t *testing.T) {
+	db, s := newFakeDB(t)
+	db.on("CreatePersonality", func([]any) (*fakeRows, error) {
+		return rows(personalityColumns, []any{uuid.NewString(), "pirate", "", time.Now(), time.Now()}), nil
+	})
+	broken := errors.New("connection reset")
+	db.on("CreatePersonalityVersion", func([]any) (*fakeRows, error) {
+		return nil, broken
+	})
+
+	if _, err := s.CreatePersonality(context.Background(), pirate); !errors.Is(err, broken) {
+		t.Fatalf("got %v, want the version's error", err)
+	}
+	// the personality without a version is gone again
+	if tx := db.called("CreatePersonality")[0].tx; tx == nil || tx.committed || !tx.rolledBack {
+		t.Errorf("got transaction %+v, want it rolled back", tx)
+	}
+}
+
+func TestListPersonalities(t *testing.T) {
+	db, s := newFakeDB(t)
+	alice, bob, gone := uuid.New(), uuid.New(), uuid.New()
+	db.on("ListPersonalities", func([]any) (*fakeRows, error) {
+		return rows(personalityColumns,
+			[]any{alice.String(), "alice", "", time.Now(), time.Now()},
+			[]any{bob.String(), "bob", "", time.Now(), time.Now()},
+			[]any{gone.String(), "gone", "", time.Now(), time.Now()},
+		), nil
+	})
+	aliceV2, bobV1 := uuid.New(), uuid.New()
+	db.on("ListLatestPersonalityVersions", func([]any) (*fakeRows, error) {
+		return rows(versionRowColumns,
+			versionRow(aliceV2, alice, 2, "alice"),
+			versionRow(bobV1, bob, 1, "bob"),
+		), nil
+	})
+
+	personalities, err := s.ListPersonalities(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a personality deleted between the queries is left out
+	if len(personalities) != 2 {
+		t.Fatalf("got %d personalities, want 2", len(personalities))
+	}
+	if p := personalities[0]; p.ID != alice || p.Latest.ID != aliceV2 || p.Latest.Version != 2 {
+		t.Errorf("got %+v", p)
+	}
+	if p := personalities[1]; p.ID != bob || p.Latest.ID != bobV1 {
+		t.Errorf("got %+v", p)
+	}
+	if n := len(db.called("ListLatestPersonalityVersions")); n != 1 {
+		t.Errorf("versions were listed %d times, want once", n)
+	}
+}
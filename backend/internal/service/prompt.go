@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+)
+
+// promptData is what system prompts and personality prompt templates can refer to, as {{.Date}} etc
+type promptData struct {
+	Name         string // The personality's name, empty without one
+	Date         string // Current date, like 2006-01-02
+	Time         string // Current time, like 15:04
+	Timezone     string // The service's time zone, see SetTimezone
+	Now          time.Time
+	Conversation string   // The conversation's name
+	Participants []string // Names of the users in the conversation
+	Frontend     string   // Frontend the message came from, like discord, may be empty
+	Channel      string   // Frontend channel, may be empty
+	Tools        []string // Names of the tools the model can call
+}
+
+// promptFuncs are the functions prompt templates can call besides text/template's builtins
+var promptFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// samplePromptData is what templates are checked against when saved, every field is set
+// so templates ranging over lists or indexing them are exercised too
+var samplePromptData = promptData{
+	Name:         "io",
+	Date:         "2006-01-02",
+	Time:         "15:04",
+	Timezone:     "UTC",
+	Now:          time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+	Conversation: "sample conversation",
+	Participants: []string{"alice", "bob"},
+	Frontend:     "discord",
+	Channel:      "general",
+	Tools:        []string{"current_time"},
+}
+
+// parsePrompt parses a prompt template, referring to keys a map doesn't have is an error too
+func parsePrompt(text string) (*template.Template, error) {
+	return template.New("prompt").Funcs(promptFuncs).Option("missingkey=error").Parse(text)
+}
+
+// checkPromptTemplate makes sure a prompt template parses and only refers to fields promptData has
+func checkPromptTemplate(text string) error {
+	tmpl, err := parsePrompt(text)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(io.Discard, samplePromptData)
+}
+
+// renderPrompt renders a prompt template with data
+func renderPrompt(text string, data promptData) (string, error) {
+	tmpl, err := parsePrompt(text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// SetTimezone sets the time zone system prompts see the current time in, by its iana name, it defaults to UTC
+// set it at startup, messages being handled meanwhile read the zone without a lock
+func (s *Service) SetTimezone(name string) error {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("%w: unknown time zone %q", ErrInvalidArgument, name)
+	}
+	s.timezone = loc
+	return nil
+}
+
+// promptDataFor collects what a system prompt can refer to for a message in a conversation
+func (s *Service) promptDataFor(ctx context.Context, conversation domain.Conversation, in SendMessageInput, tools []llm.Tool) (promptData, error) {
+	users, err := s.queries.GetConversationParticipants(ctx, conversation.ID)
+	if err != nil {
+		return promptData{}, fmt.Errorf("failed to get participants: %w", err)
+	}
+	participants := make([]string, len(users))
+	for i, u := range users {
+		participants[i] = u.Name
+	}
+	toolNames := make([]string, len(tools))
+	for i, t := range tools {
+		toolNames[i] = t.Name
+	}
+
+	now := time.Now().In(s.timezone)
+	return promptData{
+		Date:         now.Format("2006-01-02"),
+		Time:         now.Format("15:04"),
+		Timezone:     s.timezone.String(),
+		Now:          now,
+		Conversation: conversation.Name,
+		Participants: participants,
+		Frontend:     in.Frontend,
+		Channel:      in.ChannelID,
+		Tools:        toolNames,
+	}, nil
+}
+
+// renderPersonality turns a personality version into a system prompt: its rendered template,
+// then its style rules and example dialogues
+func renderPersonality(v domain.PersonalityVersion, data promptData) (string, error) {
+	data.Name = v.Name
+	prompt, err := renderPrompt(v.PromptTemplate, data)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString(prompt)
+
+	if len(v.StyleRules) > 0 {
+		b.WriteString("\n\nStyle:\n")
+		for _, rule := range v.StyleRules {
+			fmt.Fprintf(&b, "- %s\n", rule)
+		}
+	}
+	if len(v.Examples) > 0 {
+		b.WriteString("\n\nExample exchanges, they show how you talk, not what to say:\n")
+		for _, e := range v.Examples {
+			fmt.Fprintf(&b, "\nUser: %s\nYou: %s\n", e.User, e.Assistant)
+		}
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// applySystemPrompt renders the config's system prompt, and the personality the conversation talks as before it,
+// and fills in the params the config leaves unset from the personality's defaults
+// the conversation's personality wins over the config's
+func (s *Service) applySystemPrompt(ctx context.Context, config domain.AIConfig, conversation domain.Conversation, data promptData) (domain.AIConfig, error) {
+	// templates are checked when saved, but a bad one may predate that
+	prompt, err := renderPrompt(config.SystemPrompt, data)
+	if err != nil {
+		return domain.AIConfig{}, fmt.Errorf("%w: ai config %s: system prompt: %v", ErrFailedPrecondition, config.Name, err)
+	}
+
+	id := config.PersonalityVersionID
+	if conversation.PersonalityVersionID != nil {
+		id = conversation.PersonalityVersionID
+	}
+	if id != nil {
+		row, err := s.queries.GetPersonalityVersion(ctx, *id)
+		if err != nil {
+			return domain.AIConfig{}, notFound(err, "personality version")
+		}
+		version := domain.PersonalityVersionFromDB(row)
+
+		persona, err := renderPersonality(version, data)
+		if err != nil {
+			return domain.AIConfig{}, fmt.Errorf("%w: personality %s: %v", ErrFailedPrecondition, version.Name, err)
+		}
+		if prompt != "" {
+			persona += "\n\n" + prompt
+		}
+		prompt = persona
+		config.Params = withDefaults(config.Params, version.Params)
+	}
+
+	config.SystemPrompt = prompt
+	return config, nil
+}
+
+// withDefaults fills in the params left unset from defaults
+func withDefaults(params, defaults domain.GenerationParams) domain.GenerationParams {
+	if params.Temperature == nil {
+		params.Temperature = defaults.Temperature
+	}
+	if params.TopP == nil {
+		params.TopP = defaults.TopP
+	}
+	if params.MaxOutputTokens == nil {
+		params.MaxOutputTokens = defaults.MaxOutputTokens
+	}
+	if params.ReasoningEffort == "" {
+		params.ReasoningEffort = defaults.ReasoningEffort
+	}
+	return params
+}
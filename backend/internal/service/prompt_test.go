@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // like main, so the test doesn't depend on the system's zoneinfo
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+func TestCheckPromptTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{"empty", "", false},
+		{"plain text", "You are helpful.", false},
+		{"variables", "Today is {{.Date}} at {{.Time}} {{.Timezone}}, in {{.Conversation}}.", false},
+		{"lists", "Talking to {{join .Participants \", \"}}.{{range .Tools}} {{.}}{{end}}", false},
+		{"methods of fields", "{{.Now.Weekday}}", false},
+		{"conditionals", "{{if .Frontend}}On {{.Frontend}}{{with .Channel}} in #{{.}}{{end}}.{{end}}", false},
+		{"unclosed action", "Today is {{.Date", true},
+		{"unknown field", "It is {{.Weather}} outside.", true},
+		{"unknown function", "{{upper .Name}}", true},
+		{"index out of range", "{{index .Participants 5}}", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkPromptTemplate(tt.template); (err != nil) != tt.wantErr {
+				t.Errorf("got %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateAIConfigRejectsBadPrompt(t *testing.T) {
+	// rejected before the database is asked about the model, the fake would fail the test otherwise
+	_, s := newFakeDB(t)
+	_, err := s.CreateAIConfig(context.Background(), AIConfigSpec{Name: "chat", ModelID: uuid.NewString(), SystemPrompt: "It is {{.Weather}}."})
+	if !errors.Is(err, ErrInvalidArgument) || !strings.Contains(err.Error(), "system_prompt") {
+		t.Errorf("got %v, want an invalid system_prompt", err)
+	}
+}
+
+func TestApplySystemPrompt(t *testing.T) {
+	db, s := newFakeDB(t)
+	if err := s.SetTimezone("Europe/Copenhagen"); err != nil {
+		t.Fatal(err)
+	}
+	db.on("GetConversationParticipants", func([]any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(userColumns, []any{uuid.NewString(), now, now, "alice"}, []any{uuid.NewString(), now, now, "bob"}), nil
+	})
+	ctx := context.Background()
+	conversation := domain.Conversation{ID: uuid.New(), Name: "trip planning"}
+
+	data, err := s.promptDataFor(ctx, conversation, SendMessageInput{Frontend: "discord", ChannelID: "general"}, []llm.Tool{{Name: "current_time"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.Timezone != "Europe/Copenhagen" || data.Now.Location().String() != "Europe/Copenhagen" || data.Date != data.Now.Format("2006-01-02") {
+		t.Errorf("got time %v as %s in %s", data.Now, data.Date, data.Timezone)
+	}
+
+	config := domain.AIConfig{Name: "chat", SystemPrompt: `{{.Conversation}} with {{join .Participants " and "}} on {{.Frontend}} #{{.Channel}}, tools: {{join .Tools ", "}}`}
+	config, err = s.applySystemPrompt(ctx, config, conversation, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "trip planning with alice and bob on discord #general, tools: current_time"; config.SystemPrompt != want {
+		t.Errorf("got prompt %q, want %q", config.SystemPrompt, want)
+	}
+
+	// a template saved before they were checked fails the message rather than reaching the provider
+	config.SystemPrompt = "{{.Weather}}"
+	if _, err := s.applySystemPrompt(ctx, config, conversation, data); !errors.Is(err, ErrFailedPrecondition) {
+		t.Errorf("got %v, want %v", err, ErrFailedPrecondition)
+	}
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+// ListProviders returns all providers ordered by name
+func (s *Service) ListProviders(ctx context.Context) ([]domain.Provider, error) {
+	rows, err := s.queries.ListProviders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list providers: %w", err)
+	}
+
+	providers := make([]domain.Provider, len(rows))
+	for i, row := range rows {
+		providers[i] = domain.ProviderFromDB(row)
+	}
+	return providers, nil
+}
+
+// providerFor resolves the llm provider implementation behind an ai config's model
+// providers registered at startup win, otherwise a row with a base_url gets an OpenAI-compatible client,
+// which is registered so later messages reuse it
+func (s *Service) providerFor(ctx context.Context, config domain.AIConfig) (llm.Provider, error) {
+	row, err := s.queries.GetProviderByID(ctx, config.Model.ProviderID)
+	if err != nil {
+		return nil, notFound(err, "provider")
+	}
+
+	provider, err := s.providers.Get(row.Name)
+	if err == nil {
+		return provider, nil
+	}
+
+	p := domain.ProviderFromDB(row)
+	if p.BaseURL == "" {
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+
+	provider, err = compatibleProvider(p)
+	if err != nil {
+		return nil, fmt.Errorf("%w: provider %s: %v", ErrUnavailable, p.Name, err)
+	}
+	s.providers.Register(p.Name, provider)
+	return provider, nil
+}
+
+// compatibleProvider builds an OpenAI-compatible provider from a provider's endpoint settings
+func compatibleProvider(p domain.Provider) (llm.Provider, error) {
+	var key string
+	if p.APIKeyEnv != "" {
+		key = os.Getenv(p.APIKeyEnv)
+		if key == "" {
+			return nil, fmt.Errorf("%s is not set", p.APIKeyEnv)
+		}
+	}
+
+	return llm.NewOpenAICompatibleProvider(llm.CompatibleConfig{
+		BaseURL: p.BaseURL,
+		APIKey:  key,
+		Headers: p.Headers,
+		Mode:    llm.APIMode(p.APIMode),
+	})
+}
+
+// providerKeyPrefix is what the environment variables compatible providers take their api key from start with,
+// so a provider row can't send the database url or another provider's key to its base url
+// the providers table checks it as well
+const providerKeyPrefix = "IO_PROVIDER_KEY_"
+
+// envName is what api_key_env has to look like
+var envName = regexp.MustCompile(`^` + providerKeyPrefix + `[A-Za-z0-9_]+$`)
+
+// CreateProvider adds a provider, providers without a base url are served by the built-in client of the same name
+func (s *Service) CreateProvider(ctx context.Context, p domain.Provider) (domain.Provider, error) {
+	p, err := validateProvider(p)
+	if err != nil {
+		return domain.Provider{}, err
+	}
+
+	params := domain.ProviderToDB(p)
+	row, err := s.queries.CreateProvider(ctx, database.CreateProviderParams{
+		Name:      params.Name,
+		BaseUrl:   params.BaseUrl,
+		ApiKeyEnv: params.ApiKeyEnv,
+		Headers:   params.Headers,
+		ApiMode:   params.ApiMode,
+	})
+	if err != nil {
+		return domain.Provider{}, fmt.Errorf("failed to create provider: %w", alreadyExists(err, "provider"))
+	}
+	return domain.ProviderFromDB(row), nil
+}
+
+// UpdateProvider replaces a provider's settings
+// a cached OpenAI-compatible client is dropped, so the next message picks up the new endpoint
+func (s *Service) UpdateProvider(ctx context.Context, providerID string, p domain.Provider) (domain.Provider, error) {
+	id, err := uuid.Parse(providerID)
+	if err != nil {
+		return domain.Provider{}, fmt.Errorf("%w: invalid provider id", ErrInvalidArgument)
+	}
+	if p, err = validateProvider(p); err != nil {
+		return domain.Provider{}, err
+	}
+	old, err := s.queries.GetProviderByID(ctx, id)
+	if err != nil {
+		return domain.Provider{}, notFound(err, "provider")
+	}
+
+	params := domain.ProviderToDB(p)
+	row, err := s.queries.UpdateProvider(ctx, database.UpdateProviderParams{
+		ID:        id,
+		Name:      params.Name,
+		BaseUrl:   params.BaseUrl,
+		ApiKeyEnv: params.ApiKeyEnv,
+		Headers:   params.Headers,
+		ApiMode:   params.ApiMode,
+	})
+	if err != nil {
+		return domain.Provider{}, fmt.Errorf("failed to update provider: %w", alreadyExists(err, "provider"))
+	}
+
+	s.forgetCompatibleProvider(domain.ProviderFromDB(old))
+	return domain.ProviderFromDB(row), nil
+}
+
+// DeleteProvider removes a provider that has no models left
+func (s *Service) DeleteProvider(ctx context.Context, providerID string) error {
+	id, err := uuid.Parse(providerID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid provider id", ErrInvalidArgument)
+	}
+	row, err := s.queries.GetProviderByID(ctx, id)
+	if err != nil {
+		return notFound(err, "provider")
+	}
+
+	// models and their ai configs would go with it, those are deleted explicitly instead
+	models, err := s.queries.ListModelsByProvider(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list models: %w", err)
+	}
+	if len(models) > 0 {
+		return fmt.Errorf("%w: provider %s still has %d models", ErrFailedPrecondition, row.Name, len(models))
+	}
+
+	n, err := s.queries.DeleteProvider(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete provider: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("provider %w", ErrNotFound)
+	}
+
+	s.forgetCompatibleProvider(domain.ProviderFromDB(row))
+	return nil
+}
+
+// forgetCompatibleProvider drops the client providerFor registered for an OpenAI-compatible provider
+// built-in clients are registered at startup and stay
+func (s *Service) forgetCompatibleProvider(p domain.Provider) {
+	if p.BaseURL != "" {
+		s.providers.Unregister(p.Name)
+	}
+}
+
+// validateProvider checks a provider's settings, filling in the default api mode
+func validateProvider(p domain.Provider) (domain.Provider, error) {
+	if strings.TrimSpace(p.Name) == "" {
+		return domain.Provider{}, fmt.Errorf("%w: name is required", ErrInvalidArgument)
+	}
+	if p.BaseURL != "" {
+		u, err := url.Parse(p.BaseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return domain.Provider{}, fmt.Errorf("%w: base_url must be an http(s) url", ErrInvalidArgument)
+		}
+	}
+	if p.APIKeyEnv != "" && !envName.MatchString(p.APIKeyEnv) {
+		return domain.Provider{}, fmt.Errorf("%w: api_key_env must be an environment variable name starting with %s", ErrInvalidArgument, providerKeyPrefix)
+	}
+
+	switch llm.APIMode(p.APIMode) {
+	case "":
+		p.APIMode = string(llm.APIModeChatCompletions)
+	case llm.APIModeChatCompletions, llm.APIModeResponses:
+	default:
+		return domain.Provider{}, fmt.Errorf("%w: api_mode must be %q or %q", ErrInvalidArgument, llm.APIModeChatCompletions, llm.APIModeResponses)
+	}
+	return p, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+func TestProviderFor(t *testing.T) {
+	db, s := newFakeDB(t)
+	registered := &fakeSummarizer{}
+	s.providers = llm.NewRegistry()
+	s.providers.Register("fake", registered)
+
+	names := map[uuid.UUID]string{uuid.New(): "fake", uuid.New(): "gone"}
+	db.on("GetProviderByID", func(args []any) (*fakeRows, error) {
+		id := uuid.MustParse(args[0].(string))
+		if names[id] == "" {
+			return rows(providerColumns), nil
+		}
+		now := time.Now()
+		return rows(providerColumns, []any{id.String(), now, now, names[id], nil, nil, []byte("{}"), ""}), nil
+	})
+
+	for id, name := range names {
+		p, err := s.providerFor(context.Background(), domain.AIConfig{Model: domain.Model{ProviderID: id}})
+		switch name {
+		case "fake":
+			// the config's model resolves to the provider registered under its provider's name
+			if err != nil || p != llm.Provider(registered) {
+				t.Errorf("got %v, %v for the registered provider", p, err)
+			}
+		case "gone":
+			// a built-in provider without its api key isn't registered
+			if !errors.Is(err, ErrUnavailable) {
+				t.Errorf("got %v, want %v", err, ErrUnavailable)
+			}
+		}
+	}
+
+	if _, err := s.providerFor(context.Background(), domain.AIConfig{Model: domain.Model{ProviderID: uuid.New()}}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got %v for an unknown provider, want %v", err, ErrNotFound)
+	}
+}
+
+func TestValidateProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider domain.Provider
+		wantMode string
+		wantErr  bool
+	}{
+		{"name only", domain.Provider{Name: "openai"}, "chat_completions", false},
+		{"compatible endpoint", domain.Provider{Name: "ollama", BaseURL: "http://localhost:11434/v1", APIMode: "responses"}, "responses", false},
+		{"key env", domain.Provider{Name: "groq", APIKeyEnv: "IO_PROVIDER_KEY_GROQ"}, "chat_completions", false},
+		{"no name", domain.Provider{Name: "  "}, "", true},
+		{"base url without a scheme", domain.Provider{Name: "ollama", BaseURL: "localhost:11434"}, "", true},
+		{"base url of another scheme", domain.Provider{Name: "ollama", BaseURL: "ftp://localhost/v1"}, "", true},
+		{"key env outside the prefix", domain.Provider{Name: "groq", APIKeyEnv: "HOME"}, "", true},
+		{"unknown api mode", domain.Provider{Name: "groq", APIMode: "completions"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateProvider(tt.provider)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("got %v, want %v", err, ErrInvalidArgument)
+			}
+			if got.APIMode != tt.wantMode {
+				t.Errorf("got api mode %q, want %q", got.APIMode, tt.wantMode)
+			}
+		})
+	}
+}
+
+func TestDeleteProviderWithModels(t *testing.T) {
+	db, s := newFakeDB(t)
+	db.on("GetProviderByID", func(args []any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(providerColumns, []any{args[0], now, now, "openai", nil, nil, []byte("{}"), "responses"}), nil
+	})
+	db.on("ListModelsByProvider", func(args []any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(modelColumns, []any{uuid.NewString(), now, args[0], "gpt-5", nil, now, "gpt-5", int64(0), int64(0), false, true, true, true}), nil
+	})
+
+	// models are deleted explicitly, the provider doesn't take them and their configs along
+	err := s.DeleteProvider(context.Background(), uuid.NewString())
+	if !errors.Is(err, ErrFailedPrecondition) {
+		t.Errorf("got %v, want %v", err, ErrFailedPrecondition)
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultSearchPageSize and maxSearchPageSize bound how many results a search returns at once
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// SearchMessagesInput is a full-text search over the messages of a user's conversations
+type SearchMessagesInput struct {
+	UserID         string // frontend user id, see userID
+	Query          string // web search syntax: words, "quoted phrases", or, -excluded
+	ConversationID string // optional, the conversation to search
+	Role           domain.Role
+	Since          *time.Time // inclusive
+	Until          *time.Time // exclusive
+	PageSize       int        // defaults to defaultSearchPageSize
+	Cursor         string     // NextCursor of the previous page
+}
+
+// MessageSearchPage is a page of search results, newest first
+type MessageSearchPage struct {
+	Results    []domain.MessageSearchResult
+	NextCursor string // empty on the last page
+}
+
+// SearchMessages finds the messages matching a query in the conversations a user participates in
+func (s *Service) SearchMessages(ctx context.Context, in SearchMessagesInput) (MessageSearchPage, error) {
+	if strings.TrimSpace(in.Query) == "" {
+		return MessageSearchPage{}, fmt.Errorf("%w: query is required", ErrInvalidArgument)
+	}
+	uid, err := userID(in.UserID)
+	if err != nil {
+		return MessageSearchPage{}, err
+	}
+
+	params := database.SearchMessagesParams{
+		Query:  in.Query,
+		UserID: uid,
+	}
+	if in.ConversationID != "" {
+		conversation, err := s.authorizedConversation(ctx, in.ConversationID, in.UserID)
+		if err != nil {
+			return MessageSearchPage{}, err
+		}
+		params.ConversationID = uuid.NullUUID{UUID: conversation.ID, Valid: true}
+	}
+	switch in.Role {
+	case "":
+	case domain.RoleUser, domain.RoleAssistant, domain.RoleSystem, domain.RoleDeveloper, domain.RoleTool:
+		params.Role = sql.NullString{String: string(in.Role), Valid: true}
+	default:
+		return MessageSearchPage{}, fmt.Errorf("%w: unsupported role %q", ErrInvalidArgument, in.Role)
+	}
+	if in.Since != nil && in.Until != nil && !in.Since.Before(*in.Until) {
+		return MessageSearchPage{}, fmt.Errorf("%w: since has to be before until", ErrInvalidArgument)
+	}
+	if in.Since != nil {
+		params.Since = sql.NullTime{Time: in.Since.UTC(), Valid: true}
+	}
+	if in.Until != nil {
+		params.Until = sql.NullTime{Time: in.Until.UTC(), Valid: true}
+	}
+	if in.Cursor != "" {
+		createdAt, id, err := decodeSearchCursor(in.Cursor)
+		if err != nil {
+			return MessageSearchPage{}, err
+		}
+		params.BeforeCreatedAt = sql.NullTime{Time: createdAt, Valid: true}
+		params.BeforeID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	size := in.PageSize
+	switch {
+	case size < 0:
+		return MessageSearchPage{}, fmt.Errorf("%w: page_size can't be negative", ErrInvalidArgument)
+	case size == 0:
+		size = defaultSearchPageSize
+	case size > maxSearchPageSize:
+		size = maxSearchPageSize
+	}
+	// one more than asked for tells whether there is a next page
+	params.MaxResults = int32(size + 1)
+
+	rows, err := s.queries.SearchMessages(ctx, params)
+	if err != nil {
+		return MessageSearchPage{}, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	var page MessageSearchPage
+	if len(rows) > size {
+		rows = rows[:size]
+		last := rows[size-1]
+		page.NextCursor = encodeSearchCursor(last.CreatedAt, last.ID)
+	}
+	page.Results = make([]domain.MessageSearchResult, len(rows))
+	for i, row := range rows {
+		page.Results[i] = domain.MessageSearchResultFromDB(row)
+	}
+	return page, nil
+}
+
+// encodeSearchCursor makes the opaque cursor for the page after a message
+func encodeSearchCursor(createdAt time.Time, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.UTC().Format(time.RFC3339Nano) + "|" + id.String()))
+}
+
+// decodeSearchCursor reads a cursor made by encodeSearchCursor
+func decodeSearchCursor(cursor string) (time.Time, uuid.UUID, error) {
+	invalid := fmt.Errorf("%w: invalid cursor", ErrInvalidArgument)
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	at, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	messageID, err := uuid.Parse(id)
+	if err != nil {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	return createdAt, messageID, nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+var messageSearchColumns = strings.Fields(`id conversation_id conversation_name role created_at snippet`)
+
+// searchDB serves matches, newest first, the way SearchMessages pages through them
+// the last match has the same created_at as the one before it, so only the id tells them apart
+func searchDB(t *testing.T, matches int) (*fakeDB, *Service) {
+	db, s := newFakeDB(t)
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var all [][]any
+	for i := range matches {
+		at := start.Add(-time.Duration(min(i, matches-2)) * time.Minute)
+		all = append(all, []any{uuid.NewString(), uuid.NewString(), "trip", "user", at, "**oslo** " + string(rune('a'+i))})
+	}
+	slices.SortStableFunc(all, func(a, b []any) int {
+		if c := b[4].(time.Time).Compare(a[4].(time.Time)); c != 0 {
+			return c
+		}
+		return strings.Compare(b[0].(string), a[0].(string))
+	})
+
+	db.on("SearchMessages", func(args []any) (*fakeRows, error) {
+		page := rows(messageSearchColumns)
+		for _, m := range all {
+			if before, ok := args[6].(time.Time); ok {
+				at := m[4].(time.Time)
+				if at.After(before) || at.Equal(before) && m[0].(string) >= args[7].(string) {
+					continue
+				}
+			}
+			if int64(len(page.values)) == args[8].(int64) {
+				break
+			}
+			page.values = append(page.values, m)
+		}
+		return page, nil
+	})
+	return db, s
+}
+
+func TestSearchMessagesPages(t *testing.T) {
+	_, s := searchDB(t, 5)
+	ctx := context.Background()
+
+	var snippets []string
+	cursor := ""
+	for pages := 1; ; pages++ {
+		page, err := s.SearchMessages(ctx, SearchMessagesInput{UserID: "alice", Query: "oslo", PageSize: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range page.Results {
+			snippets = append(snippets, r.Snippet)
+		}
+		if page.NextCursor == "" {
+			if pages != 3 {
+				t.Errorf("got %d pages, want 3", pages)
+			}
+			break
+		}
+		if pages > 3 {
+			t.Fatal("the cursor never ran out")
+		}
+		cursor = page.NextCursor
+	}
+	// every match once, none skipped where two share a created_at
+	if len(snippets) != 5 || len(slices.Compact(slices.Sorted(slices.Values(snippets)))) != 5 {
+		t.Errorf("got snippets %q", snippets)
+	}
+}
+
+func TestSearchMessagesFilters(t *testing.T) {
+	db, s := searchDB(t, 1)
+	conversationID := uuid.NewString()
+	db.on("GetConversation", func(args []any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(conversationColumns, []any{args[0], now, now, nil, "trip", nil}), nil
+	})
+	db.on("IsParticipant", func([]any) (*fakeRows, error) { return rows([]string{"exists"}, []any{true}), nil })
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+	_, err := s.SearchMessages(context.Background(), SearchMessagesInput{
+		UserID:         "alice",
+		Query:          `"road trip" -train`,
+		ConversationID: conversationID,
+		Role:           domain.RoleAssistant,
+		Since:          &since,
+		PageSize:       500,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := db.called("SearchMessages")[0].args
+	uid, _ := userID("alice")
+	if args[0] != `"road trip" -train` || args[1] != uid.String() || args[2] != conversationID || args[3] != "assistant" {
+		t.Errorf("got query, user, conversation and role %v", args[:4])
+	}
+	// times are compared in utc, unset bounds stay null
+	if at, ok := args[4].(time.Time); !ok || !at.Equal(since) || at.Location() != time.UTC || args[5] != nil {
+		t.Errorf("got since %v and until %v", args[4], args[5])
+	}
+	if args[8] != int64(maxSearchPageSize+1) {
+		t.Errorf("got limit %v, want the page size capped at %d plus one", args[8], maxSearchPageSize)
+	}
+}
+
+func TestSearchMessagesInvalid(t *testing.T) {
+	_, s := newFakeDB(t)
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		in   SearchMessagesInput
+	}{
+		{"no query", SearchMessagesInput{UserID: "alice", Query: "  "}},
+		{"no user", SearchMessagesInput{Query: "oslo"}},
+		{"unknown role", SearchMessagesInput{UserID: "alice", Query: "oslo", Role: "robot"}},
+		{"since after until", SearchMessagesInput{UserID: "alice", Query: "oslo", Since: &now, Until: &earlier}},
+		{"negative page size", SearchMessagesInput{UserID: "alice", Query: "oslo", PageSize: -1}},
+		{"garbled cursor", SearchMessagesInput{UserID: "alice", Query: "oslo", Cursor: "not a cursor"}},
+		{"truncated cursor", SearchMessagesInput{UserID: "alice", Query: "oslo", Cursor: encodeSearchCursor(now, uuid.Nil)[:20]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.SearchMessages(context.Background(), tt.in); !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("got %v, want %v", err, ErrInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestSearchCursor(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 123456789, time.FixedZone("CET", 3600))
+	id := uuid.New()
+	gotAt, gotID, err := decodeSearchCursor(encodeSearchCursor(at, id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the time is kept to the nanosecond, rounding could skip or repeat messages at a page boundary
+	if !gotAt.Equal(at) || gotID != id {
+		t.Errorf("got %v %v, want %v %v", gotAt, gotID, at, id)
+	}
+}
@@ -0,0 +1,101 @@
+// service is the package that holds io's core business logic, independent of any transport.
+// the grpc server (see internal/server) is a thin adapter on top of it
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/curator4/io/backend/internal/mcphost"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Sentinel errors, transports map these to their own status codes
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrAlreadyExists      = errors.New("already exists")
+)
+
+// Service wires the database, the llm providers and the mcp servers together
+type Service struct {
+	db        *sql.DB // for transactions, everything else goes through queries
+	queries   *database.Queries
+	providers *llm.Registry
+	mcp       *mcphost.Host
+
+	// tool loops paused for approval, by approval id. decisions reach them through this map, so approvals only
+	// work with a single backend: a decision made on another replica is stored, but the loop waiting on it only
+	// notices when its approvalTimeout runs out
+	approvalsMu sync.Mutex
+	approvals   map[uuid.UUID]chan approvalDecision
+
+	summaries     *SummaryOptions // nil while summaries are off, see EnableSummaries
+	summarizingMu sync.Mutex
+	summarizing   map[uuid.UUID]bool // conversations with a summary run going
+
+	memories   *MemoryOptions    // nil while memory extraction is off, see EnableMemories
+	embeddings *EmbeddingOptions // nil while semantic search is off, see EnableEmbeddings
+
+	timezone *time.Location // what system prompts see the current time in, see SetTimezone
+}
+
+// New creates a Service backed by the given database, provider registry and mcp host
+func New(db *sql.DB, providers *llm.Registry, mcp *mcphost.Host) *Service {
+	return &Service{
+		db:          db,
+		queries:     database.New(db),
+		providers:   providers,
+		mcp:         mcp,
+		approvals:   make(map[uuid.UUID]chan approvalDecision),
+		summarizing: make(map[uuid.UUID]bool),
+		timezone:    time.UTC,
+	}
+}
+
+// inTx runs fn with queries bound to a transaction, which is committed if fn succeeds and rolled back otherwise
+func (s *Service) inTx(ctx context.Context, fn func(q *database.Queries) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(s.queries.WithTx(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// uniqueViolation is postgres' error code for a unique constraint violation
+const uniqueViolation = "23505"
+
+// alreadyExists wraps unique constraint violations as ErrAlreadyExists, other errors are returned as is
+func alreadyExists(err error, what string) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return fmt.Errorf("%s %w", what, ErrAlreadyExists)
+	}
+	return err
+}
+
+// notFound wraps sql.ErrNoRows as ErrNotFound, other errors are returned as is
+func notFound(err error, what string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s %w", what, ErrNotFound)
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ActiveState is what a user's next message in a channel goes to
+type ActiveState struct {
+	AIConfig     domain.AIConfig
+	Conversation *domain.Conversation // nil when the next message starts a new conversation
+}
+
+// GetActiveState returns the user's active ai config and conversation in a channel, "" being no channel in particular
+func (s *Service) GetActiveState(ctx context.Context, externalUserID, channelID string) (ActiveState, error) {
+	user, err := s.ensureUser(ctx, externalUserID)
+	if err != nil {
+		return ActiveState{}, err
+	}
+
+	config, err := s.activeAIConfig(ctx, user.ID, channelID)
+	if err != nil {
+		return ActiveState{}, err
+	}
+	conversation, err := s.activeConversation(ctx, user.ID, channelID)
+	if err != nil {
+		return ActiveState{}, err
+	}
+
+	state := ActiveState{AIConfig: config}
+	if conversation != nil {
+		c := domain.ConversationFromDB(*conversation)
+		state.Conversation = &c
+	}
+	return state, nil
+}
+
+// ResumeConversation makes one of the user's conversations the active one in a channel
+func (s *Service) ResumeConversation(ctx context.Context, conversationID, externalUserID, channelID string) (domain.Conversation, error) {
+	conversation, err := s.authorizedConversation(ctx, conversationID, externalUserID)
+	if err != nil {
+		return domain.Conversation{}, err
+	}
+	user, err := s.ensureUser(ctx, externalUserID)
+	if err != nil {
+		return domain.Conversation{}, err
+	}
+
+	if err := s.setActiveConversation(ctx, user.ID, channelID, conversation.ID); err != nil {
+		return domain.Conversation{}, err
+	}
+	return conversation, nil
+}
+
+// ClearActiveConversation makes the user's next message in a channel start a new conversation
+// the old conversation stays around and can be resumed
+func (s *Service) ClearActiveConversation(ctx context.Context, externalUserID, channelID string) error {
+	user, err := s.ensureUser(ctx, externalUserID)
+	if err != nil {
+		return err
+	}
+	return s.setActiveConversation(ctx, user.ID, channelID, uuid.Nil)
+}
+
+// activeAIConfig returns the config used for a user's new messages in a channel: the channel's selection,
+// then the user's overall one, then the default config
+func (s *Service) activeAIConfig(ctx context.Context, userID uuid.UUID, channelID string) (domain.AIConfig, error) {
+	selected, err := s.queries.GetUserAIConfig(ctx, database.GetUserAIConfigParams{
+		UserID:    userID,
+		ChannelID: channelID,
+	})
+	if err == nil {
+		return domain.AIConfigFromDB(database.GetAIConfigByIDRow(selected)), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return domain.AIConfig{}, fmt.Errorf("failed to get user ai config: %w", err)
+	}
+
+	row, err := s.queries.GetDefaultAIConfig(ctx)
+	if err != nil {
+		return domain.AIConfig{}, notFound(err, "ai config")
+	}
+	return domain.AIConfigFromDB(database.GetAIConfigByIDRow(row)), nil
+}
+
+// activeConversation returns the conversation a user's next message in a channel continues, nil for a new one
+// users that never picked or cleared one continue their most recent conversation, unless they're in a channel
+func (s *Service) activeConversation(ctx context.Context, userID uuid.UUID, channelID string) (*database.Conversation, error) {
+	state, err := s.queries.GetUserState(ctx, database.GetUserStateParams{
+		UserID:    userID,
+		ChannelID: channelID,
+	})
+	switch {
+	case err == nil:
+		if !state.ConversationID.Valid {
+			return nil, nil
+		}
+		conversation, err := s.queries.GetConversation(ctx, state.ConversationID.UUID)
+		if err != nil {
+			return nil, notFound(err, "conversation")
+		}
+		return &conversation, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("failed to get user state: %w", err)
+	case channelID != "":
+		return nil, nil
+	}
+
+	recent, err := s.queries.GetUserConversations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+	if len(recent) == 0 {
+		return nil, nil
+	}
+	return &recent[0], nil
+}
+
+// setActiveConversation stores the user's active conversation in a channel, uuid.Nil clears it
+func (s *Service) setActiveConversation(ctx context.Context, userID uuid.UUID, channelID string, conversationID uuid.UUID) error {
+	err := s.queries.SetUserConversation(ctx, database.SetUserConversationParams{
+		UserID:         userID,
+		ChannelID:      channelID,
+		ConversationID: uuid.NullUUID{UUID: conversationID, Valid: conversationID != uuid.Nil},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set active conversation: %w", err)
+	}
+	return nil
+}
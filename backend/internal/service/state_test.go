@@ -0,0 +1,80 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+var userStateColumns = strings.Fields(`user_id channel_id ai_config_id conversation_id updated_at`)
+
+func TestSendMessageUsesSelectedConfig(t *testing.T) {
+	p := newPipeline(t, domain.MessageContent{Text: "hi"})
+	if _, err := p.send("", "hello"); err != nil {
+		t.Fatal(err)
+	}
+	p.selected = "picked"
+	if _, err := p.send("", "hello again"); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(p.provider.configs, " "); got != "default picked" {
+		t.Errorf("got configs %q, want the default until one is picked", got)
+	}
+}
+
+func TestSendMessageActiveConversation(t *testing.T) {
+	now := time.Now()
+	earlier := uuid.NewString()
+	tests := []struct {
+		name    string
+		channel string
+		state   bool // whether the user has state in the channel
+		active  any  // the state's conversation, nil once cleared
+		want    string
+	}{
+		{"picked", "", true, earlier, earlier},
+		{"picked in a channel", "general", true, earlier, earlier},
+		{"cleared", "", true, nil, "new"},
+		{"most recent without state", "", false, nil, earlier},
+		// a channel the user never talked in starts fresh rather than continuing another channel's conversation
+		{"new channel", "general", false, nil, "new"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPipeline(t, domain.MessageContent{Text: "hi"})
+			p.channel = tt.channel
+			p.db.on("GetUserState", func(args []any) (*fakeRows, error) {
+				if !tt.state {
+					return rows(userStateColumns), nil
+				}
+				return rows(userStateColumns, []any{args[0], args[1], nil, tt.active, now}), nil
+			})
+			p.db.on("GetUserConversations", func([]any) (*fakeRows, error) {
+				return rows(conversationColumns, []any{earlier, now, now, now, "earlier", nil}), nil
+			})
+
+			result, err := p.send("", "hello")
+			if err != nil {
+				t.Fatal(err)
+			}
+			created := len(p.db.called("CreateConversation")) == 1
+			if tt.want == "new" {
+				if !created {
+					t.Errorf("continued %s, want a new conversation", result.ConversationID)
+				}
+				// the new conversation becomes the active one in the channel
+				set := p.db.called("SetUserConversation")
+				if len(set) != 1 || set[0].args[1] != tt.channel || set[0].args[2] != result.ConversationID.String() {
+					t.Errorf("got active conversation updates %+v", set)
+				}
+				return
+			}
+			if created || result.ConversationID.String() != tt.want {
+				t.Errorf("got conversation %s, new %v, want %s", result.ConversationID, created, tt.want)
+			}
+		})
+	}
+}
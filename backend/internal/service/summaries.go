@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultSummaryThreshold is how many tokens of unsummarized history a conversation may grow to
+	defaultSummaryThreshold = 8000
+	// summaryTimeout bounds a background summary run, which may take a few generations
+	summaryTimeout = 2 * time.Minute
+	// summaryToolResultLength is how many characters of a tool result the summarizer gets to see
+	summaryToolResultLength = 2000
+
+	summaryPrompt = `You keep a running summary of a chat conversation. You get the summary so far, if there is one, ` +
+		`and the messages that came after it. Reply with an updated summary that replaces the old one: who is involved, ` +
+		`what was discussed and decided, facts and preferences people shared, open questions and anything still in progress. ` +
+		`Keep names, numbers and specifics, drop small talk. Write plain prose, no preamble.`
+	// summaryIntro heads the summary where it stands in for the older messages
+	summaryIntro = "Summary of the earlier conversation, those messages are left out:\n\n"
+)
+
+// SummaryOptions turn on rolling summaries of long conversations
+type SummaryOptions struct {
+	AIConfig        string // name of the ai config that writes the summaries, a cheap fast model does fine
+	ThresholdTokens int    // unsummarized history beyond this gets summarized, down to half of it
+}
+
+// EnableSummaries turns on rolling summaries, conversations are checked in the background after every message
+// the summary ai config is looked up by name on every run, so it can be created after startup
+func (s *Service) EnableSummaries(opts SummaryOptions) {
+	if opts.ThresholdTokens <= 0 {
+		opts.ThresholdTokens = defaultSummaryThreshold
+	}
+	s.summaries = &opts
+}
+
+// summarizedHistory loads a conversation's history for the model: its latest summary as a system message,
+// followed by the messages after it. it also returns how many messages the summary stands in for
+func (s *Service) summarizedHistory(ctx context.Context, conversationID uuid.UUID) ([]domain.Message, int, error) {
+	history, err := s.history(ctx, conversationID)
+	if err != nil {
+		return nil, 0, err
+	}
+	summary, err := s.latestSummary(ctx, conversationID)
+	if err != nil || summary == nil {
+		return history, 0, err
+	}
+
+	messages := []domain.Message{{
+		ConversationID: conversationID,
+		Role:           domain.RoleSystem,
+		Content:        domain.MessageContent{Text: summaryIntro + summary.Content},
+		CreatedAt:      summary.CreatedAt,
+	}}
+	messages = append(messages, unsummarized(history, summary)...)
+	return messages, int(summary.MessageCount), nil
+}
+
+// summarizeLater summarizes a conversation in the background, if summaries are on and no run is going yet
+func (s *Service) summarizeLater(conversationID uuid.UUID) {
+	if s.summaries == nil {
+		return
+	}
+	s.summarizingMu.Lock()
+	defer s.summarizingMu.Unlock()
+	if s.summarizing[conversationID] {
+		return
+	}
+	s.summarizing[conversationID] = true
+
+	go func() {
+		defer func() {
+			s.summarizingMu.Lock()
+			delete(s.summarizing, conversationID)
+			s.summarizingMu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), summaryTimeout)
+		defer cancel()
+		if err := s.summarize(ctx, conversationID); err != nil {
+			log.Printf("failed to summarize conversation %s: %v", conversationID, err)
+		}
+	}()
+}
+
+// summarize brings a conversation's summary up to date once its unsummarized history passes the threshold
+// the most recent messages stay out of it, and histories too long for the summary model are taken in chunks
+func (s *Service) summarize(ctx context.Context, conversationID uuid.UUID) error {
+	config, err := s.aiConfigByName(ctx, s.summaries.AIConfig, "summary ai config")
+	if err != nil {
+		return err
+	}
+	config.SystemPrompt = summaryPrompt
+
+	provider, err := s.providerFor(ctx, config)
+	if err != nil {
+		return err
+	}
+	tok := provider.TokenEstimator(config.Model)
+
+	history, err := s.history(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	summary, err := s.latestSummary(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk := 4 * s.summaries.ThresholdTokens
+		if config.Model.ContextWindow > 0 {
+			var previous int
+			if summary != nil {
+				previous = tok.Estimate(summary.Content)
+			}
+			chunk = min(chunk, contextBudget(config, tok, nil)-previous)
+		}
+
+		covered := summaryRange(tok, unsummarized(history, summary), s.summaries.ThresholdTokens, chunk)
+		if len(covered) == 0 {
+			return nil
+		}
+		if summary, err = s.writeSummary(ctx, provider, config, conversationID, summary, covered); err != nil {
+			return err
+		}
+	}
+}
+
+// summaryRange picks the messages the next summary takes in, none while the history is under the threshold
+// about half the threshold of recent messages stays verbatim, starting with a user message, and at most
+// chunk tokens are taken at once. a message and its tool results are never split up
+func summaryRange(tok llm.TokenEstimator, history []domain.Message, threshold, chunk int) []domain.Message {
+	units := historyUnits(history)
+	costs := make([]int, len(units))
+	var total int
+	for i, unit := range units {
+		costs[i] = unitTokens(tok, unit)
+		total += costs[i]
+	}
+	if total <= threshold {
+		return nil
+	}
+
+	cut, kept := len(units), 0
+	for cut > 0 && kept+costs[cut-1] <= threshold/2 {
+		cut--
+		kept += costs[cut]
+	}
+	for cut > 0 && cut < len(units) && isReply(units[cut][0]) {
+		cut--
+	}
+
+	var covered []domain.Message
+	var size int
+	for i := 0; i < cut && (i == 0 || size+costs[i] <= chunk); i++ {
+		covered = append(covered, units[i]...)
+		size += costs[i]
+	}
+	return covered
+}
+
+// writeSummary has the model fold messages into the previous summary and stores the result
+func (s *Service) writeSummary(ctx context.Context, provider llm.Provider, config domain.AIConfig, conversationID uuid.UUID, previous *database.ConversationSummary, covered []domain.Message) (*database.ConversationSummary, error) {
+	var input strings.Builder
+	if previous != nil {
+		input.WriteString("Summary so far:\n")
+		input.WriteString(previous.Content)
+		input.WriteString("\n\n")
+	}
+	input.WriteString("Messages:\n")
+	input.WriteString(transcript(covered))
+
+	reply, err := provider.SendMessage(ctx, []domain.Message{{
+		ConversationID: conversationID,
+		Role:           domain.RoleUser,
+		Content:        domain.MessageContent{Text: input.String()},
+	}}, config, nil)
+	if err != nil {
+		return nil, fmt.Errorf("provider error: %w", err)
+	}
+	text := strings.TrimSpace(reply.Message.Content.Text)
+	if text == "" {
+		return nil, errors.New("summary came back empty")
+	}
+
+	params := database.CreateConversationSummaryParams{
+		ConversationID: conversationID,
+		FirstMessageID: covered[0].ID,
+		LastMessageID:  covered[len(covered)-1].ID,
+		MessageCount:   int32(len(covered)),
+		Content:        text,
+	}
+	if previous != nil {
+		params.FirstMessageID = previous.FirstMessageID
+		params.MessageCount += previous.MessageCount
+	}
+	summary, err := s.queries.CreateConversationSummary(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store summary: %w", err)
+	}
+	return &summary, nil
+}
+
+// latestSummary returns a conversation's current summary, nil if it has none
+func (s *Service) latestSummary(ctx context.Context, conversationID uuid.UUID) (*database.ConversationSummary, error) {
+	summary, err := s.queries.GetLatestConversationSummary(ctx, conversationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get summary: %w", err)
+	}
+	return &summary, nil
+}
+
+// unsummarized returns the messages after the ones a summary covers
+func unsummarized(history []domain.Message, summary *database.ConversationSummary) []domain.Message {
+	if summary == nil {
+		return history
+	}
+	for i, m := range history {
+		if m.ID == summary.LastMessageID {
+			return history[i+1:]
+		}
+	}
+	return history
+}
+
+// transcript renders messages as plain text for the summarizer
+func transcript(messages []domain.Message) string {
+	var b strings.Builder
+	for _, m := range messages {
+		if r := m.Content.ToolResult; r != nil {
+			content := []rune(r.Content)
+			if len(content) > summaryToolResultLength {
+				content = append(content[:summaryToolResultLength], []rune(truncatedMarker)...)
+			}
+			fmt.Fprintf(&b, "[%s returned] %s\n", r.Name, string(content))
+			continue
+		}
+
+		speaker := string(m.Role)
+		if m.User != nil && m.User.Name != "" {
+			speaker = m.User.Name
+		}
+		if m.Content.Text != "" {
+			fmt.Fprintf(&b, "%s: %s\n", speaker, m.Content.Text)
+		}
+		for _, item := range m.Content.Media {
+			fmt.Fprintf(&b, "%s: [%s %s]\n", speaker, item.Type, item.FileName)
+		}
+		for _, call := range m.Content.ToolCalls {
+			fmt.Fprintf(&b, "%s: [called %s with %s]\n", speaker, call.Name, call.Arguments)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+// conversation is a history of n messages costing 20 tokens each, alternating user and assistant
+func conversation(n int) []domain.Message {
+	history := make([]domain.Message, n)
+	for i := range history {
+		role := domain.RoleUser
+		if i%2 == 1 {
+			role = domain.RoleAssistant
+		}
+		history[i] = msg(role, fmt.Sprintf("m%d", i), 20-overhead)
+		history[i].ID = uuid.New()
+	}
+	return history
+}
+
+func TestSummaryRange(t *testing.T) {
+	call := msg(domain.RoleAssistant, "call", 20-overhead)
+	call.Content.ToolCalls = []domain.ToolCall{{ID: "1", Name: "search"}}
+	withTools := append(conversation(3), call, msg(domain.RoleTool, "r1", 20-overhead), msg(domain.RoleTool, "r2", 20-overhead))
+	withTools = append(withTools, conversation(4)...)
+
+	tests := []struct {
+		name             string
+		history          []domain.Message
+		threshold, chunk int
+		want             string
+	}{
+		{"under the threshold", conversation(4), 100, 1000, ""},
+		{"right at the threshold", conversation(5), 100, 1000, ""},
+		// 50 tokens of recent messages stay, that is the last two
+		{"keeps half the threshold", conversation(10), 100, 1000, "m0 m1 m2 m3 m4 m5 m6 m7"},
+		// three would fit in 60, but the kept messages can't start with m7, a reply
+		{"kept messages start with a user message", conversation(10), 120, 1000, "m0 m1 m2 m3 m4 m5"},
+		{"at most a chunk", conversation(10), 100, 50, "m0 m1"},
+		{"always at least one message", conversation(10), 100, 10, "m0"},
+		// the call and its results come as one, about 66 tokens, the chunk ends before them rather than inside
+		{"tool results stay with their call", withTools, 100, 70, "m0 m1 m2"},
+		{"tool results come along", withTools, 100, 130, "m0 m1 m2 call r1 r2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := texts(summaryRange(charEstimator{}, tt.history, tt.threshold, tt.chunk)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeSummarizer is a provider that answers every request with the next of "s1", "s2", ...
+type fakeSummarizer struct {
+	mu     sync.Mutex
+	inputs []string
+}
+
+func (p *fakeSummarizer) SendMessage(_ context.Context, messages []domain.Message, config domain.AIConfig, _ []llm.Tool) (*llm.Response, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if config.SystemPrompt != summaryPrompt {
+		return nil, errors.New("not asked for a summary")
+	}
+	p.inputs = append(p.inputs, messages[len(messages)-1].Content.Text)
+	reply := domain.Message{Role: domain.RoleAssistant, Content: domain.MessageContent{Text: fmt.Sprintf(" s%d\n", len(p.inputs))}}
+	return &llm.Response{Message: reply, FinishReason: llm.FinishStop}, nil
+}
+
+func (p *fakeSummarizer) StreamMessage(context.Context, []domain.Message, domain.AIConfig, []llm.Tool, llm.StreamHandler) (*llm.Response, error) {
+	return nil, errors.New("summaries aren't streamed")
+}
+
+func (p *fakeSummarizer) ValidateParams(domain.Model, domain.GenerationParams) error { return nil }
+func (p *fakeSummarizer) TokenEstimator(domain.Model) llm.TokenEstimator             { return charEstimator{} }
+
+var (
+	aiConfigColumns = strings.Fields(`id created_at updated_at last_used_at name model_id system_prompt temperature top_p
+		max_output_tokens reasoning_effort stop_sequences provider_options context_strategy personality_version_id`)
+	modelColumns = strings.Fields(`id created_at provider_id name description updated_at provider_model_id context_window
+		max_output_tokens supports_vision supports_tools supports_streaming supports_reasoning`)
+	providerColumns = strings.Fields(`id created_at updated_at name base_url api_key_env headers api_mode`)
+	messageColumns  = strings.Fields(`id created_at updated_at conversation_id user_id role content user_name user_created_at user_updated_at`)
+	summaryColumns  = strings.Fields(`id conversation_id first_message_id last_message_id message_count content created_at`)
+)
+
+// summaryDB serves a conversation and the "summarizer" ai config, whose model has a context window of
+// window tokens (0 for unknown), to summarize. the provider records what it was asked to summarize
+func summaryDB(t *testing.T, history []domain.Message, window int, previous []any) (*fakeDB, *Service, *fakeSummarizer) {
+	db, s := newFakeDB(t)
+	p := &fakeSummarizer{}
+	s.providers = llm.NewRegistry()
+	s.providers.Register("fake", p)
+	s.EnableSummaries(SummaryOptions{AIConfig: "summarizer", ThresholdTokens: 100})
+
+	now := time.Now()
+	configID, modelID, providerID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	config := []any{configID, now, now, nil, "summarizer", modelID, nil, nil, nil, nil, nil, nil, []byte("{}"), "drop_oldest", nil}
+	var contextWindow any
+	if window > 0 {
+		contextWindow = int64(window)
+	}
+	db.on("GetAIConfigByName", func(args []any) (*fakeRows, error) {
+		if args[0] != "summarizer" {
+			t.Errorf("looked up ai config %v", args[0])
+		}
+		return rows(aiConfigColumns, config), nil
+	})
+	db.on("GetAIConfigByID", func([]any) (*fakeRows, error) {
+		model := []any{modelID, now, providerID, "small", nil, now, "small-1", contextWindow, nil, false, false, false, false}
+		return rows(append(append([]string{}, aiConfigColumns...), modelColumns...), append(append([]any{}, config...), model...)), nil
+	})
+	db.on("GetProviderByID", func([]any) (*fakeRows, error) {
+		return rows(providerColumns, []any{providerID, now, now, "fake", nil, nil, []byte("{}"), ""}), nil
+	})
+	db.on("GetMessagesByConversation", func([]any) (*fakeRows, error) {
+		messages := rows(messageColumns)
+		for _, m := range history {
+			content, _ := json.Marshal(m.Content)
+			messages.values = append(messages.values, []any{m.ID.String(), now, now, uuid.NewString(), nil, string(m.Role), content, nil, nil, nil})
+		}
+		return messages, nil
+	})
+	db.on("GetLatestConversationSummary", func([]any) (*fakeRows, error) {
+		if previous == nil {
+			return rows(summaryColumns), nil
+		}
+		return rows(summaryColumns, previous), nil
+	})
+	db.on("CreateConversationSummary", func(args []any) (*fakeRows, error) {
+		return rows(summaryColumns, append(append([]any{uuid.NewString()}, args...), now)), nil
+	})
+	return db, s, p
+}
+
+// written describes the summaries stored, "first..last count content" each
+func written(db *fakeDB, history []domain.Message) []string {
+	index := make(map[string]string)
+	for _, m := range history {
+		index[m.ID.String()] = strings.TrimRight(m.Content.Text, ".")
+	}
+	var summaries []string
+	for _, c := range db.called("CreateConversationSummary") {
+		summaries = append(summaries, fmt.Sprintf("%s..%s %v %v", index[c.args[1].(string)], index[c.args[2].(string)], c.args[3], c.args[4]))
+	}
+	return summaries
+}
+
+func TestSummarize(t *testing.T) {
+	history := conversation(10)
+	db, s, p := summaryDB(t, history, 0, nil)
+
+	if err := s.summarize(context.Background(), uuid.New()); err != nil {
+		t.Fatal(err)
+	}
+	// one run takes in all but the most recent messages, then the rest is under the threshold
+	want := []string{"m0..m7 8 s1"}
+	if got := written(db, history); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got summaries %q, want %q", got, want)
+	}
+	if len(p.inputs) != 1 || strings.Contains(p.inputs[0], "Summary so far") || !strings.Contains(p.inputs[0], "assistant: m7") {
+		t.Errorf("got inputs %q", p.inputs)
+	}
+}
+
+func TestSummarizeInChunks(t *testing.T) {
+	history := conversation(10)
+	// the model has room for the prompt, a previous summary and three messages
+	db, s, p := summaryDB(t, history, windowFor(len(summaryPrompt)+2+60), nil)
+
+	if err := s.summarize(context.Background(), uuid.New()); err != nil {
+		t.Fatal(err)
+	}
+	// each run folds the next messages into the summary so far, until the rest is under the threshold
+	want := []string{"m0..m2 3 s1", "m0..m5 6 s2"}
+	if got := written(db, history); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got summaries %q, want %q", got, want)
+	}
+	if len(p.inputs) != 2 || !strings.HasPrefix(p.inputs[1], "Summary so far:\ns1\n\nMessages:\nassistant: m3") {
+		t.Errorf("got inputs %q", p.inputs)
+	}
+	if n := len(db.called("GetMessagesByConversation")); n != 1 {
+		t.Errorf("history loaded %d times, want once", n)
+	}
+}
+
+func TestSummarizeContinuesSummary(t *testing.T) {
+	history := conversation(14)
+	previous := []any{uuid.NewString(), uuid.NewString(), history[0].ID.String(), history[3].ID.String(), int64(4), "old", time.Now()}
+	db, s, p := summaryDB(t, history, 0, previous)
+
+	if err := s.summarize(context.Background(), uuid.New()); err != nil {
+		t.Fatal(err)
+	}
+	// the new summary picks up after the old one and covers everything it did
+	want := []string{"m0..m11 12 s1"}
+	if got := written(db, history); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got summaries %q, want %q", got, want)
+	}
+	if len(p.inputs) != 1 || !strings.HasPrefix(p.inputs[0], "Summary so far:\nold\n\nMessages:\nuser: m4") {
+		t.Errorf("got inputs %q", p.inputs)
+	}
+}
+
+func TestSummarizeUnderThreshold(t *testing.T) {
+	history := conversation(5)
+	db, s, p := summaryDB(t, history, 0, nil)
+
+	if err := s.summarize(context.Background(), uuid.New()); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.inputs) != 0 || len(db.called("CreateConversationSummary")) != 0 {
+		t.Errorf("summarized a short conversation")
+	}
+}
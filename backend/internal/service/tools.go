@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxToolSteps bounds how many rounds of tool calls a single message may trigger
+	maxToolSteps = 10
+	// toolTimeout bounds a single tool call
+	toolTimeout = 2 * time.Minute
+)
+
+// builtinTool is a tool implemented by the backend itself, advertised next to the ones on mcp servers
+// mcp tool names always contain "__" (see mcphost), so builtin names can't collide with them
+type builtinTool struct {
+	tool llm.Tool
+	run  func(ctx context.Context, arguments string) (string, error)
+}
+
+var builtinTools = map[string]builtinTool{
+	"current_time": {
+		tool: llm.Tool{
+			Name:        "current_time",
+			Description: "Returns the current date and time, optionally in a given IANA time zone.",
+			Parameters: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"timezone": map[string]any{
+						"type":        "string",
+						"description": "IANA time zone, e.g. Europe/Copenhagen. Defaults to UTC.",
+					},
+				},
+			},
+		},
+		run: currentTime,
+	},
+}
+
+// toolset is what the model may use under an ai config
+type toolset struct {
+	tools    []llm.Tool
+	approval map[string]bool // names of the tools whose calls wait for a user's consent
+}
+
+// has reports whether the model was given the named tool
+func (t toolset) has(name string) bool {
+	return slices.ContainsFunc(t.tools, func(tool llm.Tool) bool { return tool.Name == name })
+}
+
+// toolsFor returns the tools advertised to the model under an ai config, builtins first
+// mcp tools only come from the servers attached to the config, and the config's tool rules are applied to all of them
+func (s *Service) toolsFor(ctx context.Context, configID uuid.UUID) (toolset, error) {
+	servers, err := s.queries.ListAIConfigMCPServers(ctx, configID)
+	if err != nil {
+		return toolset{}, fmt.Errorf("failed to list mcp servers: %w", err)
+	}
+	ruleRows, err := s.queries.ListToolRules(ctx, configID)
+	if err != nil {
+		return toolset{}, fmt.Errorf("failed to list tool rules: %w", err)
+	}
+
+	names := make([]string, 0, len(builtinTools))
+	for name := range builtinTools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tools := make([]llm.Tool, 0, len(names))
+	for _, name := range names {
+		tools = append(tools, builtinTools[name].tool)
+	}
+
+	serverNames := make([]string, len(servers))
+	for i, server := range servers {
+		serverNames[i] = server.Name
+	}
+	tools = append(tools, s.mcp.Tools(serverNames)...)
+
+	rules := make([]domain.ToolRule, len(ruleRows))
+	for i, row := range ruleRows {
+		rules[i] = domain.ToolRuleFromDB(row)
+	}
+	return applyToolRules(tools, rules), nil
+}
+
+// applyToolRules drops denied tools, and once anything is allowed explicitly, everything that isn't
+// tools matching an approve rule count as allowed, but are marked as needing approval
+func applyToolRules(tools []llm.Tool, rules []domain.ToolRule) toolset {
+	allowList := false
+	for _, rule := range rules {
+		if rule.Rule == ruleAllow || rule.Rule == ruleApprove {
+			allowList = true
+		}
+	}
+
+	set := toolset{tools: make([]llm.Tool, 0, len(tools)), approval: make(map[string]bool)}
+	for _, tool := range tools {
+		if matchesRule(tool.Name, rules, ruleDeny) {
+			continue
+		}
+		gated := matchesRule(tool.Name, rules, ruleApprove)
+		if allowList && !gated && !matchesRule(tool.Name, rules, ruleAllow) {
+			continue
+		}
+		set.tools = append(set.tools, tool)
+		if gated {
+			set.approval[tool.Name] = true
+		}
+	}
+	return set
+}
+
+// matchesRule reports whether any rule of the given kind matches a tool name
+func matchesRule(name string, rules []domain.ToolRule, kind string) bool {
+	for _, rule := range rules {
+		if rule.Rule != kind {
+			continue
+		}
+		if ok, _ := path.Match(rule.ToolName, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// runTool executes a tool call, as long as it is one of the tools the model was given
+// failures become error results rather than errors, so the model gets to see them and can recover
+func (s *Service) runTool(ctx context.Context, call domain.ToolCall, tools toolset) domain.ToolResult {
+	ctx, cancel := context.WithTimeout(ctx, toolTimeout)
+	defer cancel()
+
+	result := domain.ToolResult{CallID: call.ID, Name: call.Name}
+	if !tools.has(call.Name) {
+		result.Content, result.IsError = fmt.Sprintf("tool %s is not available", call.Name), true
+		return result
+	}
+
+	if builtin, ok := builtinTools[call.Name]; ok {
+		output, err := builtin.run(ctx, call.Arguments)
+		if err != nil {
+			result.Content, result.IsError = err.Error(), true
+			return result
+		}
+		result.Content = output
+		return result
+	}
+
+	output, err := s.mcp.CallTool(ctx, call.Name, call.Arguments)
+	if err != nil {
+		result.Content, result.IsError = err.Error(), true
+		return result
+	}
+	result.Content, result.IsError = output.Content, output.IsError
+	return result
+}
+
+// currentTime implements the current_time builtin
+func currentTime(_ context.Context, arguments string) (string, error) {
+	var args struct {
+		Timezone string `json:"timezone"`
+	}
+	if strings.TrimSpace(arguments) != "" {
+		if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+			return "", fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	loc := time.UTC
+	if args.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(args.Timezone); err != nil {
+			return "", fmt.Errorf("unknown time zone %q", args.Timezone)
+		}
+	}
+	return time.Now().In(loc).Format("Monday, 2 January 2006 15:04:05 MST"), nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/curator4/io/backend/internal/llm"
+)
+
+var toolRuleColumns = strings.Fields(`ai_config_id tool_name rule created_at updated_at`)
+
+func TestApplyToolRules(t *testing.T) {
+	tools := []llm.Tool{{Name: "current_time"}, {Name: "shell__run"}, {Name: "shell__read"}, {Name: "docs__search"}}
+	rule := func(name, kind string) domain.ToolRule { return domain.ToolRule{ToolName: name, Rule: kind} }
+
+	tests := []struct {
+		name         string
+		rules        []domain.ToolRule
+		want         string
+		wantApproval string
+	}{
+		{"no rules", nil, "current_time shell__run shell__read docs__search", ""},
+		{"deny", []domain.ToolRule{rule("shell__run", ruleDeny)}, "current_time shell__read docs__search", ""},
+		{"deny a whole server", []domain.ToolRule{rule("shell__*", ruleDeny)}, "current_time docs__search", ""},
+		{"allow lists everything else out", []domain.ToolRule{rule("docs__*", ruleAllow)}, "docs__search", ""},
+		{"deny wins over allow", []domain.ToolRule{rule("shell__*", ruleAllow), rule("shell__run", ruleDeny)}, "shell__read", ""},
+		{"approve allows", []domain.ToolRule{rule("current_time", ruleAllow), rule("shell__run", ruleApprove)}, "current_time shell__run", "shell__run"},
+		{"deny wins over approve", []domain.ToolRule{rule("shell__*", ruleApprove), rule("shell__run", ruleDeny)}, "shell__read", "shell__read"},
+		{"globs don't cross into other names", []domain.ToolRule{rule("shell", ruleDeny)}, "current_time shell__run shell__read docs__search", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := applyToolRules(tools, tt.rules)
+			var got, approval []string
+			for _, tool := range set.tools {
+				got = append(got, tool.Name)
+				if set.approval[tool.Name] {
+					approval = append(approval, tool.Name)
+				}
+			}
+			if strings.Join(got, " ") != tt.want || strings.Join(approval, " ") != tt.wantApproval {
+				t.Errorf("got %v needing approval %v, want %q needing approval %q", got, approval, tt.want, tt.wantApproval)
+			}
+		})
+	}
+}
+
+func TestSendMessageDeniedTool(t *testing.T) {
+	call := domain.MessageContent{ToolCalls: []domain.ToolCall{{ID: "call-1", Name: "current_time", Arguments: "{}"}}}
+	p := newPipeline(t, call, domain.MessageContent{Text: "no clock"})
+	p.db.on("ListToolRules", func(args []any) (*fakeRows, error) {
+		now := time.Now()
+		return rows(toolRuleColumns, []any{args[0], "current_*", ruleDeny, now, now}), nil
+	})
+
+	result, err := p.send("", "what time is it?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the model wasn't offered the tool, and calling it anyway doesn't run it
+	r := result.ToolMessages[1].Content.ToolResult
+	if r == nil || !r.IsError || r.Content != "tool current_time is not available" {
+		t.Errorf("got result %+v", r)
+	}
+}
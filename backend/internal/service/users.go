@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/curator4/io/backend/internal/database"
+	"github.com/curator4/io/backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// userID maps a frontend user id to a users.id
+// frontends like discord have their own id formats (snowflakes), those are hashed into a stable uuid
+func userID(externalID string) (uuid.UUID, error) {
+	if externalID == "" {
+		return uuid.Nil, fmt.Errorf("%w: user_id is required", ErrInvalidArgument)
+	}
+	if id, err := uuid.Parse(externalID); err == nil {
+		return id, nil
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(externalID)), nil
+}
+
+// ensureUser returns the user for a frontend user id, creating it on first contact
+func (s *Service) ensureUser(ctx context.Context, externalID string) (domain.User, error) {
+	id, err := userID(externalID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	user, err := s.queries.GetUserByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		user, err = s.queries.CreateUser(ctx, database.CreateUserParams{
+			ID:   id,
+			Name: externalID,
+		})
+	}
+	if err != nil {
+		return domain.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return domain.UserFromDB(user), nil
+}
@@ -0,0 +1,30 @@
+-- name: AttachMCPServer :exec
+INSERT INTO ai_config_mcp_servers (ai_config_id, mcp_server_id, created_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT DO NOTHING;
+
+-- name: DetachMCPServer :execrows
+DELETE FROM ai_config_mcp_servers
+WHERE ai_config_id = $1 AND mcp_server_id = $2;
+
+-- name: ListAIConfigMCPServers :many
+SELECT s.* FROM mcp_servers s
+JOIN ai_config_mcp_servers a ON a.mcp_server_id = s.id
+WHERE a.ai_config_id = $1
+ORDER BY s.name;
+
+-- name: SetToolRule :one
+INSERT INTO ai_config_tool_rules (ai_config_id, tool_name, rule, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
+ON CONFLICT (ai_config_id, tool_name) DO UPDATE
+SET rule = EXCLUDED.rule, updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteToolRule :execrows
+DELETE FROM ai_config_tool_rules
+WHERE ai_config_id = $1 AND tool_name = $2;
+
+-- name: ListToolRules :many
+SELECT * FROM ai_config_tool_rules
+WHERE ai_config_id = $1
+ORDER BY tool_name;
@@ -1,12 +1,14 @@
 -- name: CreateAIConfig :one
-INSERT INTO ai_configs (id, created_at, updated_at, name, model_id, system_prompt)
+INSERT INTO ai_configs (id, created_at, updated_at, name, model_id, system_prompt, context_strategy, personality_version_id)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
   $1,
   $2,
-  $3
+  $3,
+  $4,
+  $5
 )
 RETURNING *;
 
@@ -22,9 +24,38 @@ FROM ai_configs ac
 JOIN models m ON ac.model_id = m.id
 WHERE ac.id = $1;
 
+-- name: GetDefaultAIConfig :one
+-- the config of users who haven't picked one: the oldest, so it doesn't change with what others use
+SELECT
+  ac.*,
+  sqlc.embed(m)
+FROM ai_configs ac
+JOIN models m ON ac.model_id = m.id
+ORDER BY ac.created_at, ac.id
+LIMIT 1;
+
 -- name: ListAIConfigs :many
-SELECT * FROM ai_configs
-ORDER BY name;
+SELECT
+  ac.*,
+  sqlc.embed(m)
+FROM ai_configs ac
+JOIN models m ON ac.model_id = m.id
+ORDER BY ac.name;
+
+-- name: UpdateAIConfig :exec
+UPDATE ai_configs
+SET
+  name = $2,
+  model_id = $3,
+  system_prompt = $4,
+  context_strategy = $5,
+  personality_version_id = $6,
+  updated_at = NOW()
+WHERE id = $1;
+
+-- name: CountAIConfigsByModel :one
+SELECT COUNT(*) FROM ai_configs
+WHERE model_id = $1;
 
 -- name: UpdateAIConfigModel :one
 UPDATE ai_configs
@@ -42,11 +73,23 @@ SET
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateAIConfigParams :exec
+UPDATE ai_configs
+SET
+  temperature = $2,
+  top_p = $3,
+  max_output_tokens = $4,
+  reasoning_effort = $5,
+  stop_sequences = $6,
+  provider_options = $7,
+  updated_at = NOW()
+WHERE id = $1;
+
 -- name: UpdateAIConfigLastUsed :exec
 UPDATE ai_configs
 SET last_used_at = NOW()
 WHERE id = $1;
 
--- name: DeleteAIConfig :exec
+-- name: DeleteAIConfig :execrows
 DELETE FROM ai_configs
 WHERE id = $1;
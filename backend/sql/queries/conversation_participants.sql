@@ -1,6 +1,7 @@
 -- name: AddParticipant :exec
 INSERT INTO conversation_participants (conversation_id, user_id)
-VALUES ($1, $2);
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
 
 -- name: RemoveParticipant :exec
 DELETE FROM conversation_participants
@@ -11,6 +12,12 @@ SELECT u.* FROM users u
 JOIN conversation_participants cp ON u.id = cp.user_id
 WHERE cp.conversation_id = $1;
 
+-- name: IsParticipant :one
+SELECT EXISTS (
+  SELECT 1 FROM conversation_participants
+  WHERE conversation_id = $1 AND user_id = $2
+);
+
 -- name: GetUserConversations :many
 SELECT c.* FROM conversations c
 JOIN conversation_participants cp ON c.id = cp.conversation_id
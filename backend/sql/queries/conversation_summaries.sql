@@ -0,0 +1,10 @@
+-- name: CreateConversationSummary :one
+INSERT INTO conversation_summaries (conversation_id, first_message_id, last_message_id, message_count, content)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetLatestConversationSummary :one
+SELECT * FROM conversation_summaries
+WHERE conversation_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
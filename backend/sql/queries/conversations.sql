@@ -33,3 +33,11 @@ WHERE id = $1;
 -- name: DeleteConversation :exec
 DELETE FROM conversations
 WHERE id = $1;
+
+-- name: UpdateConversationPersonality :one
+UPDATE conversations
+SET
+  personality_version_id = $2,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,17 @@
+-- name: CreateDocument :one
+INSERT INTO documents (user_id, name, content)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetDocument :one
+SELECT * FROM documents
+WHERE id = $1;
+
+-- name: ListDocuments :many
+SELECT * FROM documents
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteDocument :execrows
+DELETE FROM documents
+WHERE id = $1;
@@ -0,0 +1,55 @@
+-- name: CreateEmbedding :exec
+INSERT INTO embeddings (message_id, memory_id, document_id, chunk_index, content, embedder, embedding)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: DeleteMemoryEmbeddings :exec
+DELETE FROM embeddings
+WHERE memory_id = $1;
+
+-- name: SearchEmbeddings :many
+-- the chunks nearest to a query vector that a user may see: messages of their conversations,
+-- their own and the global memories, and their documents. excluded are the given messages and memories,
+-- ones the model sees anyway
+SELECT
+  e.id,
+  e.message_id,
+  e.memory_id,
+  e.document_id,
+  e.content,
+  doc.name AS document_name,
+  (e.embedding <=> sqlc.arg(query)::vector)::float8 AS distance
+FROM embeddings e
+LEFT JOIN messages msg ON msg.id = e.message_id
+LEFT JOIN memories mem ON mem.id = e.memory_id
+LEFT JOIN documents doc ON doc.id = e.document_id
+WHERE e.embedder = sqlc.arg(embedder)
+  AND (
+    msg.conversation_id IN (SELECT cp.conversation_id FROM conversation_participants cp WHERE cp.user_id = sqlc.arg(user_id))
+    OR (e.memory_id IS NOT NULL AND (mem.user_id IS NULL OR mem.user_id = sqlc.arg(user_id)))
+    OR doc.user_id = sqlc.arg(user_id)
+  )
+  AND COALESCE(e.message_id, e.memory_id, e.document_id) <> ALL(sqlc.arg(exclude)::uuid[])
+ORDER BY e.embedding <=> sqlc.arg(query)::vector
+LIMIT sqlc.arg(max_results);
+
+-- name: ListUnembeddedMessages :many
+-- user and assistant messages with text that the embedder hasn't seen yet, oldest first
+-- text has to have a non-space character, any whitespace counts as space like for chunkText
+SELECT * FROM messages m
+WHERE m.role IN ('user', 'assistant')
+  AND m.content->>'text' ~ '\S'
+  AND NOT EXISTS (SELECT 1 FROM embeddings e WHERE e.message_id = m.id AND e.embedder = $1)
+ORDER BY m.created_at
+LIMIT $2;
+
+-- name: ListUnembeddedMemories :many
+SELECT * FROM memories m
+WHERE NOT EXISTS (SELECT 1 FROM embeddings e WHERE e.memory_id = m.id AND e.embedder = $1)
+ORDER BY m.created_at
+LIMIT $2;
+
+-- name: ListUnembeddedDocuments :many
+SELECT * FROM documents d
+WHERE NOT EXISTS (SELECT 1 FROM embeddings e WHERE e.document_id = d.id AND e.embedder = $1)
+ORDER BY d.created_at
+LIMIT $2;
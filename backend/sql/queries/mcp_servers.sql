@@ -0,0 +1,32 @@
+-- name: CreateMCPServer :one
+INSERT INTO mcp_servers (id, created_at, updated_at, name, transport, command, args, env, url, headers)
+VALUES (
+  gen_random_uuid(),
+  NOW(),
+  NOW(),
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+RETURNING *;
+
+-- name: GetMCPServer :one
+SELECT * FROM mcp_servers
+WHERE name = $1;
+
+-- name: ListMCPServers :many
+SELECT * FROM mcp_servers
+ORDER BY name;
+
+-- name: ListEnabledMCPServers :many
+SELECT * FROM mcp_servers
+WHERE enabled
+ORDER BY name;
+
+-- name: DeleteMCPServer :exec
+DELETE FROM mcp_servers
+WHERE name = $1;
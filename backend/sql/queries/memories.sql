@@ -0,0 +1,49 @@
+-- name: CreateMemory :one
+INSERT INTO memories (user_id, content, normalized)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetMemory :one
+SELECT * FROM memories
+WHERE id = $1;
+
+-- name: FindMemory :one
+-- the memory a fact duplicates, if any
+SELECT * FROM memories
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg(user_id)::uuid AND normalized = sqlc.arg(normalized);
+
+-- name: ListMemories :many
+-- a user's memories, followed by the global ones
+SELECT * FROM memories
+WHERE user_id = $1 OR user_id IS NULL
+ORDER BY user_id NULLS LAST, created_at;
+
+-- name: RecallMemories :many
+-- a user's and the global memories matching a text search query, best match first
+SELECT * FROM memories
+WHERE (user_id = sqlc.arg(user_id) OR user_id IS NULL)
+  AND to_tsvector('english', content) @@ to_tsquery('english', sqlc.arg(query))
+ORDER BY ts_rank(to_tsvector('english', content), to_tsquery('english', sqlc.arg(query))) DESC, created_at DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: UpdateMemory :one
+UPDATE memories
+SET
+  content = $2,
+  normalized = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteMemory :execrows
+DELETE FROM memories
+WHERE id = $1;
+
+-- name: AddMemorySource :exec
+INSERT INTO memory_sources (memory_id, message_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: ListMemorySources :many
+SELECT * FROM memory_sources
+WHERE memory_id = ANY(sqlc.arg(memory_ids)::uuid[]);
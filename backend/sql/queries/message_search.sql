@@ -0,0 +1,29 @@
+-- name: SearchMessages :many
+-- messages matching a web search style query in the conversations a user participates in, newest first.
+-- the filters are optional, and the cursor is the created_at and id of the last message of the previous page
+SELECT
+  m.id,
+  m.conversation_id,
+  c.name AS conversation_name,
+  m.role,
+  m.created_at,
+  ts_headline(
+    'english',
+    COALESCE(m.content->>'text', ''),
+    websearch_to_tsquery('english', sqlc.arg(query)),
+    'StartSel=**, StopSel=**, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "'
+  )::text AS snippet
+FROM messages m
+JOIN conversations c ON c.id = m.conversation_id
+WHERE to_tsvector('english', COALESCE(m.content->>'text', '')) @@ websearch_to_tsquery('english', sqlc.arg(query))
+  AND m.conversation_id IN (SELECT cp.conversation_id FROM conversation_participants cp WHERE cp.user_id = sqlc.arg(user_id))
+  AND (sqlc.narg(conversation_id)::uuid IS NULL OR m.conversation_id = sqlc.narg(conversation_id)::uuid)
+  AND (sqlc.narg(role)::text IS NULL OR m.role = sqlc.narg(role)::text)
+  AND (sqlc.narg(since)::timestamp IS NULL OR m.created_at >= sqlc.narg(since)::timestamp)
+  AND (sqlc.narg(until)::timestamp IS NULL OR m.created_at < sqlc.narg(until)::timestamp)
+  AND (
+    sqlc.narg(before_created_at)::timestamp IS NULL
+    OR (m.created_at, m.id) < (sqlc.narg(before_created_at)::timestamp, sqlc.narg(before_id)::uuid)
+  )
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT sqlc.arg(max_results);
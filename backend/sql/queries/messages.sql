@@ -1,19 +1,23 @@
 -- name: CreateMessage :one
-INSERT INTO messages (id, created_at, updated_at, conversation_id, role, content)
+INSERT INTO messages (id, created_at, updated_at, conversation_id, user_id, role, content)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
   $1,
   $2,
-  $3
+  $3,
+  $4
 )
 RETURNING *;
 
 -- name: GetMessagesByConversation :many
+-- user columns are selected individually since the join is nullable for assistant messages
 SELECT
   m.*,
-  sqlc.embed(u)
+  u.name AS user_name,
+  u.created_at AS user_created_at,
+  u.updated_at AS user_updated_at
 FROM messages m
 LEFT JOIN users u ON m.user_id = u.id
 WHERE m.conversation_id = $1
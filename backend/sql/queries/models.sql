@@ -1,12 +1,22 @@
 -- name: CreateModel :one
-INSERT INTO models (id, created_at, updated_at, provider_id, name, description)
+INSERT INTO models (
+  id, created_at, updated_at, provider_id, name, description, provider_model_id, context_window,
+  max_output_tokens, supports_vision, supports_tools, supports_streaming, supports_reasoning
+)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
   $1,
   $2,
-  $3
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10
 )
 RETURNING *;
 
@@ -27,6 +37,22 @@ SELECT * FROM models
 WHERE provider_id = $1
 ORDER BY name;
 
--- name: DeleteModel :exec
+-- name: UpdateModel :one
+UPDATE models
+SET
+  name = $2,
+  description = $3,
+  provider_model_id = $4,
+  context_window = $5,
+  max_output_tokens = $6,
+  supports_vision = $7,
+  supports_tools = $8,
+  supports_streaming = $9,
+  supports_reasoning = $10,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteModel :execrows
 DELETE FROM models
 WHERE id = $1;
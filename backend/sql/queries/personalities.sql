@@ -0,0 +1,71 @@
+-- name: CreatePersonality :one
+INSERT INTO personalities (name, description)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetPersonality :one
+SELECT * FROM personalities
+WHERE id = $1;
+
+-- name: ListPersonalities :many
+SELECT * FROM personalities
+ORDER BY name;
+
+-- name: UpdatePersonality :one
+UPDATE personalities
+SET
+  name = $2,
+  description = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePersonality :execrows
+DELETE FROM personalities
+WHERE id = $1;
+
+-- name: CreatePersonalityVersion :one
+-- versions count up per personality, the unique constraint catches concurrent edits
+INSERT INTO personality_versions (
+  personality_id, version, prompt_template, style_rules, examples,
+  temperature, top_p, max_output_tokens, reasoning_effort
+)
+VALUES (
+  $1,
+  (SELECT COALESCE(MAX(version), 0) + 1 FROM personality_versions WHERE personality_id = $1),
+  $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetPersonalityVersion :one
+SELECT pv.*, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+WHERE pv.id = $1;
+
+-- name: GetLatestPersonalityVersion :one
+SELECT pv.*, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+WHERE pv.personality_id = $1
+ORDER BY pv.version DESC
+LIMIT 1;
+
+-- name: ListLatestPersonalityVersions :many
+-- the newest version of every personality
+SELECT DISTINCT ON (pv.personality_id) pv.*, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+ORDER BY pv.personality_id, pv.version DESC;
+
+-- name: ListPersonalityVersions :many
+SELECT pv.*, p.name AS personality_name
+FROM personality_versions pv
+JOIN personalities p ON pv.personality_id = p.id
+WHERE pv.personality_id = $1
+ORDER BY pv.version DESC;
+
+-- name: CountAIConfigsByPersonality :one
+SELECT COUNT(*) FROM ai_configs ac
+JOIN personality_versions pv ON ac.personality_version_id = pv.id
+WHERE pv.personality_id = $1;
@@ -1,10 +1,14 @@
 -- name: CreateProvider :one
-INSERT INTO providers (id, created_at, updated_at, name)
+INSERT INTO providers (id, created_at, updated_at, name, base_url, api_key_env, headers, api_mode)
 VALUES (
   gen_random_uuid(),
   NOW(),
   NOW(),
-  $1
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
 )
 RETURNING *;
 
@@ -12,10 +16,26 @@ RETURNING *;
 SELECT * FROM providers
 WHERE name = $1;
 
+-- name: GetProviderByID :one
+SELECT * FROM providers
+WHERE id = $1;
+
 -- name: ListProviders :many
 SELECT * FROM providers
 ORDER BY name;
 
--- name: DeleteProvider :exec
+-- name: UpdateProvider :one
+UPDATE providers
+SET
+  name = $2,
+  base_url = $3,
+  api_key_env = $4,
+  headers = $5,
+  api_mode = $6,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteProvider :execrows
 DELETE FROM providers
-WHERE name = $1;
+WHERE id = $1;
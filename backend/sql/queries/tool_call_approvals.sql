@@ -0,0 +1,25 @@
+-- name: CreateToolApproval :one
+INSERT INTO tool_call_approvals (message_id, call_id, tool_name, arguments, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetToolApproval :one
+-- the conversation is joined in so callers can check the deciding user participates in it
+SELECT a.*, m.conversation_id
+FROM tool_call_approvals a
+JOIN messages m ON m.id = a.message_id
+WHERE a.id = $1;
+
+-- name: DecideToolApproval :one
+-- only pending approvals can be decided, the first decision wins
+UPDATE tool_call_approvals
+SET status = $2, decided_by = $3, reason = $4, decided_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: ExpireOverdueToolApprovals :execrows
+-- approvals still pending past their deadline belong to generations that died with their process, a live tool loop
+-- expires its own approval on time. ones that aren't due yet may be waited on by another running backend
+UPDATE tool_call_approvals
+SET status = 'expired', decided_at = now()
+WHERE status = 'pending' AND expires_at < now();
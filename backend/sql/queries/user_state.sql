@@ -0,0 +1,31 @@
+-- name: GetUserState :one
+SELECT * FROM user_state
+WHERE user_id = $1 AND channel_id = $2;
+
+-- name: GetUserAIConfig :one
+-- the channel's own selection wins over the user's overall one
+SELECT
+  ac.*,
+  sqlc.embed(m)
+FROM user_state us
+JOIN ai_configs ac ON us.ai_config_id = ac.id
+JOIN models m ON ac.model_id = m.id
+WHERE us.user_id = $1 AND (us.channel_id = $2 OR us.channel_id = '')
+ORDER BY us.channel_id DESC
+LIMIT 1;
+
+-- name: SetUserAIConfig :exec
+INSERT INTO user_state (user_id, channel_id, ai_config_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel_id) DO UPDATE
+SET
+  ai_config_id = EXCLUDED.ai_config_id,
+  updated_at = NOW();
+
+-- name: SetUserConversation :exec
+INSERT INTO user_state (user_id, channel_id, conversation_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, channel_id) DO UPDATE
+SET
+  conversation_id = EXCLUDED.conversation_id,
+  updated_at = NOW();
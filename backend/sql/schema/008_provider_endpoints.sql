@@ -0,0 +1,17 @@
+-- +goose Up
+-- providers with a base_url are served by the generic openai-compatible client (grok, ollama, llama.cpp, vllm...)
+-- api_key_env names the environment variable holding the key, so secrets stay out of the database
+-- only IO_PROVIDER_KEY_ variables, a row must not be able to send the database url or another key to its base_url
+ALTER TABLE providers
+  ADD COLUMN base_url TEXT,
+  ADD COLUMN api_key_env TEXT CHECK (api_key_env ~ '^IO_PROVIDER_KEY_[A-Za-z0-9_]+$'),
+  ADD COLUMN headers JSONB NOT NULL DEFAULT '{}',
+  ADD COLUMN api_mode TEXT NOT NULL DEFAULT 'chat_completions'
+    CHECK (api_mode IN ('chat_completions', 'responses'));
+
+-- +goose Down
+ALTER TABLE providers
+  DROP COLUMN api_mode,
+  DROP COLUMN headers,
+  DROP COLUMN api_key_env,
+  DROP COLUMN base_url;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- mcp servers the backend connects to as a host, their tools are advertised to the model
+-- env holds extra environment variables for the server process, on top of the backend's own
+CREATE TABLE mcp_servers (
+  id UUID PRIMARY KEY,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  updated_at TIMESTAMP NOT NULL DEFAULT now(),
+  name TEXT NOT NULL UNIQUE,
+  transport TEXT NOT NULL DEFAULT 'stdio' CHECK (transport IN ('stdio')),
+  command TEXT NOT NULL,
+  args TEXT[] NOT NULL DEFAULT '{}',
+  env JSONB NOT NULL DEFAULT '{}',
+  enabled BOOLEAN NOT NULL DEFAULT true
+);
+
+-- +goose Down
+DROP TABLE mcp_servers;
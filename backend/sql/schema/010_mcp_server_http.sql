@@ -0,0 +1,22 @@
+-- +goose Up
+-- remote mcp servers are reached over streamable http (or the legacy sse transport) at url instead of being launched
+-- header values may reference IO_MCP_SECRET_ environment variables as ${NAME}, so tokens stay out of the database
+ALTER TABLE mcp_servers
+  ALTER COLUMN command DROP NOT NULL,
+  ADD COLUMN url TEXT,
+  ADD COLUMN headers JSONB NOT NULL DEFAULT '{}',
+  DROP CONSTRAINT mcp_servers_transport_check,
+  ADD CONSTRAINT mcp_servers_transport_check
+    CHECK (transport IN ('stdio', 'streamable_http', 'sse')),
+  ADD CONSTRAINT mcp_servers_endpoint_check
+    CHECK ((transport = 'stdio' AND command IS NOT NULL) OR (transport <> 'stdio' AND url IS NOT NULL));
+
+-- +goose Down
+DELETE FROM mcp_servers WHERE transport <> 'stdio';
+ALTER TABLE mcp_servers
+  DROP CONSTRAINT mcp_servers_endpoint_check,
+  DROP CONSTRAINT mcp_servers_transport_check,
+  ADD CONSTRAINT mcp_servers_transport_check CHECK (transport IN ('stdio')),
+  DROP COLUMN headers,
+  DROP COLUMN url,
+  ALTER COLUMN command SET NOT NULL;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- content is a MessageContent (see domain), tool_calls only appear on assistant messages
+-- and every tool message carries exactly one tool_result
+ALTER TABLE messages
+  ADD CONSTRAINT messages_role_check
+    CHECK (role IN ('user', 'assistant', 'system', 'developer', 'tool')),
+  ADD CONSTRAINT messages_tool_calls_check
+    CHECK (content -> 'tool_calls' IS NULL OR (role = 'assistant' AND jsonb_typeof(content -> 'tool_calls') = 'array')),
+  ADD CONSTRAINT messages_tool_result_check
+    CHECK ((role = 'tool') = (jsonb_typeof(content -> 'tool_result') IS NOT DISTINCT FROM 'object'));
+
+-- +goose Down
+ALTER TABLE messages
+  DROP CONSTRAINT messages_tool_result_check,
+  DROP CONSTRAINT messages_tool_calls_check,
+  DROP CONSTRAINT messages_role_check;
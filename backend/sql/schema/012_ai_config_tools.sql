@@ -0,0 +1,24 @@
+-- +goose Up
+-- an ai config only sees the tools of the mcp servers attached to it
+CREATE TABLE ai_config_mcp_servers (
+  ai_config_id UUID NOT NULL REFERENCES ai_configs(id) ON DELETE CASCADE,
+  mcp_server_id UUID NOT NULL REFERENCES mcp_servers(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  PRIMARY KEY (ai_config_id, mcp_server_id)
+);
+
+-- rules narrow that down further, tool_name is the advertised name ("shell__run", "current_time")
+-- or a glob over it ("filesystem__write_*"). denied tools are dropped, and once a config allows
+-- anything explicitly only allowed tools are left
+CREATE TABLE ai_config_tool_rules (
+  ai_config_id UUID NOT NULL REFERENCES ai_configs(id) ON DELETE CASCADE,
+  tool_name TEXT NOT NULL,
+  rule TEXT NOT NULL CHECK (rule IN ('allow', 'deny')),
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  updated_at TIMESTAMP NOT NULL DEFAULT now(),
+  PRIMARY KEY (ai_config_id, tool_name)
+);
+
+-- +goose Down
+DROP TABLE ai_config_tool_rules;
+DROP TABLE ai_config_mcp_servers;
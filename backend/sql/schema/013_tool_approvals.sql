@@ -0,0 +1,28 @@
+-- +goose Up
+-- an 'approve' rule makes matching tools available, but every call waits for a user's consent
+ALTER TABLE ai_config_tool_rules
+  DROP CONSTRAINT ai_config_tool_rules_rule_check,
+  ADD CONSTRAINT ai_config_tool_rules_rule_check CHECK (rule IN ('allow', 'deny', 'approve'));
+
+-- one row per tool call that needed approval, next to the assistant message that made the call
+CREATE TABLE tool_call_approvals (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+  call_id TEXT NOT NULL,
+  tool_name TEXT NOT NULL,
+  arguments TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'expired')),
+  decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  reason TEXT,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  expires_at TIMESTAMP NOT NULL,
+  decided_at TIMESTAMP,
+  UNIQUE (message_id, call_id)
+);
+
+-- +goose Down
+DROP TABLE tool_call_approvals;
+DELETE FROM ai_config_tool_rules WHERE rule = 'approve';
+ALTER TABLE ai_config_tool_rules
+  DROP CONSTRAINT ai_config_tool_rules_rule_check,
+  ADD CONSTRAINT ai_config_tool_rules_rule_check CHECK (rule IN ('allow', 'deny'));
@@ -0,0 +1,20 @@
+-- +goose Up
+-- generation params, null (or empty) leaves the provider's default. whether a model accepts a param
+-- depends on the model, that is checked by the backend when the params are saved
+ALTER TABLE ai_configs
+  ADD COLUMN temperature DOUBLE PRECISION CHECK (temperature >= 0 AND temperature <= 2),
+  ADD COLUMN top_p DOUBLE PRECISION CHECK (top_p > 0 AND top_p <= 1),
+  ADD COLUMN max_output_tokens INTEGER CHECK (max_output_tokens > 0),
+  ADD COLUMN reasoning_effort TEXT CHECK (reasoning_effort IN ('minimal', 'low', 'medium', 'high')),
+  ADD COLUMN stop_sequences TEXT[] NOT NULL DEFAULT '{}',
+  -- merged into the provider's request body as is, for anything io has no param for
+  ADD COLUMN provider_options JSONB NOT NULL DEFAULT '{}' CHECK (jsonb_typeof(provider_options) = 'object');
+
+-- +goose Down
+ALTER TABLE ai_configs
+  DROP COLUMN provider_options,
+  DROP COLUMN stop_sequences,
+  DROP COLUMN reasoning_effort,
+  DROP COLUMN max_output_tokens,
+  DROP COLUMN top_p,
+  DROP COLUMN temperature;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- models become the registry providers resolve models from. name is what io calls a model,
+-- provider_model_id is what the provider's api calls it
+ALTER TABLE models
+  ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT now(),
+  ADD COLUMN provider_model_id TEXT,
+  ADD COLUMN context_window INTEGER CHECK (context_window > 0),
+  ADD COLUMN max_output_tokens INTEGER CHECK (max_output_tokens > 0),
+  ADD COLUMN supports_vision BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN supports_tools BOOLEAN NOT NULL DEFAULT true,
+  ADD COLUMN supports_streaming BOOLEAN NOT NULL DEFAULT true,
+  ADD COLUMN supports_reasoning BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE models SET provider_model_id = CASE name
+  WHEN 'gpt-5.1' THEN 'gpt-5-chat-latest'
+  ELSE name
+END;
+
+ALTER TABLE models
+  ALTER COLUMN provider_model_id SET NOT NULL,
+  ADD CONSTRAINT models_provider_name_key UNIQUE (provider_id, name);
+
+-- what used to be hard-coded for the models io shipped with
+UPDATE models SET context_window = 128000, max_output_tokens = 16384, supports_vision = true
+WHERE name = 'gpt-5.1';
+UPDATE models SET context_window = 400000, max_output_tokens = 128000, supports_vision = true, supports_reasoning = true
+WHERE name IN ('gpt-5-nano', 'gpt-5-mini');
+UPDATE models SET context_window = 200000, max_output_tokens = 64000, supports_vision = true, supports_reasoning = true
+WHERE name IN ('claude-opus-4-5', 'claude-sonnet-4-5', 'claude-haiku-4-5');
+UPDATE models SET context_window = 1048576, max_output_tokens = 65536, supports_vision = true, supports_reasoning = true
+WHERE name IN ('gemini-2.5-pro', 'gemini-2.5-flash', 'gemini-2.5-flash-lite');
+
+-- +goose Down
+ALTER TABLE models
+  DROP CONSTRAINT models_provider_name_key,
+  DROP COLUMN supports_reasoning,
+  DROP COLUMN supports_streaming,
+  DROP COLUMN supports_tools,
+  DROP COLUMN supports_vision,
+  DROP COLUMN max_output_tokens,
+  DROP COLUMN context_window,
+  DROP COLUMN provider_model_id,
+  DROP COLUMN updated_at;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- what a user has selected, per frontend channel. channel_id '' is the user's overall selection,
+-- which channels without their own ai config fall back to
+CREATE TABLE user_state (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  channel_id TEXT NOT NULL DEFAULT '',
+  -- null falls back to the overall selection, then to the most recently used config
+  ai_config_id UUID REFERENCES ai_configs(id) ON DELETE SET NULL,
+  -- null means the next message starts a new conversation
+  conversation_id UUID REFERENCES conversations(id) ON DELETE SET NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (user_id, channel_id)
+);
+
+-- +goose Down
+DROP TABLE user_state;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- how a conversation that outgrew the model's context window is cut down: drop_oldest leaves out
+-- the oldest messages, truncate_oldest first shortens them to an excerpt so the thread stays visible
+ALTER TABLE ai_configs
+  ADD COLUMN context_strategy TEXT NOT NULL DEFAULT 'drop_oldest'
+    CHECK (context_strategy IN ('drop_oldest', 'truncate_oldest'));
+
+-- +goose Down
+ALTER TABLE ai_configs
+  DROP COLUMN context_strategy;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- rolling summaries of long conversations. each one takes in the summary before it, so the latest
+-- covers everything from first_message_id through last_message_id and is the only one that is used
+CREATE TABLE conversation_summaries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+  first_message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+  last_message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+  message_count INTEGER NOT NULL CHECK (message_count > 0),
+  content TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX conversation_summaries_conversation_idx ON conversation_summaries (conversation_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE conversation_summaries;
@@ -0,0 +1,41 @@
+-- +goose Up
+CREATE TABLE personalities (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- versions are never edited, a change to a personality adds a version, so configs and conversations
+-- that reference an older one keep behaving the same
+CREATE TABLE personality_versions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  personality_id UUID NOT NULL REFERENCES personalities(id) ON DELETE CASCADE,
+  version INTEGER NOT NULL,
+  prompt_template TEXT NOT NULL,
+  style_rules TEXT[] NOT NULL DEFAULT '{}',
+  -- [{"user": "...", "assistant": "..."}], shown to the model as how the personality talks
+  examples JSONB NOT NULL DEFAULT '[]' CHECK (jsonb_typeof(examples) = 'array'),
+  -- defaults for the generation params an ai config leaves unset, same rules as on ai_configs
+  temperature DOUBLE PRECISION CHECK (temperature >= 0 AND temperature <= 2),
+  top_p DOUBLE PRECISION CHECK (top_p > 0 AND top_p <= 1),
+  max_output_tokens INTEGER CHECK (max_output_tokens > 0),
+  reasoning_effort TEXT CHECK (reasoning_effort IN ('minimal', 'low', 'medium', 'high')),
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  UNIQUE (personality_id, version)
+);
+
+-- a conversation's personality wins over its ai config's
+ALTER TABLE ai_configs
+  ADD COLUMN personality_version_id UUID REFERENCES personality_versions(id) ON DELETE SET NULL;
+ALTER TABLE conversations
+  ADD COLUMN personality_version_id UUID REFERENCES personality_versions(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE conversations
+  DROP COLUMN personality_version_id;
+ALTER TABLE ai_configs
+  DROP COLUMN personality_version_id;
+DROP TABLE personality_versions;
+DROP TABLE personalities;
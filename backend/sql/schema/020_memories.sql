@@ -0,0 +1,27 @@
+-- +goose Up
+-- long-term memory, facts worth keeping across conversations. a memory belongs to a user,
+-- or without one it is global and recalled in everyone's conversations
+CREATE TABLE memories (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  content TEXT NOT NULL,
+  -- content folded for deduplication, see normalizeMemory
+  normalized TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX memories_normalized_idx ON memories (COALESCE(user_id, '00000000-0000-0000-0000-000000000000'), normalized);
+CREATE INDEX memories_user_idx ON memories (user_id);
+CREATE INDEX memories_search_idx ON memories USING GIN (to_tsvector('english', content));
+
+-- the messages a memory was learned from, a fact that comes up again gets its new sources added
+CREATE TABLE memory_sources (
+  memory_id UUID NOT NULL REFERENCES memories(id) ON DELETE CASCADE,
+  message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+  PRIMARY KEY (memory_id, message_id)
+);
+
+-- +goose Down
+DROP TABLE memory_sources;
+DROP TABLE memories;
@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS vector;
+
+-- text users hand io to draw on, it is only ever seen through retrieval
+CREATE TABLE documents (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  content TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX documents_user_idx ON documents (user_id, created_at DESC);
+
+-- chunks of messages, memories and documents embedded for semantic search, each from exactly one of them.
+-- vectors of different embedders aren't comparable, only the configured embedder's are searched.
+-- the dimensions are fixed so the index works, see llm.EmbeddingDimensions
+CREATE TABLE embeddings (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  message_id UUID REFERENCES messages(id) ON DELETE CASCADE,
+  memory_id UUID REFERENCES memories(id) ON DELETE CASCADE,
+  document_id UUID REFERENCES documents(id) ON DELETE CASCADE,
+  chunk_index INTEGER NOT NULL DEFAULT 0,
+  content TEXT NOT NULL,
+  embedder TEXT NOT NULL,
+  embedding vector(1536) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  CHECK (num_nonnulls(message_id, memory_id, document_id) = 1)
+);
+
+CREATE INDEX embeddings_message_idx ON embeddings (message_id);
+CREATE INDEX embeddings_memory_idx ON embeddings (memory_id);
+CREATE INDEX embeddings_document_idx ON embeddings (document_id);
+CREATE INDEX embeddings_vector_idx ON embeddings USING hnsw (embedding vector_cosine_ops);
+
+-- +goose Down
+DROP TABLE embeddings;
+DROP TABLE documents;
+DROP EXTENSION IF EXISTS vector;
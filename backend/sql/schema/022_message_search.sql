@@ -0,0 +1,8 @@
+-- +goose Up
+-- full-text search over message text, queries have to use the same expression to hit the index
+CREATE INDEX messages_search_idx ON messages USING GIN (to_tsvector('english', COALESCE(content->>'text', '')));
+CREATE INDEX messages_conversation_created_idx ON messages (conversation_id, created_at DESC, id DESC);
+
+-- +goose Down
+DROP INDEX messages_conversation_created_idx;
+DROP INDEX messages_search_idx;
@@ -0,0 +1,147 @@
+import { credentials, Metadata } from '@grpc/grpc-js';
+import {
+  AdminServiceClient,
+  ConnectMCPServerResponse,
+  CreateAIConfigRequest,
+  CreateAIConfigResponse,
+  CreateModelRequest,
+  CreateModelResponse,
+  CreateProviderRequest,
+  CreateProviderResponse,
+  DeleteAIConfigResponse,
+  DeleteModelResponse,
+  DeleteProviderResponse,
+  DisconnectMCPServerResponse,
+  ListModelsRequest,
+  ListModelsResponse,
+  RestartMCPServerResponse,
+} from './generated/io';
+
+// AdminClient calls the backend AdminService, every call carries IO_ADMIN_TOKEN
+export class AdminClient {
+  private client: AdminServiceClient;
+  private metadata: Metadata;
+
+  constructor(host: string, port: number, token: string) {
+    const address = `${host}:${port}`;
+    this.client = new AdminServiceClient(address, credentials.createInsecure());
+    this.metadata = new Metadata();
+    this.metadata.set('authorization', `Bearer ${token}`);
+  }
+
+  async listModels(request: ListModelsRequest): Promise<ListModelsResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.listModels(request, this.metadata, (error, response) => {
+        if (error) reject(error);
+        else resolve(response);
+      });
+    });
+  }
+
+  async createProvider(
+    request: CreateProviderRequest,
+  ): Promise<CreateProviderResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.createProvider(
+        request,
+        this.metadata,
+        (error, response) => {
+          if (error) reject(error);
+          else resolve(response);
+        },
+      );
+    });
+  }
+
+  async deleteProvider(id: string): Promise<DeleteProviderResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.deleteProvider({ id }, this.metadata, (error, response) => {
+        if (error) reject(error);
+        else resolve(response);
+      });
+    });
+  }
+
+  async createModel(request: CreateModelRequest): Promise<CreateModelResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.createModel(request, this.metadata, (error, response) => {
+        if (error) reject(error);
+        else resolve(response);
+      });
+    });
+  }
+
+  async deleteModel(id: string): Promise<DeleteModelResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.deleteModel({ id }, this.metadata, (error, response) => {
+        if (error) reject(error);
+        else resolve(response);
+      });
+    });
+  }
+
+  async createAIConfig(
+    request: CreateAIConfigRequest,
+  ): Promise<CreateAIConfigResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.createAIConfig(
+        request,
+        this.metadata,
+        (error, response) => {
+          if (error) reject(error);
+          else resolve(response);
+        },
+      );
+    });
+  }
+
+  async deleteAIConfig(id: string): Promise<DeleteAIConfigResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.deleteAIConfig({ id }, this.metadata, (error, response) => {
+        if (error) reject(error);
+        else resolve(response);
+      });
+    });
+  }
+
+  async connectMCPServer(name: string): Promise<ConnectMCPServerResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.connectMCPServer(
+        { name },
+        this.metadata,
+        (error, response) => {
+          if (error) reject(error);
+          else resolve(response);
+        },
+      );
+    });
+  }
+
+  async disconnectMCPServer(
+    name: string,
+  ): Promise<DisconnectMCPServerResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.disconnectMCPServer(
+        { name },
+        this.metadata,
+        (error, response) => {
+          if (error) reject(error);
+          else resolve(response);
+        },
+      );
+    });
+  }
+
+  async restartMCPServer(name: string): Promise<RestartMCPServerResponse> {
+    return new Promise((resolve, reject) => {
+      this.client.restartMCPServer(
+        { name },
+        this.metadata,
+        (error, response) => {
+          if (error) reject(error);
+          else resolve(response);
+        },
+      );
+    });
+  }
+}
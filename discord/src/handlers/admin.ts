@@ -0,0 +1,140 @@
+import { Message } from 'discord.js';
+import { AdminClient } from '../grpc/admin';
+
+// adminUsage lists the catalog commands, replied to anything else starting with "io admin"
+const adminUsage = [
+  'io admin models [provider id]',
+  'io admin provider add <name> [base url] [IO_PROVIDER_KEY_ variable]',
+  'io admin provider rm <id>',
+  'io admin model add <provider id> <name> [provider model id]',
+  'io admin model rm <id>',
+  'io admin config add <name> <model id>',
+  'io admin config rm <id>',
+  'io admin mcp connect|disconnect|restart <server name>',
+].join('\n');
+
+// isAdminCommand is a helper that tells "io admin ..." messages apart from chat
+export const isAdminCommand = (message: Message): boolean =>
+  /^io admin\b/i.test(message.content);
+
+// runAdminCommand parses an "io admin ..." message into an AdminService call and returns the reply
+// only the discord users in DISCORD_ADMIN_IDS may manage the catalog
+const runAdminCommand = async (
+  message: Message,
+  adminClient: AdminClient,
+  adminIds: Set<string>,
+): Promise<string> => {
+  if (!adminIds.has(message.author.id)) return 'not allowed';
+
+  const [, , entity, action, ...args] = message.content.trim().split(/\s+/);
+  if (entity === 'models') {
+    // the second word, if any, is the provider to list the models of
+    const { models } = await adminClient.listModels({
+      providerId: action ?? '',
+    });
+    if (models.length === 0) return 'no models';
+    return models
+      .map((model) => `${model.name} (${model.id}) ${model.providerModelId}`)
+      .join('\n');
+  }
+
+  switch (`${entity} ${action}`) {
+    case 'provider add': {
+      if (args.length < 1) break;
+      const { provider } = await adminClient.createProvider({
+        provider: {
+          name: args[0],
+          baseUrl: args[1] ?? '',
+          apiKeyEnv: args[2] ?? '',
+          headers: {},
+          apiMode: '',
+        },
+      });
+      return `added provider ${provider?.name} (${provider?.id})`;
+    }
+    case 'provider rm': {
+      if (args.length < 1) break;
+      await adminClient.deleteProvider(args[0]);
+      return `removed provider ${args[0]}`;
+    }
+    case 'model add': {
+      if (args.length < 2) break;
+      const { model } = await adminClient.createModel({
+        providerId: args[0],
+        model: {
+          name: args[1],
+          providerModelId: args[2] ?? '',
+          description: '',
+          contextWindow: 0,
+          maxOutputTokens: 0,
+          supportsVision: false,
+          supportsTools: false,
+          supportsStreaming: false,
+          supportsReasoning: false,
+        },
+      });
+      return `added model ${model?.name} (${model?.id})`;
+    }
+    case 'model rm': {
+      if (args.length < 1) break;
+      await adminClient.deleteModel(args[0]);
+      return `removed model ${args[0]}`;
+    }
+    case 'config add': {
+      if (args.length < 2) break;
+      const { config } = await adminClient.createAIConfig({
+        config: {
+          name: args[0],
+          modelId: args[1],
+          systemPrompt: '',
+          contextStrategy: '',
+          personalityVersionId: '',
+        },
+      });
+      return `added ai config ${config?.name} (${config?.id})`;
+    }
+    case 'config rm': {
+      if (args.length < 1) break;
+      await adminClient.deleteAIConfig(args[0]);
+      return `removed ai config ${args[0]}`;
+    }
+    case 'mcp connect': {
+      if (args.length < 1) break;
+      const { server } = await adminClient.connectMCPServer(args[0]);
+      return `mcp server ${server?.name} is ${server?.state}`;
+    }
+    case 'mcp disconnect': {
+      if (args.length < 1) break;
+      await adminClient.disconnectMCPServer(args[0]);
+      return `disconnected mcp server ${args[0]}`;
+    }
+    case 'mcp restart': {
+      if (args.length < 1) break;
+      const { server } = await adminClient.restartMCPServer(args[0]);
+      return `mcp server ${server?.name} is ${server?.state}`;
+    }
+  }
+  return adminUsage;
+};
+
+// handleAdminCommand replies to an "io admin ..." message, the same way handleMessage reports errors
+export const handleAdminCommand = async (
+  message: Message,
+  adminClient: AdminClient,
+  adminIds: Set<string>,
+): Promise<void> => {
+  try {
+    await message.reply(await runAdminCommand(message, adminClient, adminIds));
+  } catch (error) {
+    console.error('error handling admin command: ', error);
+    const errorMessage =
+      error instanceof Error
+        ? `Error: ${error.message}`
+        : 'Unknown error occurred';
+    try {
+      await message.reply(`failed ❌, ${errorMessage}`);
+    } catch (replyError) {
+      console.error('failed to even send error msg to discord, F:', replyError);
+    }
+  }
+};
@@ -1,12 +1,27 @@
 import { Client, GatewayIntentBits, Events, Partials } from 'discord.js';
 import { GrpcClient } from './grpc/client';
+import { AdminClient } from './grpc/admin';
 import { handleMessage } from './handlers/message';
+import { handleAdminCommand, isAdminCommand } from './handlers/admin';
 
 // grpc client, see ./grpc/client
 const grpcHost = process.env.GRPC_HOST || 'localhost';
 const grpcPort = parseInt(process.env.GRPC_PORT || '50051');
 const grpcClient = new GrpcClient(grpcHost, grpcPort);
 
+// admin grpc client, see ./grpc/admin, "io admin" commands are off without IO_ADMIN_TOKEN
+const adminPort = parseInt(process.env.ADMIN_GRPC_PORT || '50052');
+const adminToken = process.env.IO_ADMIN_TOKEN;
+const adminClient = adminToken
+  ? new AdminClient(grpcHost, adminPort, adminToken)
+  : undefined;
+const adminIds = new Set(
+  (process.env.DISCORD_ADMIN_IDS || '')
+    .split(',')
+    .map((id) => id.trim())
+    .filter(Boolean),
+);
+
 // discord client
 const token = process.env.DISCORD_TOKEN;
 const discordClient = new Client({
@@ -26,6 +41,10 @@ discordClient.once(Events.ClientReady, (readyClient) => {
 
 // message events
 discordClient.on(Events.MessageCreate, async (message) => {
+  if (adminClient && !message.author.bot && isAdminCommand(message)) {
+    await handleAdminCommand(message, adminClient, adminIds);
+    return;
+  }
   await handleMessage(message, grpcClient);
 });
 